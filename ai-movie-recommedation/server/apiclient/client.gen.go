@@ -0,0 +1,11710 @@
+// Package apiclient provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.8.0 DO NOT EDIT.
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for CatalogEventType.
+const (
+	CatalogEventTypeMovieAdded     CatalogEventType = "movie-added"
+	CatalogEventTypeRankingChanged CatalogEventType = "ranking-changed"
+	CatalogEventTypeReviewUpdated  CatalogEventType = "review-updated"
+)
+
+// Valid indicates whether the value is a known member of the CatalogEventType enum.
+func (e CatalogEventType) Valid() bool {
+	switch e {
+	case CatalogEventTypeMovieAdded:
+		return true
+	case CatalogEventTypeRankingChanged:
+		return true
+	case CatalogEventTypeReviewUpdated:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ExperimentVariantStrategy.
+const (
+	ExperimentVariantStrategyGenreMatch ExperimentVariantStrategy = "genre_match"
+	ExperimentVariantStrategyNewest     ExperimentVariantStrategy = "newest"
+	ExperimentVariantStrategyPopular    ExperimentVariantStrategy = "popular"
+	ExperimentVariantStrategyTopPicks   ExperimentVariantStrategy = "top_picks"
+	ExperimentVariantStrategyTrending   ExperimentVariantStrategy = "trending"
+)
+
+// Valid indicates whether the value is a known member of the ExperimentVariantStrategy enum.
+func (e ExperimentVariantStrategy) Valid() bool {
+	switch e {
+	case ExperimentVariantStrategyGenreMatch:
+		return true
+	case ExperimentVariantStrategyNewest:
+		return true
+	case ExperimentVariantStrategyPopular:
+		return true
+	case ExperimentVariantStrategyTopPicks:
+		return true
+	case ExperimentVariantStrategyTrending:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for FeedRowStrategy.
+const (
+	FeedRowStrategyGenreMatch FeedRowStrategy = "genre_match"
+	FeedRowStrategyNewest     FeedRowStrategy = "newest"
+	FeedRowStrategyPopular    FeedRowStrategy = "popular"
+	FeedRowStrategyTrending   FeedRowStrategy = "trending"
+)
+
+// Valid indicates whether the value is a known member of the FeedRowStrategy enum.
+func (e FeedRowStrategy) Valid() bool {
+	switch e {
+	case FeedRowStrategyGenreMatch:
+		return true
+	case FeedRowStrategyNewest:
+		return true
+	case FeedRowStrategyPopular:
+		return true
+	case FeedRowStrategyTrending:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for JobStatus.
+const (
+	JobStatusFailed     JobStatus = "failed"
+	JobStatusPending    JobStatus = "pending"
+	JobStatusRunning    JobStatus = "running"
+	JobStatusSucceeded  JobStatus = "succeeded"
+	JobStatusSuperseded JobStatus = "superseded"
+)
+
+// Valid indicates whether the value is a known member of the JobStatus enum.
+func (e JobStatus) Valid() bool {
+	switch e {
+	case JobStatusFailed:
+		return true
+	case JobStatusPending:
+		return true
+	case JobStatusRunning:
+		return true
+	case JobStatusSucceeded:
+		return true
+	case JobStatusSuperseded:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for JobType.
+const (
+	JobTypeGenreCascade  JobType = "genre_cascade"
+	JobTypeMovieSummary  JobType = "movie_summary"
+	JobTypeRerankAll     JobType = "rerank_all"
+	JobTypeReviewRanking JobType = "review_ranking"
+)
+
+// Valid indicates whether the value is a known member of the JobType enum.
+func (e JobType) Valid() bool {
+	switch e {
+	case JobTypeGenreCascade:
+		return true
+	case JobTypeMovieSummary:
+		return true
+	case JobTypeRerankAll:
+		return true
+	case JobTypeReviewRanking:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for MovieMaturity.
+const (
+	MovieMaturityAdult MovieMaturity = "adult"
+	MovieMaturityKids  MovieMaturity = "kids"
+	MovieMaturityTeen  MovieMaturity = "teen"
+)
+
+// Valid indicates whether the value is a known member of the MovieMaturity enum.
+func (e MovieMaturity) Valid() bool {
+	switch e {
+	case MovieMaturityAdult:
+		return true
+	case MovieMaturityKids:
+		return true
+	case MovieMaturityTeen:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProfileMaturity.
+const (
+	ProfileMaturityAdult ProfileMaturity = "adult"
+	ProfileMaturityKids  ProfileMaturity = "kids"
+	ProfileMaturityTeen  ProfileMaturity = "teen"
+)
+
+// Valid indicates whether the value is a known member of the ProfileMaturity enum.
+func (e ProfileMaturity) Valid() bool {
+	switch e {
+	case ProfileMaturityAdult:
+		return true
+	case ProfileMaturityKids:
+		return true
+	case ProfileMaturityTeen:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProfileRequestMaturity.
+const (
+	ProfileRequestMaturityAdult ProfileRequestMaturity = "adult"
+	ProfileRequestMaturityKids  ProfileRequestMaturity = "kids"
+	ProfileRequestMaturityTeen  ProfileRequestMaturity = "teen"
+)
+
+// Valid indicates whether the value is a known member of the ProfileRequestMaturity enum.
+func (e ProfileRequestMaturity) Valid() bool {
+	switch e {
+	case ProfileRequestMaturityAdult:
+		return true
+	case ProfileRequestMaturityKids:
+		return true
+	case ProfileRequestMaturityTeen:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PromptTemplateSource.
+const (
+	PromptTemplateSourceBuiltin  PromptTemplateSource = "builtin"
+	PromptTemplateSourceDatabase PromptTemplateSource = "database"
+)
+
+// Valid indicates whether the value is a known member of the PromptTemplateSource enum.
+func (e PromptTemplateSource) Valid() bool {
+	switch e {
+	case PromptTemplateSourceBuiltin:
+		return true
+	case PromptTemplateSourceDatabase:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RegisterRequestRole.
+const (
+	RegisterRequestRoleADMIN RegisterRequestRole = "ADMIN"
+	RegisterRequestRoleUSER  RegisterRequestRole = "USER"
+)
+
+// Valid indicates whether the value is a known member of the RegisterRequestRole enum.
+func (e RegisterRequestRole) Valid() bool {
+	switch e {
+	case RegisterRequestRoleADMIN:
+		return true
+	case RegisterRequestRoleUSER:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for UserResponseRole.
+const (
+	UserResponseRoleADMIN UserResponseRole = "ADMIN"
+	UserResponseRoleUSER  UserResponseRole = "USER"
+)
+
+// Valid indicates whether the value is a known member of the UserResponseRole enum.
+func (e UserResponseRole) Valid() bool {
+	switch e {
+	case UserResponseRoleADMIN:
+		return true
+	case UserResponseRoleUSER:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ExportMoviesParamsFormat.
+const (
+	ExportMoviesParamsFormatCsv  ExportMoviesParamsFormat = "csv"
+	ExportMoviesParamsFormatJson ExportMoviesParamsFormat = "json"
+)
+
+// Valid indicates whether the value is a known member of the ExportMoviesParamsFormat enum.
+func (e ExportMoviesParamsFormat) Valid() bool {
+	switch e {
+	case ExportMoviesParamsFormatCsv:
+		return true
+	case ExportMoviesParamsFormatJson:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ImportMoviesParamsFormat.
+const (
+	ImportMoviesParamsFormatCsv  ImportMoviesParamsFormat = "csv"
+	ImportMoviesParamsFormatJson ImportMoviesParamsFormat = "json"
+)
+
+// Valid indicates whether the value is a known member of the ImportMoviesParamsFormat enum.
+func (e ImportMoviesParamsFormat) Valid() bool {
+	switch e {
+	case ImportMoviesParamsFormatCsv:
+		return true
+	case ImportMoviesParamsFormatJson:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetTrendingMoviesParamsWindow.
+const (
+	GetTrendingMoviesParamsWindowDay   GetTrendingMoviesParamsWindow = "day"
+	GetTrendingMoviesParamsWindowMonth GetTrendingMoviesParamsWindow = "month"
+	GetTrendingMoviesParamsWindowWeek  GetTrendingMoviesParamsWindow = "week"
+)
+
+// Valid indicates whether the value is a known member of the GetTrendingMoviesParamsWindow enum.
+func (e GetTrendingMoviesParamsWindow) Valid() bool {
+	switch e {
+	case GetTrendingMoviesParamsWindowDay:
+		return true
+	case GetTrendingMoviesParamsWindowMonth:
+		return true
+	case GetTrendingMoviesParamsWindowWeek:
+		return true
+	default:
+		return false
+	}
+}
+
+// ActivityStats What happened to a movie in a trending window.
+type ActivityStats struct {
+	// Ratings First ratings.
+	Ratings       int     `json:"ratings"`
+	Score         float32 `json:"score"`
+	Views         int     `json:"views"`
+	WatchlistAdds int     `json:"watchlist_adds"`
+}
+
+// AdminReviewRequest defines model for AdminReviewRequest.
+type AdminReviewRequest struct {
+	AdminReview string `json:"admin_review"`
+}
+
+// CatalogEvent A change to a movie. Which optional field is set depends on type: `movie` for movie-added, `admin_review` for review-updated and `ranking` for ranking-changed.
+type CatalogEvent struct {
+	// AdminReview The new review.
+	AdminReview *string `json:"admin_review,omitempty"`
+
+	// At When the change was made.
+	At time.Time `json:"at"`
+
+	// ImdbId Example: tt0245429
+	ImdbId string `json:"imdb_id"`
+	Movie  *Movie `json:"movie,omitempty"`
+
+	// Ranking A tier of the ranking scale. Movies embed a copy of their tier; lower values are better.
+	Ranking *Ranking         `json:"ranking,omitempty"`
+	Type    CatalogEventType `json:"type"`
+}
+
+// CatalogEventType defines model for CatalogEvent.Type.
+type CatalogEventType string
+
+// ChatFilters What the LLM understood from the request. Empty lists and zeros do not filter. Only genres, mood tags and content warnings that occur in the catalog are kept.
+type ChatFilters struct {
+	// ExcludeContentWarnings Movies whose summary has none of these content warnings. Movies without a summary are left out.
+	//
+	// Example: ["violence"]
+	ExcludeContentWarnings []string `json:"exclude_content_warnings"`
+
+	// Genres Movies in any of these genres.
+	//
+	// Example: ["Animation","Family"]
+	Genres            []string `json:"genres"`
+	MaxRuntimeMinutes int      `json:"max_runtime_minutes"`
+	MaxYear           int      `json:"max_year"`
+	MinYear           int      `json:"min_year"`
+
+	// MoodTags Movies whose summary has any of these mood tags.
+	//
+	// Example: ["uplifting"]
+	MoodTags []string `json:"mood_tags"`
+}
+
+// ChatRequest defines model for ChatRequest.
+type ChatRequest struct {
+	// Limit Most movies to return.
+	Limit *int `json:"limit,omitempty"`
+
+	// Message Example: something light and animated for a family night
+	Message string `json:"message"`
+}
+
+// Experiment defines model for Experiment.
+type Experiment struct {
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Description Example: Do popular movies beat genre matches?
+	Description *string `json:"description,omitempty"`
+
+	// Name Example: popular-vs-genres
+	Name      string              `json:"name"`
+	UpdatedAt time.Time           `json:"updated_at"`
+	Variants  []ExperimentVariant `json:"variants"`
+}
+
+// ExperimentRequest defines model for ExperimentRequest.
+type ExperimentRequest struct {
+	// Active Whether the experiment serves traffic. Only one experiment can be active.
+	Active      *bool   `json:"active,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Variants Variant names must be unique, and at least one weight above 0.
+	Variants []ExperimentVariant `json:"variants"`
+}
+
+// ExperimentResults defines model for ExperimentResults.
+type ExperimentResults struct {
+	Active bool `json:"active"`
+
+	// Experiment Example: popular-vs-genres
+	Experiment string `json:"experiment"`
+
+	// Variants In the experiment's variant order; variants since removed come last.
+	Variants []ExperimentVariantResult `json:"variants"`
+}
+
+// ExperimentVariant defines model for ExperimentVariant.
+type ExperimentVariant struct {
+	// Name Example: treatment
+	Name string `json:"name"`
+
+	// Strategy Recommendation strategy the variant serves. top_picks is what users get outside experiments.
+	//
+	// Example: popular
+	Strategy ExperimentVariantStrategy `json:"strategy"`
+
+	// Weight Share of traffic relative to the other variants' weights; 0 takes the variant out of traffic.
+	//
+	// Example: 50
+	Weight int `json:"weight"`
+}
+
+// ExperimentVariantStrategy Recommendation strategy the variant serves. top_picks is what users get outside experiments.
+//
+// Example: popular
+type ExperimentVariantStrategy string
+
+// ExperimentVariantResult defines model for ExperimentVariantResult.
+type ExperimentVariantResult struct {
+	// ConversionRate conversions / movies_shown.
+	//
+	// Example: 0.07
+	ConversionRate float32 `json:"conversion_rate"`
+
+	// Conversions Shown movies the user saved or rated 4 or more after first seeing them in the variant.
+	//
+	// Example: 42
+	Conversions int `json:"conversions"`
+
+	// Exposures Recommendation responses the variant served.
+	//
+	// Example: 310
+	Exposures int `json:"exposures"`
+
+	// MoviesShown Distinct movies shown per user, summed over users.
+	//
+	// Example: 600
+	MoviesShown int `json:"movies_shown"`
+
+	// Strategy Example: top_picks
+	Strategy string `json:"strategy"`
+
+	// Users Distinct users the variant served.
+	//
+	// Example: 120
+	Users int `json:"users"`
+
+	// Variant Example: control
+	Variant string `json:"variant"`
+
+	// Weight Current weight; 0 for a variant since removed from the experiment.
+	//
+	// Example: 50
+	Weight int `json:"weight"`
+}
+
+// Feed defines model for Feed.
+type Feed struct {
+	// Rows Rows in display order. Empty rows are left out and no movie appears in more than one row.
+	Rows []FeedRow `json:"rows"`
+}
+
+// FeedRow defines model for FeedRow.
+type FeedRow struct {
+	// Id Stable row identifier: top_picks, genre:<name>, trending or new.
+	//
+	// Example: genre:Fantasy
+	Id     string  `json:"id"`
+	Movies []Movie `json:"movies"`
+
+	// Strategy Recommendation strategy that filled the row.
+	//
+	// Example: genre_match
+	Strategy FeedRowStrategy `json:"strategy"`
+
+	// Title Example: Because you like Fantasy
+	Title string `json:"title"`
+}
+
+// FeedRowStrategy Recommendation strategy that filled the row.
+//
+// Example: genre_match
+type FeedRowStrategy string
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Example: genre[0].genre_name
+	Field string `json:"field"`
+
+	// Message Example: is required
+	Message string `json:"message"`
+
+	// Rule Example: required
+	Rule string `json:"rule"`
+}
+
+// Genre A genre. Movies, users and profiles embed copies of it; only `genre_id` is checked when they are written, and the name is taken from the genres collection.
+type Genre struct {
+	// GenreId Example: 4
+	GenreId int `json:"genre_id"`
+
+	// GenreName Example: Fantasy
+	GenreName string `json:"genre_name"`
+
+	// ParentId Makes the genre a sub-genre of another one. Recommendations for fans of a genre include its sub-genres.
+	//
+	// Example: 2
+	ParentId *int `json:"parent_id,omitempty"`
+}
+
+// GenreRequest defines model for GenreRequest.
+type GenreRequest struct {
+	// GenreId Defaults to one more than the highest genre_id in use.
+	//
+	// Example: 10
+	GenreId *int `json:"genre_id,omitempty"`
+
+	// GenreName Example: Noir
+	GenreName string `json:"genre_name"`
+
+	// ParentId Example: 2
+	ParentId *int `json:"parent_id,omitempty"`
+}
+
+// GenreUpdate defines model for GenreUpdate.
+type GenreUpdate struct {
+	// Genre A genre. Movies, users and profiles embed copies of it; only `genre_id` is checked when they are written, and the name is taken from the genres collection.
+	Genre Genre `json:"genre"`
+
+	// Job A background job. `review_ranking` jobs classify one movie's admin review; `rerank_all` jobs queue a review ranking job for every reviewed movie; `movie_summary` jobs write a movie's summary; `genre_cascade` jobs copy a renamed genre's name into the movies, users and profiles that embed it.
+	Job *Job `json:"job,omitempty"`
+}
+
+// GenreUpdateRequest defines model for GenreUpdateRequest.
+type GenreUpdateRequest struct {
+	// GenreName Example: Film Noir
+	GenreName string `json:"genre_name"`
+
+	// ParentId Leave out to make the genre top-level.
+	//
+	// Example: 2
+	ParentId *int `json:"parent_id,omitempty"`
+}
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	DryRun bool       `json:"dry_run"`
+	Errors []RowError `json:"errors"`
+
+	// Failed Example: 1
+	Failed int `json:"failed"`
+
+	// Inserted Example: 12
+	Inserted int `json:"inserted"`
+
+	// Total Example: 15
+	Total int `json:"total"`
+
+	// Unchanged Example: 0
+	Unchanged int `json:"unchanged"`
+
+	// Updated Example: 2
+	Updated int `json:"updated"`
+}
+
+// InsertResult Result of a MongoDB insert.
+type InsertResult struct {
+	Acknowledged *bool `json:"Acknowledged,omitempty"`
+
+	// InsertedID ObjectID of the new document.
+	InsertedID *string `json:"InsertedID,omitempty"`
+}
+
+// Job A background job. `review_ranking` jobs classify one movie's admin review; `rerank_all` jobs queue a review ranking job for every reviewed movie; `movie_summary` jobs write a movie's summary; `genre_cascade` jobs copy a renamed genre's name into the movies, users and profiles that embed it.
+type Job struct {
+	// AdminReview The review being ranked.
+	AdminReview *string   `json:"admin_review,omitempty"`
+	Attempts    int       `json:"attempts"`
+	CreatedAt   time.Time `json:"created_at"`
+
+	// Enqueued Review ranking jobs queued by a rerank_all job.
+	Enqueued   *int       `json:"enqueued,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	// GenreId Genre renamed by a genre_cascade job.
+	//
+	// Example: 4
+	GenreId *int `json:"genre_id,omitempty"`
+
+	// ImdbId Example: tt0245429
+	ImdbId *string `json:"imdb_id,omitempty"`
+
+	// JobId Example: 66f1c2a9e4b0a1b2c3d4e5f6
+	JobId string `json:"job_id"`
+
+	// LastError Error of the latest failed attempt.
+	LastError *string `json:"last_error,omitempty"`
+
+	// MaxAttempts Example: 5
+	MaxAttempts int `json:"max_attempts"`
+
+	// Prompt The prompt version that produced an LLM result.
+	Prompt *PromptRef `json:"prompt,omitempty"`
+
+	// Ranking A tier of the ranking scale. Movies embed a copy of their tier; lower values are better.
+	Ranking *Ranking `json:"ranking,omitempty"`
+
+	// Reason Why a rerank_all job was queued.
+	//
+	// Example: rankings changed
+	Reason *string `json:"reason,omitempty"`
+
+	// RunAfter When the job is next due. Failed attempts are retried with exponential backoff.
+	RunAfter time.Time `json:"run_after"`
+
+	// Status `superseded` means a newer review replaced the one this job was queued for.
+	Status JobStatus `json:"status"`
+	Type   JobType   `json:"type"`
+
+	// Updated Movies, users and profiles updated by a genre_cascade job.
+	Updated   *int      `json:"updated,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// JobStatus `superseded` means a newer review replaced the one this job was queued for.
+type JobStatus string
+
+// JobType defines model for Job.Type.
+type JobType string
+
+// LLMUsage defines model for LLMUsage.
+type LLMUsage struct {
+	// CacheHits Calls answered from the response cache.
+	CacheHits int64 `json:"cache_hits"`
+
+	// Calls Calls that reached the model.
+	Calls            int64 `json:"calls"`
+	CompletionTokens int64 `json:"completion_tokens"`
+
+	// CostBudgetUsd Daily cost limit in USD; 0 is unlimited.
+	CostBudgetUsd float32 `json:"cost_budget_usd"`
+
+	// CostUsd Estimated from the configured per-1K-token prices.
+	CostUsd float32 `json:"cost_usd"`
+
+	// Day Example: 2026-10-19
+	Day          openapi_types.Date `json:"day"`
+	PromptTokens int64              `json:"prompt_tokens"`
+	ResetsAt     time.Time          `json:"resets_at"`
+
+	// TokenBudget Daily token limit; 0 is unlimited.
+	TokenBudget int64 `json:"token_budget"`
+}
+
+// LogoutRequest defines model for LogoutRequest.
+type LogoutRequest struct {
+	UserId string `json:"user_id"`
+}
+
+// Message defines model for Message.
+type Message struct {
+	Message *string `json:"message,omitempty"`
+}
+
+// Metadata Filled in from an external movie catalog. Only source and enriched_at are set when the provider has no entry for the movie.
+type Metadata struct {
+	// Cast Example: ["Rumi Hiiragi","Miyu Irino"]
+	Cast *[]string `json:"cast,omitempty"`
+
+	// Director Example: Hayao Miyazaki
+	Director   *string   `json:"director,omitempty"`
+	EnrichedAt time.Time `json:"enriched_at"`
+
+	// Language ISO 639-1 code of the original language.
+	//
+	// Example: ja
+	Language *string `json:"language,omitempty"`
+	Overview *string `json:"overview,omitempty"`
+
+	// ReleaseYear Example: 2001
+	ReleaseYear *int `json:"release_year,omitempty"`
+
+	// RuntimeMinutes Example: 125
+	RuntimeMinutes *int `json:"runtime_minutes,omitempty"`
+
+	// Source Metadata provider that supplied the data.
+	//
+	// Example: tmdb
+	Source string `json:"source"`
+}
+
+// Movie defines model for Movie.
+type Movie struct {
+	// UnderscoreId MongoDB ObjectID in hex.
+	UnderscoreId *string `json:"_id,omitempty"`
+	AdminReview  *string `json:"admin_review,omitempty"`
+	Genre        []Genre `json:"genre"`
+
+	// ImdbId Example: tt0245429
+	ImdbId string `json:"imdb_id"`
+
+	// Maturity Who the movie is suitable for. Unrated movies are only recommended to adult profiles.
+	Maturity *MovieMaturity `json:"maturity,omitempty"`
+
+	// Metadata Filled in from an external movie catalog. Only source and enriched_at are set when the provider has no entry for the movie.
+	Metadata   *Metadata `json:"metadata,omitempty"`
+	PosterPath string    `json:"poster_path"`
+
+	// Ranking A tier of the ranking scale. Movies embed a copy of their tier; lower values are better.
+	Ranking Ranking `json:"ranking"`
+
+	// RankingPrompt The prompt version that produced an LLM result.
+	RankingPrompt *PromptRef `json:"ranking_prompt,omitempty"`
+
+	// Summary Written by the LLM from the movie's admin review and metadata.
+	Summary *Summary `json:"summary,omitempty"`
+
+	// Title Example: Spirited Away
+	Title string `json:"title"`
+
+	// YoutubeId Example: ByXuk9QqQkk
+	YoutubeId string `json:"youtube_id"`
+}
+
+// MovieMaturity Who the movie is suitable for. Unrated movies are only recommended to adult profiles.
+type MovieMaturity string
+
+// MovieStats defines model for MovieStats.
+type MovieStats struct {
+	// AverageRating Example: 4.2
+	AverageRating float32 `json:"average_rating"`
+
+	// Day What happened to a movie in a trending window.
+	Day ActivityStats `json:"day"`
+
+	// ImdbId Example: tt0245429
+	ImdbId string `json:"imdb_id"`
+
+	// Month What happened to a movie in a trending window.
+	Month ActivityStats `json:"month"`
+
+	// RatingCount Users who rated the movie, of all time.
+	RatingCount int `json:"rating_count"`
+
+	// Watchlisted Watchlists the movie is on now.
+	Watchlisted int `json:"watchlisted"`
+
+	// Week What happened to a movie in a trending window.
+	Week ActivityStats `json:"week"`
+}
+
+// OnboardingResult defines model for OnboardingResult.
+type OnboardingResult struct {
+	// FavouriteGenres Genres derived from the user's ratings, strongest first.
+	FavouriteGenres []Genre `json:"favourite_genres"`
+}
+
+// OnboardingSample defines model for OnboardingSample.
+type OnboardingSample struct {
+	// Movies Movies to rate, spread across the top-level genres, best ranked first within each.
+	Movies []Movie `json:"movies"`
+
+	// Rated Movies the user has rated so far.
+	//
+	// Example: 1
+	Rated int `json:"rated"`
+
+	// Required Ratings needed before onboarding can be completed.
+	//
+	// Example: 3
+	Required int `json:"required"`
+}
+
+// Problem RFC 7807 problem details.
+type Problem struct {
+	// Code Example: movie_not_found
+	Code      string        `json:"code"`
+	Detail    *string       `json:"detail,omitempty"`
+	Errors    *[]FieldError `json:"errors,omitempty"`
+	Instance  *string       `json:"instance,omitempty"`
+	RequestId *string       `json:"request_id,omitempty"`
+
+	// Status Example: 404
+	Status int `json:"status"`
+
+	// Title Example: Not Found
+	Title string `json:"title"`
+
+	// Type Example: urn:magicstream:problem:movie_not_found
+	Type string `json:"type"`
+}
+
+// Profile defines model for Profile.
+type Profile struct {
+	CreatedAt       time.Time `json:"created_at"`
+	FavouriteGenres []Genre   `json:"favourite_genres"`
+
+	// Maturity Movies recommended to the profile: kids sees kids movies, teen also teen movies, adult every movie.
+	Maturity ProfileMaturity `json:"maturity"`
+
+	// Name Example: Kids
+	Name string `json:"name"`
+
+	// ProfileId Example: 6650c2f4e13b5a0f8d4c1a2b
+	ProfileId string    `json:"profile_id"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ProfileMaturity Movies recommended to the profile: kids sees kids movies, teen also teen movies, adult every movie.
+type ProfileMaturity string
+
+// ProfileRequest defines model for ProfileRequest.
+type ProfileRequest struct {
+	// FavouriteGenres Genres are matched by genre_id.
+	FavouriteGenres *[]Genre `json:"favourite_genres,omitempty"`
+
+	// Maturity Movies recommended to the profile: kids sees kids movies, teen also teen movies, adult every movie.
+	Maturity ProfileRequestMaturity `json:"maturity"`
+
+	// Name Unique within the account.
+	//
+	// Example: Kids
+	Name string `json:"name"`
+}
+
+// ProfileRequestMaturity Movies recommended to the profile: kids sees kids movies, teen also teen movies, adult every movie.
+type ProfileRequestMaturity string
+
+// PromptPreview defines model for PromptPreview.
+type PromptPreview struct {
+	// Prompt The prompt version that produced an LLM result.
+	Prompt  *PromptRef            `json:"prompt,omitempty"`
+	Results []PromptPreviewResult `json:"results"`
+}
+
+// PromptPreviewRequest defines model for PromptPreviewRequest.
+type PromptPreviewRequest struct {
+	// Body An unsaved draft to preview instead of a stored version.
+	Body *string `json:"body,omitempty"`
+
+	// Reviews Example: ["A masterpiece from start to finish."]
+	Reviews []string `json:"reviews"`
+
+	// Version Stored version to preview. 0 or omitted means the version in use.
+	Version *int `json:"version,omitempty"`
+}
+
+// PromptPreviewResult defines model for PromptPreviewResult.
+type PromptPreviewResult struct {
+	Answer string `json:"answer"`
+
+	// Cached Whether the answer came from the response cache.
+	Cached bool `json:"cached"`
+
+	// Error Set when the answer is not one of the rankings.
+	Error *string `json:"error,omitempty"`
+
+	// Prompt The rendered prompt sent to the model.
+	Prompt string `json:"prompt"`
+
+	// Ranking A tier of the ranking scale. Movies embed a copy of their tier; lower values are better.
+	Ranking *Ranking `json:"ranking,omitempty"`
+	Review  string   `json:"review"`
+}
+
+// PromptRef The prompt version that produced an LLM result.
+type PromptRef struct {
+	// Name Example: review_ranking
+	Name string `json:"name"`
+
+	// Version Example: 1
+	Version int `json:"version"`
+}
+
+// PromptTemplate One immutable version of a named prompt, rendered with Go text/template. Built-in versions ship with the server; the highest version is the one in use.
+type PromptTemplate struct {
+	// Body Example: Classify this review as one of {{join .Rankings ", "}}.
+	// {{.Review}}
+	Body      string     `json:"body"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// CreatedBy User ID of the admin who saved the version.
+	CreatedBy   *string `json:"created_by,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Name Example: review_ranking
+	Name   string               `json:"name"`
+	Source PromptTemplateSource `json:"source"`
+
+	// Version Example: 2
+	Version int `json:"version"`
+}
+
+// PromptTemplateSource defines model for PromptTemplate.Source.
+type PromptTemplateSource string
+
+// PromptTemplateRequest defines model for PromptTemplateRequest.
+type PromptTemplateRequest struct {
+	// Body A Go text/template. review_ranking templates receive `.Rankings` (a list of names) and `.Review`, and must output the review.
+	Body        string  `json:"body"`
+	Description *string `json:"description,omitempty"`
+}
+
+// Ranking A tier of the ranking scale. Movies embed a copy of their tier; lower values are better.
+type Ranking struct {
+	// RankingName Example: Excellent
+	RankingName string `json:"ranking_name"`
+
+	// RankingValue Example: 1
+	RankingValue int `json:"ranking_value"`
+
+	// Unranked Marks the tier of movies without a ranked review. It is never offered to the LLM as an answer.
+	Unranked *bool `json:"unranked,omitempty"`
+}
+
+// RankingScaleRequest defines model for RankingScaleRequest.
+type RankingScaleRequest struct {
+	// Rankings Every tier, best first, with strictly increasing values and the unranked tier last.
+	Rankings []RankingTierRequest `json:"rankings"`
+}
+
+// RankingScaleUpdate defines model for RankingScaleUpdate.
+type RankingScaleUpdate struct {
+	// Job A background job. `review_ranking` jobs classify one movie's admin review; `rerank_all` jobs queue a review ranking job for every reviewed movie; `movie_summary` jobs write a movie's summary; `genre_cascade` jobs copy a renamed genre's name into the movies, users and profiles that embed it.
+	Job      *Job      `json:"job,omitempty"`
+	Rankings []Ranking `json:"rankings"`
+
+	// Remapped Movies moved to a different tier.
+	Remapped int `json:"remapped"`
+}
+
+// RankingTierRequest defines model for RankingTierRequest.
+type RankingTierRequest struct {
+	// RankingName Example: Excellent
+	RankingName string `json:"ranking_name"`
+
+	// RankingValue Example: 1
+	RankingValue int `json:"ranking_value"`
+
+	// Replaces Current tiers whose movies move to this one, such as the tier's name before a rename.
+	//
+	// Example: ["Great"]
+	Replaces *[]string `json:"replaces,omitempty"`
+
+	// Unranked Marks the tier of movies without a ranked review. Exactly one tier is unranked, and it comes last.
+	Unranked *bool `json:"unranked,omitempty"`
+}
+
+// Rating A user's score for a movie.
+type Rating struct {
+	// ImdbId Example: tt0245429
+	ImdbId string `json:"imdb_id"`
+
+	// RatedAt When the user first rated the movie.
+	RatedAt time.Time `json:"rated_at"`
+
+	// Score Example: 4
+	Score     int       `json:"score"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// RatingRequest defines model for RatingRequest.
+type RatingRequest struct {
+	// Score From 1 (worst) to 5 (best).
+	//
+	// Example: 4
+	Score int `json:"score"`
+}
+
+// RegisterRequest defines model for RegisterRequest.
+type RegisterRequest struct {
+	Email           openapi_types.Email `json:"email"`
+	FavouriteGenres []Genre             `json:"favourite_genres"`
+	FirstName       string              `json:"first_name"`
+	LastName        string              `json:"last_name"`
+	Password        string              `json:"password"`
+	Role            RegisterRequestRole `json:"role"`
+}
+
+// RegisterRequestRole defines model for RegisterRequest.Role.
+type RegisterRequestRole string
+
+// RowError defines model for RowError.
+type RowError struct {
+	Errors []FieldError `json:"errors"`
+
+	// ImdbId Example: tt0245429
+	ImdbId *string `json:"imdb_id,omitempty"`
+
+	// Row 1-based position of the movie in the file, not counting a CSV header.
+	//
+	// Example: 3
+	Row int `json:"row"`
+}
+
+// Summary Written by the LLM from the movie's admin review and metadata.
+type Summary struct {
+	// ContentWarnings Example: ["frightening scenes"]
+	ContentWarnings []string  `json:"content_warnings"`
+	GeneratedAt     time.Time `json:"generated_at"`
+
+	// MoodTags Example: ["whimsical","heartfelt"]
+	MoodTags []string `json:"mood_tags"`
+
+	// Prompt The prompt version that produced an LLM result.
+	Prompt *PromptRef `json:"prompt,omitempty"`
+
+	// Synopsis Example: A girl wanders into a world of spirits and must work in a bathhouse to free her parents.
+	Synopsis string `json:"synopsis"`
+}
+
+// TrendingMovie defines model for TrendingMovie.
+type TrendingMovie struct {
+	Movie Movie `json:"movie"`
+
+	// Score Decayed, weighted activity in the window.
+	//
+	// Example: 12.5
+	Score float32 `json:"score"`
+}
+
+// UserLogin defines model for UserLogin.
+type UserLogin struct {
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+}
+
+// UserResponse defines model for UserResponse.
+type UserResponse struct {
+	Email           *openapi_types.Email `json:"email,omitempty"`
+	FavouriteGenres *[]Genre             `json:"favourite_genres,omitempty"`
+	FirstName       *string              `json:"first_name,omitempty"`
+	LastName        *string              `json:"last_name,omitempty"`
+
+	// Profiles The household's profiles. Select one with POST /profiles/{profile_id}/select, or carry on as the account itself.
+	Profiles *[]Profile `json:"profiles,omitempty"`
+
+	// RefreshToken Always empty; tokens are delivered as cookies.
+	RefreshToken *string           `json:"refresh_token,omitempty"`
+	Role         *UserResponseRole `json:"role,omitempty"`
+
+	// Token Always empty; tokens are delivered as cookies.
+	Token  *string `json:"token,omitempty"`
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// UserResponseRole defines model for UserResponse.Role.
+type UserResponseRole string
+
+// ExportMoviesParams defines parameters for ExportMovies.
+type ExportMoviesParams struct {
+	// Format Output format. Defaults to json.
+	Format *ExportMoviesParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportMoviesParamsFormat defines parameters for ExportMovies.
+type ExportMoviesParamsFormat string
+
+// ImportMoviesJSONBody defines parameters for ImportMovies.
+type ImportMoviesJSONBody = []Movie
+
+// ImportMoviesParams defines parameters for ImportMovies.
+type ImportMoviesParams struct {
+	// Format Body format. Defaults to the Content-Type header.
+	Format *ImportMoviesParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// DryRun Validate and report without writing.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ImportMoviesParamsFormat defines parameters for ImportMovies.
+type ImportMoviesParamsFormat string
+
+// GetGenresParams defines parameters for GetGenres.
+type GetGenresParams struct {
+	// IfNoneMatch ETag of a previous response. When it is still current the server answers 304 without a body.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// GetMovieParams defines parameters for GetMovie.
+type GetMovieParams struct {
+	// IfNoneMatch ETag of a previous response. When it is still current the server answers 304 without a body.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// GetMoviesParams defines parameters for GetMovies.
+type GetMoviesParams struct {
+	// IfNoneMatch ETag of a previous response. When it is still current the server answers 304 without a body.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// GetTrendingMoviesParams defines parameters for GetTrendingMovies.
+type GetTrendingMoviesParams struct {
+	Window *GetTrendingMoviesParamsWindow `form:"window,omitempty" json:"window,omitempty"`
+	Limit  *int                           `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// IfNoneMatch ETag of a previous response. When it is still current the server answers 304 without a body.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// GetTrendingMoviesParamsWindow defines parameters for GetTrendingMovies.
+type GetTrendingMoviesParamsWindow string
+
+// GetOnboardingMoviesParams defines parameters for GetOnboardingMovies.
+type GetOnboardingMoviesParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// AddMovieJSONRequestBody defines body for AddMovie for application/json ContentType.
+type AddMovieJSONRequestBody = Movie
+
+// SaveExperimentJSONRequestBody defines body for SaveExperiment for application/json ContentType.
+type SaveExperimentJSONRequestBody = ExperimentRequest
+
+// CreateGenreJSONRequestBody defines body for CreateGenre for application/json ContentType.
+type CreateGenreJSONRequestBody = GenreRequest
+
+// UpdateGenreJSONRequestBody defines body for UpdateGenre for application/json ContentType.
+type UpdateGenreJSONRequestBody = GenreUpdateRequest
+
+// ImportMoviesJSONRequestBody defines body for ImportMovies for application/json ContentType.
+type ImportMoviesJSONRequestBody = ImportMoviesJSONBody
+
+// CreatePromptTemplateJSONRequestBody defines body for CreatePromptTemplate for application/json ContentType.
+type CreatePromptTemplateJSONRequestBody = PromptTemplateRequest
+
+// PreviewPromptTemplateJSONRequestBody defines body for PreviewPromptTemplate for application/json ContentType.
+type PreviewPromptTemplateJSONRequestBody = PromptPreviewRequest
+
+// ReplaceRankingsJSONRequestBody defines body for ReplaceRankings for application/json ContentType.
+type ReplaceRankingsJSONRequestBody = RankingScaleRequest
+
+// LoginUserJSONRequestBody defines body for LoginUser for application/json ContentType.
+type LoginUserJSONRequestBody = UserLogin
+
+// LogoutUserJSONRequestBody defines body for LogoutUser for application/json ContentType.
+type LogoutUserJSONRequestBody = LogoutRequest
+
+// RateMovieJSONRequestBody defines body for RateMovie for application/json ContentType.
+type RateMovieJSONRequestBody = RatingRequest
+
+// CreateProfileJSONRequestBody defines body for CreateProfile for application/json ContentType.
+type CreateProfileJSONRequestBody = ProfileRequest
+
+// UpdateProfileJSONRequestBody defines body for UpdateProfile for application/json ContentType.
+type UpdateProfileJSONRequestBody = ProfileRequest
+
+// ChatRecommendationsJSONRequestBody defines body for ChatRecommendations for application/json ContentType.
+type ChatRecommendationsJSONRequestBody = ChatRequest
+
+// RegisterUserJSONRequestBody defines body for RegisterUser for application/json ContentType.
+type RegisterUserJSONRequestBody = RegisterRequest
+
+// UpdateAdminReviewJSONRequestBody defines body for UpdateAdminReview for application/json ContentType.
+type UpdateAdminReviewJSONRequestBody = AdminReviewRequest
+
+// RequestEditorFn is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+
+	// AddMovieWithBody Add a movie
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /addmovie (the `AddMovie` operationId).
+	AddMovieWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddMovie Add a movie
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /addmovie (the `AddMovie` operationId).
+	AddMovie(ctx context.Context, body AddMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListExperiments List recommendation experiments
+	//
+	// Corresponds with GET /admin/experiments (the `ListExperiments` operationId).
+	ListExperiments(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SaveExperimentWithBody Create or replace a recommendation experiment
+	//
+	// Users are bucketed into a variant by a hash of the experiment name and their user_id, so each user sees the same variant for as long as the variants and weights stay the same. Changing them while the experiment is active moves some users to another variant.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with PUT /admin/experiments/{name} (the `SaveExperiment` operationId).
+	SaveExperimentWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SaveExperiment Create or replace a recommendation experiment
+	//
+	// Users are bucketed into a variant by a hash of the experiment name and their user_id, so each user sees the same variant for as long as the variants and weights stay the same. Changing them while the experiment is active moves some users to another variant.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with PUT /admin/experiments/{name} (the `SaveExperiment` operationId).
+	SaveExperiment(ctx context.Context, name string, body SaveExperimentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetExperimentResults Compare an experiment's variants
+	//
+	// Corresponds with GET /admin/experiments/{name}/results (the `GetExperimentResults` operationId).
+	GetExperimentResults(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateGenreWithBody Create a genre
+	//
+	// Requires the ADMIN role. Set `parent_id` to create a sub-genre.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /admin/genres (the `CreateGenre` operationId).
+	CreateGenreWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateGenre Create a genre
+	//
+	// Requires the ADMIN role. Set `parent_id` to create a sub-genre.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /admin/genres (the `CreateGenre` operationId).
+	CreateGenre(ctx context.Context, body CreateGenreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteGenre Delete a genre
+	//
+	// Requires the ADMIN role. Only a genre that no movie, user or sub-genre refers to can be deleted.
+	//
+	// Corresponds with DELETE /admin/genres/{genre_id} (the `DeleteGenre` operationId).
+	DeleteGenre(ctx context.Context, genreId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateGenreWithBody Rename or move a genre
+	//
+	// Requires the ADMIN role. Replaces the genre's name and parent. After a rename a `genre_cascade` job updates the copies of the genre embedded in movies, users and profiles.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with PUT /admin/genres/{genre_id} (the `UpdateGenre` operationId).
+	UpdateGenreWithBody(ctx context.Context, genreId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateGenre Rename or move a genre
+	//
+	// Requires the ADMIN role. Replaces the genre's name and parent. After a rename a `genre_cascade` job updates the copies of the genre embedded in movies, users and profiles.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with PUT /admin/genres/{genre_id} (the `UpdateGenre` operationId).
+	UpdateGenre(ctx context.Context, genreId int, body UpdateGenreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJob Get a background job
+	//
+	// Requires the ADMIN role.
+	//
+	// Corresponds with GET /admin/jobs/{job_id} (the `GetJob` operationId).
+	GetJob(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLLMUsage Get today's LLM usage
+	//
+	// Requires the ADMIN role. Reports today's (UTC) model calls, cache hits, tokens and estimated cost against the daily budget. Once a budget is spent, review ranking jobs wait until it resets.
+	//
+	// Corresponds with GET /admin/llm/usage (the `GetLLMUsage` operationId).
+	GetLLMUsage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportMovies Export the catalogue
+	//
+	// Requires the ADMIN role. Streams every movie ordered by `imdb_id` in a form the import endpoint accepts.
+	//
+	// Corresponds with GET /admin/movies/export (the `ExportMovies` operationId).
+	ExportMovies(ctx context.Context, params *ExportMoviesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportMoviesWithBody Bulk import movies
+	//
+	// Requires the ADMIN role. Upserts movies by `imdb_id`. The body is a JSON array of movies or a CSV file with the header `imdb_id,title,poster_path,youtube_id,genre,admin_review,ranking_value,ranking_name`; CSV genres are written as `4:Fantasy|7:Action`. Invalid rows, including rows naming a `genre_id` that does not exist, are reported and skipped. An empty `admin_review` keeps the stored review; a movie whose review changes is ranked and summarized again, as after a review update.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /admin/movies/import (the `ImportMovies` operationId).
+	ImportMoviesWithBody(ctx context.Context, params *ImportMoviesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportMovies Bulk import movies
+	//
+	// Requires the ADMIN role. Upserts movies by `imdb_id`. The body is a JSON array of movies or a CSV file with the header `imdb_id,title,poster_path,youtube_id,genre,admin_review,ranking_value,ranking_name`; CSV genres are written as `4:Fantasy|7:Action`. Invalid rows, including rows naming a `genre_id` that does not exist, are reported and skipped. An empty `admin_review` keeps the stored review; a movie whose review changes is ranked and summarized again, as after a review update.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /admin/movies/import (the `ImportMovies` operationId).
+	ImportMovies(ctx context.Context, params *ImportMoviesParams, body ImportMoviesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RerankMovies Re-rank every reviewed movie
+	//
+	// Requires the ADMIN role. Queues a job that queues a review ranking job for every movie with an admin review. If one is already pending, that job is returned. The server also queues it by itself when the rankings collection changes.
+	//
+	// Corresponds with POST /admin/movies/rerank (the `RerankMovies` operationId).
+	RerankMovies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EnrichMovie Refresh a movie's metadata
+	//
+	// Requires the ADMIN role. Looks the movie up in the configured metadata provider and stores the result. Movies are also enriched when added and by a background refresh job.
+	//
+	// Corresponds with POST /admin/movies/{imdb_id}/enrich (the `EnrichMovie` operationId).
+	EnrichMovie(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReviewStatus Get a movie's review ranking status
+	//
+	// Requires the ADMIN role. Returns the most recently queued review ranking job for the movie.
+	//
+	// Corresponds with GET /admin/movies/{imdb_id}/review-status (the `GetReviewStatus` operationId).
+	GetReviewStatus(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMovieStats Get a movie's popularity statistics
+	//
+	// Requires the ADMIN role. Returns the movie's activity and score in each trending window, with its ratings and watchlist entries of all time.
+	//
+	// Corresponds with GET /admin/movies/{imdb_id}/stats (the `GetMovieStats` operationId).
+	GetMovieStats(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SummarizeMovie Regenerate a movie's summary
+	//
+	// Requires the ADMIN role. Queues a job that has the LLM write the movie's synopsis, mood tags and content warnings from its admin review and metadata. A movie with neither a review nor a metadata overview cannot be summarized, and its job fails.
+	//
+	// Corresponds with POST /admin/movies/{imdb_id}/summarize (the `SummarizeMovie` operationId).
+	SummarizeMovie(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPromptTemplates List the versions of a prompt
+	//
+	// Requires the ADMIN role. Returns built-in and saved versions, newest first. The first one is in use.
+	//
+	// Corresponds with GET /admin/prompts/{name} (the `ListPromptTemplates` operationId).
+	ListPromptTemplates(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePromptTemplateWithBody Save a new prompt version
+	//
+	// Requires the ADMIN role. The template is rendered with sample data before it is saved. The new version is used for every ranking from then on; existing rankings keep the version recorded in `ranking_prompt` until they are re-ranked.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /admin/prompts/{name} (the `CreatePromptTemplate` operationId).
+	CreatePromptTemplateWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePromptTemplate Save a new prompt version
+	//
+	// Requires the ADMIN role. The template is rendered with sample data before it is saved. The new version is used for every ranking from then on; existing rankings keep the version recorded in `ranking_prompt` until they are re-ranked.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /admin/prompts/{name} (the `CreatePromptTemplate` operationId).
+	CreatePromptTemplate(ctx context.Context, name string, body CreatePromptTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreviewPromptTemplateWithBody Preview a prompt against sample reviews
+	//
+	// Requires the ADMIN role. Classifies each sample review with a stored version or an unsaved draft. No movie is changed. Only `review_ranking` can be previewed.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /admin/prompts/{name}/preview (the `PreviewPromptTemplate` operationId).
+	PreviewPromptTemplateWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreviewPromptTemplate Preview a prompt against sample reviews
+	//
+	// Requires the ADMIN role. Classifies each sample review with a stored version or an unsaved draft. No movie is changed. Only `review_ranking` can be previewed.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /admin/prompts/{name}/preview (the `PreviewPromptTemplate` operationId).
+	PreviewPromptTemplate(ctx context.Context, name string, body PreviewPromptTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRankings List the ranking scale
+	//
+	// Requires the ADMIN role. Returns every tier, best first.
+	//
+	// Corresponds with GET /admin/rankings (the `GetRankings` operationId).
+	GetRankings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplaceRankingsWithBody Replace the ranking scale
+	//
+	// Requires the ADMIN role. Replaces every tier. Each movie moves to the tier of the same name, to the tier that `replaces` its old one, or, when its tier was dropped, to the unranked tier. When the tiers offered to the LLM changed, a `rerank_all` job re-ranks every reviewed movie.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with PUT /admin/rankings (the `ReplaceRankings` operationId).
+	ReplaceRankingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplaceRankings Replace the ranking scale
+	//
+	// Requires the ADMIN role. Replaces every tier. Each movie moves to the tier of the same name, to the tier that `replaces` its old one, or, when its tier was dropped, to the unranked tier. When the tiers offered to the LLM changed, a `rerank_all` job re-ranks every reviewed movie.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with PUT /admin/rankings (the `ReplaceRankings` operationId).
+	ReplaceRankings(ctx context.Context, body ReplaceRankingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFeed The user's personalized home feed
+	//
+	// Rows of top picks from the user's favourite genres, one row for each of their first favourite genres, movies trending this week and newly added movies. A movie appears only in the first row that has it. Row sizes are set by the FEED_ROW_LIMITS environment variable, e.g. `top_picks=5,genre=8,genre_rows=3,trending=10,new=10`; a size of 0 leaves the row out. With a profile selected, recommendations follow the profile's favourite genres and maturity setting.
+	//
+	// Corresponds with GET /feed (the `GetFeed` operationId).
+	GetFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetGenres List all genres
+	//
+	// Served from a short-lived in-process cache that is invalidated when the catalogue changes. Responses carry an ETag and a `public` Cache-Control header for revalidation.
+	//
+	// Corresponds with GET /genres (the `GetGenres` operationId).
+	GetGenres(ctx context.Context, params *GetGenresParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoginUserWithBody Log in and receive auth cookies
+	//
+	// Sets the `access_token` and `refresh_token` HttpOnly cookies.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /login (the `LoginUser` operationId).
+	LoginUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoginUser Log in and receive auth cookies
+	//
+	// Sets the `access_token` and `refresh_token` HttpOnly cookies.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /login (the `LoginUser` operationId).
+	LoginUser(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LogoutUserWithBody Log out and clear auth cookies
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /logout (the `LogoutUser` operationId).
+	LogoutUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LogoutUser Log out and clear auth cookies
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /logout (the `LogoutUser` operationId).
+	LogoutUser(ctx context.Context, body LogoutUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMovie Get a movie by IMDb ID
+	//
+	// Served from a short-lived in-process cache that is invalidated when the catalogue changes. Responses carry an ETag and a `private` Cache-Control header for revalidation.
+	//
+	// Corresponds with GET /movie/{imdb_id} (the `GetMovie` operationId).
+	GetMovie(ctx context.Context, imdbId string, params *GetMovieParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RateMovieWithBody Rate a movie
+	//
+	// Stores the user's score for the movie, replacing any earlier one. Only the first rating of a movie by a user counts towards its popularity.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with PUT /movie/{imdb_id}/rating (the `RateMovie` operationId).
+	RateMovieWithBody(ctx context.Context, imdbId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RateMovie Rate a movie
+	//
+	// Stores the user's score for the movie, replacing any earlier one. Only the first rating of a movie by a user counts towards its popularity.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with PUT /movie/{imdb_id}/rating (the `RateMovie` operationId).
+	RateMovie(ctx context.Context, imdbId string, body RateMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMovies List all movies
+	//
+	// Served from a short-lived in-process cache that is invalidated when the catalogue changes. Responses carry an ETag and a `public` Cache-Control header for revalidation.
+	//
+	// Corresponds with GET /movies (the `GetMovies` operationId).
+	GetMovies(ctx context.Context, params *GetMoviesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamCatalogEvents Stream changes to the catalog
+	//
+	// Changes are streamed as server-sent events named after their type, each carrying a CatalogEvent:
+	//
+	// - `movie-added`: a movie was added or imported
+	// - `review-updated`: a movie's admin review changed
+	// - `ranking-changed`: a movie was given another tier, by a review ranking or a new ranking scale
+	//
+	// Idle streams get a `: keep-alive` comment every 25 seconds. A client that falls too far behind is disconnected and should reconnect and reload the catalog. On a replica set the events come from a change stream and include writes made through any server; on a standalone MongoDB only writes made through this server are streamed.
+	//
+	// Corresponds with GET /movies/events (the `StreamCatalogEvents` operationId).
+	StreamCatalogEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTrendingMovies List trending movies
+	//
+	// Movies are scored by their views (weight 1), first ratings (3) and watchlist adds (5) in the window. Each hour's activity is halved for every half-life of its age: 6 hours for `day`, 2 days for `week` and 7 days for `month`. Movies without activity in the window are left out. Served from the response cache like `/movies`.
+	//
+	// Corresponds with GET /movies/trending (the `GetTrendingMovies` operationId).
+	GetTrendingMovies(ctx context.Context, params *GetTrendingMoviesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompleteOnboarding Derive favourite genres from the user's ratings
+	//
+	// Each rating counts for the genres of its movie by how far it is above or below 3. Up to three genres that come out ahead replace the favourite genres of the user, or of their selected profile.
+	//
+	// Corresponds with POST /onboarding/complete (the `CompleteOnboarding` operationId).
+	CompleteOnboarding(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOnboardingMovies Movies for a new user to rate
+	//
+	// A spread of movies across the catalog for a user without favourite genres to rate with PUT /movie/{imdb_id}/rating. Movies the user already rated are left out.
+	//
+	// Corresponds with GET /onboarding/movies (the `GetOnboardingMovies` operationId).
+	GetOnboardingMovies(ctx context.Context, params *GetOnboardingMoviesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProfiles The profiles of the user's household
+	//
+	// Corresponds with GET /profiles (the `ListProfiles` operationId).
+	ListProfiles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProfileWithBody Add a profile to the household
+	//
+	// A profile has its own favourite genres, ratings, watchlist and maturity setting. An account can have at most 5 profiles.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /profiles (the `CreateProfile` operationId).
+	CreateProfileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProfile Add a profile to the household
+	//
+	// A profile has its own favourite genres, ratings, watchlist and maturity setting. An account can have at most 5 profiles.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /profiles (the `CreateProfile` operationId).
+	CreateProfile(ctx context.Context, body CreateProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProfile Delete a profile with its ratings and watchlist
+	//
+	// Corresponds with DELETE /profiles/{profile_id} (the `DeleteProfile` operationId).
+	DeleteProfile(ctx context.Context, profileId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProfileWithBody Update a profile
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with PUT /profiles/{profile_id} (the `UpdateProfile` operationId).
+	UpdateProfileWithBody(ctx context.Context, profileId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProfile Update a profile
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with PUT /profiles/{profile_id} (the `UpdateProfile` operationId).
+	UpdateProfile(ctx context.Context, profileId string, body UpdateProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SelectProfile Act for a profile of the household
+	//
+	// Issues new access and refresh token cookies scoped to the profile. Ratings, the watchlist, onboarding and recommendations then belong to the profile, and only movies its maturity setting allows are recommended. Logging in again goes back to the account itself.
+	//
+	// Corresponds with POST /profiles/{profile_id}/select (the `SelectProfile` operationId).
+	SelectProfile(ctx context.Context, profileId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ChatRecommendationsWithBody Movies recommended from a request in the user's own words
+	//
+	// The LLM turns the message into catalog filters, and the answer is streamed as server-sent events:
+	//
+	// - `filters`: the ChatFilters that were applied
+	// - `message`: one sentence introducing the picks, as plain text
+	// - `movie`: one Movie per match, best ranked first
+	// - `done`: `{"count": n}`
+	// - `error`: a Problem, if the stream fails after it started
+	//
+	// Errors before the stream starts are ordinary problem responses. With a profile selected, only movies its maturity setting allows are streamed.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /recommendations/chat (the `ChatRecommendations` operationId).
+	ChatRecommendationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ChatRecommendations Movies recommended from a request in the user's own words
+	//
+	// The LLM turns the message into catalog filters, and the answer is streamed as server-sent events:
+	//
+	// - `filters`: the ChatFilters that were applied
+	// - `message`: one sentence introducing the picks, as plain text
+	// - `movie`: one Movie per match, best ranked first
+	// - `done`: `{"count": n}`
+	// - `error`: a Problem, if the stream fails after it started
+	//
+	// Errors before the stream starts are ordinary problem responses. With a profile selected, only movies its maturity setting allows are streamed.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /recommendations/chat (the `ChatRecommendations` operationId).
+	ChatRecommendations(ctx context.Context, body ChatRecommendationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRecommendedMovies Movies recommended from the user's favourite genres
+	//
+	// Movies in the user's favourite genres and their sub-genres, best ranked first. Users without favourite genres get popular movies instead: this month's trending movies, topped up with the best ranked ones. The count is the top_picks limit of FEED_ROW_LIMITS. While an experiment is active, the user's variant chooses the strategy instead and the response is logged as an exposure. With a profile selected, recommendations follow the profile's favourite genres and maturity setting.
+	//
+	// Corresponds with GET /recommendedmovies (the `GetRecommendedMovies` operationId).
+	GetRecommendedMovies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefreshTokens Rotate auth cookies using the refresh token
+	//
+	// Corresponds with POST /refresh (the `RefreshTokens` operationId).
+	RefreshTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegisterUserWithBody Register a new user
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /register (the `RegisterUser` operationId).
+	RegisterUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegisterUser Register a new user
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /register (the `RegisterUser` operationId).
+	RegisterUser(ctx context.Context, body RegisterUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAdminReviewWithBody Update the admin review and queue re-ranking
+	//
+	// Requires the ADMIN role. Stores the review and queues a background job that classifies it with the LLM into one of the configured rankings. Poll `/admin/movies/{imdb_id}/review-status` for the result. Unless the server runs with `AUTO_SUMMARIZE=off`, a job that rewrites the movie's summary is queued too.
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with PATCH /updatereview/{imdb_id} (the `UpdateAdminReview` operationId).
+	UpdateAdminReviewWithBody(ctx context.Context, imdbId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAdminReview Update the admin review and queue re-ranking
+	//
+	// Requires the ADMIN role. Stores the review and queues a background job that classifies it with the LLM into one of the configured rankings. Poll `/admin/movies/{imdb_id}/review-status` for the result. Unless the server runs with `AUTO_SUMMARIZE=off`, a job that rewrites the movie's summary is queued too.
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with PATCH /updatereview/{imdb_id} (the `UpdateAdminReview` operationId).
+	UpdateAdminReview(ctx context.Context, imdbId string, body UpdateAdminReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWatchlist List the movies on the user's watchlist
+	//
+	// Most recently added first.
+	//
+	// Corresponds with GET /watchlist (the `GetWatchlist` operationId).
+	GetWatchlist(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveFromWatchlist Remove a movie from the user's watchlist
+	//
+	// Corresponds with DELETE /watchlist/{imdb_id} (the `RemoveFromWatchlist` operationId).
+	RemoveFromWatchlist(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddToWatchlist Add a movie to the user's watchlist
+	//
+	// Adding a movie that is already on the watchlist changes nothing.
+	//
+	// Corresponds with PUT /watchlist/{imdb_id} (the `AddToWatchlist` operationId).
+	AddToWatchlist(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+// AddMovieWithBody Add a movie
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /addmovie (the `AddMovie` operationId).
+func (c *Client) AddMovieWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddMovieRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// AddMovie Add a movie
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /addmovie (the `AddMovie` operationId).
+func (c *Client) AddMovie(ctx context.Context, body AddMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddMovieRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ListExperiments List recommendation experiments
+//
+// Corresponds with GET /admin/experiments (the `ListExperiments` operationId).
+func (c *Client) ListExperiments(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListExperimentsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// SaveExperimentWithBody Create or replace a recommendation experiment
+//
+// Users are bucketed into a variant by a hash of the experiment name and their user_id, so each user sees the same variant for as long as the variants and weights stay the same. Changing them while the experiment is active moves some users to another variant.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with PUT /admin/experiments/{name} (the `SaveExperiment` operationId).
+func (c *Client) SaveExperimentWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSaveExperimentRequestWithBody(c.Server, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// SaveExperiment Create or replace a recommendation experiment
+//
+// Users are bucketed into a variant by a hash of the experiment name and their user_id, so each user sees the same variant for as long as the variants and weights stay the same. Changing them while the experiment is active moves some users to another variant.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with PUT /admin/experiments/{name} (the `SaveExperiment` operationId).
+func (c *Client) SaveExperiment(ctx context.Context, name string, body SaveExperimentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSaveExperimentRequest(c.Server, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetExperimentResults Compare an experiment's variants
+//
+// Corresponds with GET /admin/experiments/{name}/results (the `GetExperimentResults` operationId).
+func (c *Client) GetExperimentResults(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetExperimentResultsRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateGenreWithBody Create a genre
+//
+// Requires the ADMIN role. Set `parent_id` to create a sub-genre.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /admin/genres (the `CreateGenre` operationId).
+func (c *Client) CreateGenreWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateGenreRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateGenre Create a genre
+//
+// Requires the ADMIN role. Set `parent_id` to create a sub-genre.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /admin/genres (the `CreateGenre` operationId).
+func (c *Client) CreateGenre(ctx context.Context, body CreateGenreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateGenreRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// DeleteGenre Delete a genre
+//
+// Requires the ADMIN role. Only a genre that no movie, user or sub-genre refers to can be deleted.
+//
+// Corresponds with DELETE /admin/genres/{genre_id} (the `DeleteGenre` operationId).
+func (c *Client) DeleteGenre(ctx context.Context, genreId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteGenreRequest(c.Server, genreId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// UpdateGenreWithBody Rename or move a genre
+//
+// Requires the ADMIN role. Replaces the genre's name and parent. After a rename a `genre_cascade` job updates the copies of the genre embedded in movies, users and profiles.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with PUT /admin/genres/{genre_id} (the `UpdateGenre` operationId).
+func (c *Client) UpdateGenreWithBody(ctx context.Context, genreId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateGenreRequestWithBody(c.Server, genreId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// UpdateGenre Rename or move a genre
+//
+// Requires the ADMIN role. Replaces the genre's name and parent. After a rename a `genre_cascade` job updates the copies of the genre embedded in movies, users and profiles.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with PUT /admin/genres/{genre_id} (the `UpdateGenre` operationId).
+func (c *Client) UpdateGenre(ctx context.Context, genreId int, body UpdateGenreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateGenreRequest(c.Server, genreId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetJob Get a background job
+//
+// Requires the ADMIN role.
+//
+// Corresponds with GET /admin/jobs/{job_id} (the `GetJob` operationId).
+func (c *Client) GetJob(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJobRequest(c.Server, jobId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetLLMUsage Get today's LLM usage
+//
+// Requires the ADMIN role. Reports today's (UTC) model calls, cache hits, tokens and estimated cost against the daily budget. Once a budget is spent, review ranking jobs wait until it resets.
+//
+// Corresponds with GET /admin/llm/usage (the `GetLLMUsage` operationId).
+func (c *Client) GetLLMUsage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLLMUsageRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ExportMovies Export the catalogue
+//
+// Requires the ADMIN role. Streams every movie ordered by `imdb_id` in a form the import endpoint accepts.
+//
+// Corresponds with GET /admin/movies/export (the `ExportMovies` operationId).
+func (c *Client) ExportMovies(ctx context.Context, params *ExportMoviesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportMoviesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ImportMoviesWithBody Bulk import movies
+//
+// Requires the ADMIN role. Upserts movies by `imdb_id`. The body is a JSON array of movies or a CSV file with the header `imdb_id,title,poster_path,youtube_id,genre,admin_review,ranking_value,ranking_name`; CSV genres are written as `4:Fantasy|7:Action`. Invalid rows, including rows naming a `genre_id` that does not exist, are reported and skipped. An empty `admin_review` keeps the stored review; a movie whose review changes is ranked and summarized again, as after a review update.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /admin/movies/import (the `ImportMovies` operationId).
+func (c *Client) ImportMoviesWithBody(ctx context.Context, params *ImportMoviesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportMoviesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ImportMovies Bulk import movies
+//
+// Requires the ADMIN role. Upserts movies by `imdb_id`. The body is a JSON array of movies or a CSV file with the header `imdb_id,title,poster_path,youtube_id,genre,admin_review,ranking_value,ranking_name`; CSV genres are written as `4:Fantasy|7:Action`. Invalid rows, including rows naming a `genre_id` that does not exist, are reported and skipped. An empty `admin_review` keeps the stored review; a movie whose review changes is ranked and summarized again, as after a review update.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /admin/movies/import (the `ImportMovies` operationId).
+func (c *Client) ImportMovies(ctx context.Context, params *ImportMoviesParams, body ImportMoviesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportMoviesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RerankMovies Re-rank every reviewed movie
+//
+// Requires the ADMIN role. Queues a job that queues a review ranking job for every movie with an admin review. If one is already pending, that job is returned. The server also queues it by itself when the rankings collection changes.
+//
+// Corresponds with POST /admin/movies/rerank (the `RerankMovies` operationId).
+func (c *Client) RerankMovies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRerankMoviesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// EnrichMovie Refresh a movie's metadata
+//
+// Requires the ADMIN role. Looks the movie up in the configured metadata provider and stores the result. Movies are also enriched when added and by a background refresh job.
+//
+// Corresponds with POST /admin/movies/{imdb_id}/enrich (the `EnrichMovie` operationId).
+func (c *Client) EnrichMovie(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnrichMovieRequest(c.Server, imdbId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetReviewStatus Get a movie's review ranking status
+//
+// Requires the ADMIN role. Returns the most recently queued review ranking job for the movie.
+//
+// Corresponds with GET /admin/movies/{imdb_id}/review-status (the `GetReviewStatus` operationId).
+func (c *Client) GetReviewStatus(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReviewStatusRequest(c.Server, imdbId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetMovieStats Get a movie's popularity statistics
+//
+// Requires the ADMIN role. Returns the movie's activity and score in each trending window, with its ratings and watchlist entries of all time.
+//
+// Corresponds with GET /admin/movies/{imdb_id}/stats (the `GetMovieStats` operationId).
+func (c *Client) GetMovieStats(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMovieStatsRequest(c.Server, imdbId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// SummarizeMovie Regenerate a movie's summary
+//
+// Requires the ADMIN role. Queues a job that has the LLM write the movie's synopsis, mood tags and content warnings from its admin review and metadata. A movie with neither a review nor a metadata overview cannot be summarized, and its job fails.
+//
+// Corresponds with POST /admin/movies/{imdb_id}/summarize (the `SummarizeMovie` operationId).
+func (c *Client) SummarizeMovie(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSummarizeMovieRequest(c.Server, imdbId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ListPromptTemplates List the versions of a prompt
+//
+// Requires the ADMIN role. Returns built-in and saved versions, newest first. The first one is in use.
+//
+// Corresponds with GET /admin/prompts/{name} (the `ListPromptTemplates` operationId).
+func (c *Client) ListPromptTemplates(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPromptTemplatesRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreatePromptTemplateWithBody Save a new prompt version
+//
+// Requires the ADMIN role. The template is rendered with sample data before it is saved. The new version is used for every ranking from then on; existing rankings keep the version recorded in `ranking_prompt` until they are re-ranked.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /admin/prompts/{name} (the `CreatePromptTemplate` operationId).
+func (c *Client) CreatePromptTemplateWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePromptTemplateRequestWithBody(c.Server, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreatePromptTemplate Save a new prompt version
+//
+// Requires the ADMIN role. The template is rendered with sample data before it is saved. The new version is used for every ranking from then on; existing rankings keep the version recorded in `ranking_prompt` until they are re-ranked.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /admin/prompts/{name} (the `CreatePromptTemplate` operationId).
+func (c *Client) CreatePromptTemplate(ctx context.Context, name string, body CreatePromptTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePromptTemplateRequest(c.Server, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// PreviewPromptTemplateWithBody Preview a prompt against sample reviews
+//
+// Requires the ADMIN role. Classifies each sample review with a stored version or an unsaved draft. No movie is changed. Only `review_ranking` can be previewed.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /admin/prompts/{name}/preview (the `PreviewPromptTemplate` operationId).
+func (c *Client) PreviewPromptTemplateWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewPromptTemplateRequestWithBody(c.Server, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// PreviewPromptTemplate Preview a prompt against sample reviews
+//
+// Requires the ADMIN role. Classifies each sample review with a stored version or an unsaved draft. No movie is changed. Only `review_ranking` can be previewed.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /admin/prompts/{name}/preview (the `PreviewPromptTemplate` operationId).
+func (c *Client) PreviewPromptTemplate(ctx context.Context, name string, body PreviewPromptTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewPromptTemplateRequest(c.Server, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetRankings List the ranking scale
+//
+// Requires the ADMIN role. Returns every tier, best first.
+//
+// Corresponds with GET /admin/rankings (the `GetRankings` operationId).
+func (c *Client) GetRankings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRankingsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ReplaceRankingsWithBody Replace the ranking scale
+//
+// Requires the ADMIN role. Replaces every tier. Each movie moves to the tier of the same name, to the tier that `replaces` its old one, or, when its tier was dropped, to the unranked tier. When the tiers offered to the LLM changed, a `rerank_all` job re-ranks every reviewed movie.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with PUT /admin/rankings (the `ReplaceRankings` operationId).
+func (c *Client) ReplaceRankingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceRankingsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ReplaceRankings Replace the ranking scale
+//
+// Requires the ADMIN role. Replaces every tier. Each movie moves to the tier of the same name, to the tier that `replaces` its old one, or, when its tier was dropped, to the unranked tier. When the tiers offered to the LLM changed, a `rerank_all` job re-ranks every reviewed movie.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with PUT /admin/rankings (the `ReplaceRankings` operationId).
+func (c *Client) ReplaceRankings(ctx context.Context, body ReplaceRankingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceRankingsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetFeed The user's personalized home feed
+//
+// Rows of top picks from the user's favourite genres, one row for each of their first favourite genres, movies trending this week and newly added movies. A movie appears only in the first row that has it. Row sizes are set by the FEED_ROW_LIMITS environment variable, e.g. `top_picks=5,genre=8,genre_rows=3,trending=10,new=10`; a size of 0 leaves the row out. With a profile selected, recommendations follow the profile's favourite genres and maturity setting.
+//
+// Corresponds with GET /feed (the `GetFeed` operationId).
+func (c *Client) GetFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFeedRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetGenres List all genres
+//
+// Served from a short-lived in-process cache that is invalidated when the catalogue changes. Responses carry an ETag and a `public` Cache-Control header for revalidation.
+//
+// Corresponds with GET /genres (the `GetGenres` operationId).
+func (c *Client) GetGenres(ctx context.Context, params *GetGenresParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetGenresRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// LoginUserWithBody Log in and receive auth cookies
+//
+// Sets the `access_token` and `refresh_token` HttpOnly cookies.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /login (the `LoginUser` operationId).
+func (c *Client) LoginUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// LoginUser Log in and receive auth cookies
+//
+// Sets the `access_token` and `refresh_token` HttpOnly cookies.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /login (the `LoginUser` operationId).
+func (c *Client) LoginUser(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// LogoutUserWithBody Log out and clear auth cookies
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /logout (the `LogoutUser` operationId).
+func (c *Client) LogoutUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// LogoutUser Log out and clear auth cookies
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /logout (the `LogoutUser` operationId).
+func (c *Client) LogoutUser(ctx context.Context, body LogoutUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetMovie Get a movie by IMDb ID
+//
+// Served from a short-lived in-process cache that is invalidated when the catalogue changes. Responses carry an ETag and a `private` Cache-Control header for revalidation.
+//
+// Corresponds with GET /movie/{imdb_id} (the `GetMovie` operationId).
+func (c *Client) GetMovie(ctx context.Context, imdbId string, params *GetMovieParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMovieRequest(c.Server, imdbId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RateMovieWithBody Rate a movie
+//
+// Stores the user's score for the movie, replacing any earlier one. Only the first rating of a movie by a user counts towards its popularity.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with PUT /movie/{imdb_id}/rating (the `RateMovie` operationId).
+func (c *Client) RateMovieWithBody(ctx context.Context, imdbId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRateMovieRequestWithBody(c.Server, imdbId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RateMovie Rate a movie
+//
+// Stores the user's score for the movie, replacing any earlier one. Only the first rating of a movie by a user counts towards its popularity.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with PUT /movie/{imdb_id}/rating (the `RateMovie` operationId).
+func (c *Client) RateMovie(ctx context.Context, imdbId string, body RateMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRateMovieRequest(c.Server, imdbId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetMovies List all movies
+//
+// Served from a short-lived in-process cache that is invalidated when the catalogue changes. Responses carry an ETag and a `public` Cache-Control header for revalidation.
+//
+// Corresponds with GET /movies (the `GetMovies` operationId).
+func (c *Client) GetMovies(ctx context.Context, params *GetMoviesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMoviesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// StreamCatalogEvents Stream changes to the catalog
+//
+// Changes are streamed as server-sent events named after their type, each carrying a CatalogEvent:
+//
+// - `movie-added`: a movie was added or imported
+// - `review-updated`: a movie's admin review changed
+// - `ranking-changed`: a movie was given another tier, by a review ranking or a new ranking scale
+//
+// Idle streams get a `: keep-alive` comment every 25 seconds. A client that falls too far behind is disconnected and should reconnect and reload the catalog. On a replica set the events come from a change stream and include writes made through any server; on a standalone MongoDB only writes made through this server are streamed.
+//
+// Corresponds with GET /movies/events (the `StreamCatalogEvents` operationId).
+func (c *Client) StreamCatalogEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamCatalogEventsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetTrendingMovies List trending movies
+//
+// Movies are scored by their views (weight 1), first ratings (3) and watchlist adds (5) in the window. Each hour's activity is halved for every half-life of its age: 6 hours for `day`, 2 days for `week` and 7 days for `month`. Movies without activity in the window are left out. Served from the response cache like `/movies`.
+//
+// Corresponds with GET /movies/trending (the `GetTrendingMovies` operationId).
+func (c *Client) GetTrendingMovies(ctx context.Context, params *GetTrendingMoviesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTrendingMoviesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CompleteOnboarding Derive favourite genres from the user's ratings
+//
+// Each rating counts for the genres of its movie by how far it is above or below 3. Up to three genres that come out ahead replace the favourite genres of the user, or of their selected profile.
+//
+// Corresponds with POST /onboarding/complete (the `CompleteOnboarding` operationId).
+func (c *Client) CompleteOnboarding(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteOnboardingRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetOnboardingMovies Movies for a new user to rate
+//
+// A spread of movies across the catalog for a user without favourite genres to rate with PUT /movie/{imdb_id}/rating. Movies the user already rated are left out.
+//
+// Corresponds with GET /onboarding/movies (the `GetOnboardingMovies` operationId).
+func (c *Client) GetOnboardingMovies(ctx context.Context, params *GetOnboardingMoviesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOnboardingMoviesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ListProfiles The profiles of the user's household
+//
+// Corresponds with GET /profiles (the `ListProfiles` operationId).
+func (c *Client) ListProfiles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProfilesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateProfileWithBody Add a profile to the household
+//
+// A profile has its own favourite genres, ratings, watchlist and maturity setting. An account can have at most 5 profiles.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /profiles (the `CreateProfile` operationId).
+func (c *Client) CreateProfileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProfileRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateProfile Add a profile to the household
+//
+// A profile has its own favourite genres, ratings, watchlist and maturity setting. An account can have at most 5 profiles.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /profiles (the `CreateProfile` operationId).
+func (c *Client) CreateProfile(ctx context.Context, body CreateProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProfileRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// DeleteProfile Delete a profile with its ratings and watchlist
+//
+// Corresponds with DELETE /profiles/{profile_id} (the `DeleteProfile` operationId).
+func (c *Client) DeleteProfile(ctx context.Context, profileId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProfileRequest(c.Server, profileId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// UpdateProfileWithBody Update a profile
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with PUT /profiles/{profile_id} (the `UpdateProfile` operationId).
+func (c *Client) UpdateProfileWithBody(ctx context.Context, profileId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProfileRequestWithBody(c.Server, profileId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// UpdateProfile Update a profile
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with PUT /profiles/{profile_id} (the `UpdateProfile` operationId).
+func (c *Client) UpdateProfile(ctx context.Context, profileId string, body UpdateProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProfileRequest(c.Server, profileId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// SelectProfile Act for a profile of the household
+//
+// Issues new access and refresh token cookies scoped to the profile. Ratings, the watchlist, onboarding and recommendations then belong to the profile, and only movies its maturity setting allows are recommended. Logging in again goes back to the account itself.
+//
+// Corresponds with POST /profiles/{profile_id}/select (the `SelectProfile` operationId).
+func (c *Client) SelectProfile(ctx context.Context, profileId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSelectProfileRequest(c.Server, profileId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ChatRecommendationsWithBody Movies recommended from a request in the user's own words
+//
+// The LLM turns the message into catalog filters, and the answer is streamed as server-sent events:
+//
+// - `filters`: the ChatFilters that were applied
+// - `message`: one sentence introducing the picks, as plain text
+// - `movie`: one Movie per match, best ranked first
+// - `done`: `{"count": n}`
+// - `error`: a Problem, if the stream fails after it started
+//
+// Errors before the stream starts are ordinary problem responses. With a profile selected, only movies its maturity setting allows are streamed.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /recommendations/chat (the `ChatRecommendations` operationId).
+func (c *Client) ChatRecommendationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChatRecommendationsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ChatRecommendations Movies recommended from a request in the user's own words
+//
+// The LLM turns the message into catalog filters, and the answer is streamed as server-sent events:
+//
+// - `filters`: the ChatFilters that were applied
+// - `message`: one sentence introducing the picks, as plain text
+// - `movie`: one Movie per match, best ranked first
+// - `done`: `{"count": n}`
+// - `error`: a Problem, if the stream fails after it started
+//
+// Errors before the stream starts are ordinary problem responses. With a profile selected, only movies its maturity setting allows are streamed.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /recommendations/chat (the `ChatRecommendations` operationId).
+func (c *Client) ChatRecommendations(ctx context.Context, body ChatRecommendationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChatRecommendationsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetRecommendedMovies Movies recommended from the user's favourite genres
+//
+// Movies in the user's favourite genres and their sub-genres, best ranked first. Users without favourite genres get popular movies instead: this month's trending movies, topped up with the best ranked ones. The count is the top_picks limit of FEED_ROW_LIMITS. While an experiment is active, the user's variant chooses the strategy instead and the response is logged as an exposure. With a profile selected, recommendations follow the profile's favourite genres and maturity setting.
+//
+// Corresponds with GET /recommendedmovies (the `GetRecommendedMovies` operationId).
+func (c *Client) GetRecommendedMovies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRecommendedMoviesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RefreshTokens Rotate auth cookies using the refresh token
+//
+// Corresponds with POST /refresh (the `RefreshTokens` operationId).
+func (c *Client) RefreshTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshTokensRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RegisterUserWithBody Register a new user
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /register (the `RegisterUser` operationId).
+func (c *Client) RegisterUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RegisterUser Register a new user
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /register (the `RegisterUser` operationId).
+func (c *Client) RegisterUser(ctx context.Context, body RegisterUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// UpdateAdminReviewWithBody Update the admin review and queue re-ranking
+//
+// Requires the ADMIN role. Stores the review and queues a background job that classifies it with the LLM into one of the configured rankings. Poll `/admin/movies/{imdb_id}/review-status` for the result. Unless the server runs with `AUTO_SUMMARIZE=off`, a job that rewrites the movie's summary is queued too.
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with PATCH /updatereview/{imdb_id} (the `UpdateAdminReview` operationId).
+func (c *Client) UpdateAdminReviewWithBody(ctx context.Context, imdbId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAdminReviewRequestWithBody(c.Server, imdbId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// UpdateAdminReview Update the admin review and queue re-ranking
+//
+// Requires the ADMIN role. Stores the review and queues a background job that classifies it with the LLM into one of the configured rankings. Poll `/admin/movies/{imdb_id}/review-status` for the result. Unless the server runs with `AUTO_SUMMARIZE=off`, a job that rewrites the movie's summary is queued too.
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with PATCH /updatereview/{imdb_id} (the `UpdateAdminReview` operationId).
+func (c *Client) UpdateAdminReview(ctx context.Context, imdbId string, body UpdateAdminReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAdminReviewRequest(c.Server, imdbId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetWatchlist List the movies on the user's watchlist
+//
+// Most recently added first.
+//
+// Corresponds with GET /watchlist (the `GetWatchlist` operationId).
+func (c *Client) GetWatchlist(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWatchlistRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RemoveFromWatchlist Remove a movie from the user's watchlist
+//
+// Corresponds with DELETE /watchlist/{imdb_id} (the `RemoveFromWatchlist` operationId).
+func (c *Client) RemoveFromWatchlist(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveFromWatchlistRequest(c.Server, imdbId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// AddToWatchlist Add a movie to the user's watchlist
+//
+// Adding a movie that is already on the watchlist changes nothing.
+//
+// Corresponds with PUT /watchlist/{imdb_id} (the `AddToWatchlist` operationId).
+func (c *Client) AddToWatchlist(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddToWatchlistRequest(c.Server, imdbId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewAddMovieRequest calls the generic AddMovie builder with application/json body
+func NewAddMovieRequest(server string, body AddMovieJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddMovieRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddMovieRequestWithBody constructs an http.Request for the AddMovie method, with any body, and a specified content type
+func NewAddMovieRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/addmovie")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListExperimentsRequest constructs an http.Request for the ListExperiments method
+func NewListExperimentsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/experiments")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSaveExperimentRequest calls the generic SaveExperiment builder with application/json body
+func NewSaveExperimentRequest(server string, name string, body SaveExperimentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSaveExperimentRequestWithBody(server, name, "application/json", bodyReader)
+}
+
+// NewSaveExperimentRequestWithBody constructs an http.Request for the SaveExperiment method, with any body, and a specified content type
+func NewSaveExperimentRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/experiments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetExperimentResultsRequest constructs an http.Request for the GetExperimentResults method
+func NewGetExperimentResultsRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/experiments/%s/results", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateGenreRequest calls the generic CreateGenre builder with application/json body
+func NewCreateGenreRequest(server string, body CreateGenreJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateGenreRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateGenreRequestWithBody constructs an http.Request for the CreateGenre method, with any body, and a specified content type
+func NewCreateGenreRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/genres")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteGenreRequest constructs an http.Request for the DeleteGenre method
+func NewDeleteGenreRequest(server string, genreId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "genre_id", genreId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/genres/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateGenreRequest calls the generic UpdateGenre builder with application/json body
+func NewUpdateGenreRequest(server string, genreId int, body UpdateGenreJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateGenreRequestWithBody(server, genreId, "application/json", bodyReader)
+}
+
+// NewUpdateGenreRequestWithBody constructs an http.Request for the UpdateGenre method, with any body, and a specified content type
+func NewUpdateGenreRequestWithBody(server string, genreId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "genre_id", genreId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/genres/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetJobRequest constructs an http.Request for the GetJob method
+func NewGetJobRequest(server string, jobId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "job_id", jobId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLLMUsageRequest constructs an http.Request for the GetLLMUsage method
+func NewGetLLMUsageRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/llm/usage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportMoviesRequest constructs an http.Request for the ExportMovies method
+func NewExportMoviesRequest(server string, params *ExportMoviesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/movies/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "format", *params.Format, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportMoviesRequest calls the generic ImportMovies builder with application/json body
+func NewImportMoviesRequest(server string, params *ImportMoviesParams, body ImportMoviesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportMoviesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewImportMoviesRequestWithBody constructs an http.Request for the ImportMovies method, with any body, and a specified content type
+func NewImportMoviesRequestWithBody(server string, params *ImportMoviesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/movies/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "format", *params.Format, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "dry_run", *params.DryRun, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRerankMoviesRequest constructs an http.Request for the RerankMovies method
+func NewRerankMoviesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/movies/rerank")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEnrichMovieRequest constructs an http.Request for the EnrichMovie method
+func NewEnrichMovieRequest(server string, imdbId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "imdb_id", imdbId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/movies/%s/enrich", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReviewStatusRequest constructs an http.Request for the GetReviewStatus method
+func NewGetReviewStatusRequest(server string, imdbId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "imdb_id", imdbId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/movies/%s/review-status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMovieStatsRequest constructs an http.Request for the GetMovieStats method
+func NewGetMovieStatsRequest(server string, imdbId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "imdb_id", imdbId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/movies/%s/stats", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSummarizeMovieRequest constructs an http.Request for the SummarizeMovie method
+func NewSummarizeMovieRequest(server string, imdbId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "imdb_id", imdbId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/movies/%s/summarize", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPromptTemplatesRequest constructs an http.Request for the ListPromptTemplates method
+func NewListPromptTemplatesRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/prompts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePromptTemplateRequest calls the generic CreatePromptTemplate builder with application/json body
+func NewCreatePromptTemplateRequest(server string, name string, body CreatePromptTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePromptTemplateRequestWithBody(server, name, "application/json", bodyReader)
+}
+
+// NewCreatePromptTemplateRequestWithBody constructs an http.Request for the CreatePromptTemplate method, with any body, and a specified content type
+func NewCreatePromptTemplateRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/prompts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPreviewPromptTemplateRequest calls the generic PreviewPromptTemplate builder with application/json body
+func NewPreviewPromptTemplateRequest(server string, name string, body PreviewPromptTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPreviewPromptTemplateRequestWithBody(server, name, "application/json", bodyReader)
+}
+
+// NewPreviewPromptTemplateRequestWithBody constructs an http.Request for the PreviewPromptTemplate method, with any body, and a specified content type
+func NewPreviewPromptTemplateRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/prompts/%s/preview", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRankingsRequest constructs an http.Request for the GetRankings method
+func NewGetRankingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/rankings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReplaceRankingsRequest calls the generic ReplaceRankings builder with application/json body
+func NewReplaceRankingsRequest(server string, body ReplaceRankingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReplaceRankingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReplaceRankingsRequestWithBody constructs an http.Request for the ReplaceRankings method, with any body, and a specified content type
+func NewReplaceRankingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/rankings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetFeedRequest constructs an http.Request for the GetFeed method
+func NewGetFeedRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/feed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetGenresRequest constructs an http.Request for the GetGenres method
+func NewGetGenresRequest(server string, params *GetGenresParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/genres")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithOptions("simple", false, "If-None-Match", *params.IfNoneMatch, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationHeader, Type: "string", Format: ""})
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLoginUserRequest calls the generic LoginUser builder with application/json body
+func NewLoginUserRequest(server string, body LoginUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginUserRequestWithBody constructs an http.Request for the LoginUser method, with any body, and a specified content type
+func NewLoginUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLogoutUserRequest calls the generic LogoutUser builder with application/json body
+func NewLogoutUserRequest(server string, body LogoutUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLogoutUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLogoutUserRequestWithBody constructs an http.Request for the LogoutUser method, with any body, and a specified content type
+func NewLogoutUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMovieRequest constructs an http.Request for the GetMovie method
+func NewGetMovieRequest(server string, imdbId string, params *GetMovieParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "imdb_id", imdbId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/movie/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithOptions("simple", false, "If-None-Match", *params.IfNoneMatch, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationHeader, Type: "string", Format: ""})
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewRateMovieRequest calls the generic RateMovie builder with application/json body
+func NewRateMovieRequest(server string, imdbId string, body RateMovieJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRateMovieRequestWithBody(server, imdbId, "application/json", bodyReader)
+}
+
+// NewRateMovieRequestWithBody constructs an http.Request for the RateMovie method, with any body, and a specified content type
+func NewRateMovieRequestWithBody(server string, imdbId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "imdb_id", imdbId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/movie/%s/rating", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMoviesRequest constructs an http.Request for the GetMovies method
+func NewGetMoviesRequest(server string, params *GetMoviesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/movies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithOptions("simple", false, "If-None-Match", *params.IfNoneMatch, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationHeader, Type: "string", Format: ""})
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewStreamCatalogEventsRequest constructs an http.Request for the StreamCatalogEvents method
+func NewStreamCatalogEventsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/movies/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTrendingMoviesRequest constructs an http.Request for the GetTrendingMovies method
+func NewGetTrendingMoviesRequest(server string, params *GetTrendingMoviesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/movies/trending")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Window != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "window", *params.Window, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithOptions("simple", false, "If-None-Match", *params.IfNoneMatch, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationHeader, Type: "string", Format: ""})
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCompleteOnboardingRequest constructs an http.Request for the CompleteOnboarding method
+func NewCompleteOnboardingRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/onboarding/complete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOnboardingMoviesRequest constructs an http.Request for the GetOnboardingMovies method
+func NewGetOnboardingMoviesRequest(server string, params *GetOnboardingMoviesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/onboarding/movies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListProfilesRequest constructs an http.Request for the ListProfiles method
+func NewListProfilesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/profiles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateProfileRequest calls the generic CreateProfile builder with application/json body
+func NewCreateProfileRequest(server string, body CreateProfileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProfileRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateProfileRequestWithBody constructs an http.Request for the CreateProfile method, with any body, and a specified content type
+func NewCreateProfileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/profiles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProfileRequest constructs an http.Request for the DeleteProfile method
+func NewDeleteProfileRequest(server string, profileId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "profile_id", profileId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/profiles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProfileRequest calls the generic UpdateProfile builder with application/json body
+func NewUpdateProfileRequest(server string, profileId string, body UpdateProfileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProfileRequestWithBody(server, profileId, "application/json", bodyReader)
+}
+
+// NewUpdateProfileRequestWithBody constructs an http.Request for the UpdateProfile method, with any body, and a specified content type
+func NewUpdateProfileRequestWithBody(server string, profileId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "profile_id", profileId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/profiles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSelectProfileRequest constructs an http.Request for the SelectProfile method
+func NewSelectProfileRequest(server string, profileId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "profile_id", profileId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/profiles/%s/select", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewChatRecommendationsRequest calls the generic ChatRecommendations builder with application/json body
+func NewChatRecommendationsRequest(server string, body ChatRecommendationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewChatRecommendationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewChatRecommendationsRequestWithBody constructs an http.Request for the ChatRecommendations method, with any body, and a specified content type
+func NewChatRecommendationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recommendations/chat")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRecommendedMoviesRequest constructs an http.Request for the GetRecommendedMovies method
+func NewGetRecommendedMoviesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recommendedmovies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRefreshTokensRequest constructs an http.Request for the RefreshTokens method
+func NewRefreshTokensRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRegisterUserRequest calls the generic RegisterUser builder with application/json body
+func NewRegisterUserRequest(server string, body RegisterUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegisterUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRegisterUserRequestWithBody constructs an http.Request for the RegisterUser method, with any body, and a specified content type
+func NewRegisterUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateAdminReviewRequest calls the generic UpdateAdminReview builder with application/json body
+func NewUpdateAdminReviewRequest(server string, imdbId string, body UpdateAdminReviewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAdminReviewRequestWithBody(server, imdbId, "application/json", bodyReader)
+}
+
+// NewUpdateAdminReviewRequestWithBody constructs an http.Request for the UpdateAdminReview method, with any body, and a specified content type
+func NewUpdateAdminReviewRequestWithBody(server string, imdbId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "imdb_id", imdbId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/updatereview/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetWatchlistRequest constructs an http.Request for the GetWatchlist method
+func NewGetWatchlistRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/watchlist")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRemoveFromWatchlistRequest constructs an http.Request for the RemoveFromWatchlist method
+func NewRemoveFromWatchlistRequest(server string, imdbId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "imdb_id", imdbId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/watchlist/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddToWatchlistRequest constructs an http.Request for the AddToWatchlist method
+func NewAddToWatchlistRequest(server string, imdbId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "imdb_id", imdbId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/watchlist/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+
+	// AddMovieWithBodyWithResponse Add a movie
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /addmovie (the `AddMovie` operationId).
+	AddMovieWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddMovieResponse, error)
+
+	// AddMovieWithResponse Add a movie
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /addmovie (the `AddMovie` operationId).
+	AddMovieWithResponse(ctx context.Context, body AddMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*AddMovieResponse, error)
+
+	// ListExperimentsWithResponse List recommendation experiments
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /admin/experiments (the `ListExperiments` operationId).
+	ListExperimentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListExperimentsResponse, error)
+
+	// SaveExperimentWithBodyWithResponse Create or replace a recommendation experiment
+	//
+	// Users are bucketed into a variant by a hash of the experiment name and their user_id, so each user sees the same variant for as long as the variants and weights stay the same. Changing them while the experiment is active moves some users to another variant.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /admin/experiments/{name} (the `SaveExperiment` operationId).
+	SaveExperimentWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveExperimentResponse, error)
+
+	// SaveExperimentWithResponse Create or replace a recommendation experiment
+	//
+	// Users are bucketed into a variant by a hash of the experiment name and their user_id, so each user sees the same variant for as long as the variants and weights stay the same. Changing them while the experiment is active moves some users to another variant.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /admin/experiments/{name} (the `SaveExperiment` operationId).
+	SaveExperimentWithResponse(ctx context.Context, name string, body SaveExperimentJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveExperimentResponse, error)
+
+	// GetExperimentResultsWithResponse Compare an experiment's variants
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /admin/experiments/{name}/results (the `GetExperimentResults` operationId).
+	GetExperimentResultsWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetExperimentResultsResponse, error)
+
+	// CreateGenreWithBodyWithResponse Create a genre
+	//
+	// Requires the ADMIN role. Set `parent_id` to create a sub-genre.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /admin/genres (the `CreateGenre` operationId).
+	CreateGenreWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGenreResponse, error)
+
+	// CreateGenreWithResponse Create a genre
+	//
+	// Requires the ADMIN role. Set `parent_id` to create a sub-genre.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /admin/genres (the `CreateGenre` operationId).
+	CreateGenreWithResponse(ctx context.Context, body CreateGenreJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateGenreResponse, error)
+
+	// DeleteGenreWithResponse Delete a genre
+	//
+	// Requires the ADMIN role. Only a genre that no movie, user or sub-genre refers to can be deleted.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /admin/genres/{genre_id} (the `DeleteGenre` operationId).
+	DeleteGenreWithResponse(ctx context.Context, genreId int, reqEditors ...RequestEditorFn) (*DeleteGenreResponse, error)
+
+	// UpdateGenreWithBodyWithResponse Rename or move a genre
+	//
+	// Requires the ADMIN role. Replaces the genre's name and parent. After a rename a `genre_cascade` job updates the copies of the genre embedded in movies, users and profiles.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /admin/genres/{genre_id} (the `UpdateGenre` operationId).
+	UpdateGenreWithBodyWithResponse(ctx context.Context, genreId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGenreResponse, error)
+
+	// UpdateGenreWithResponse Rename or move a genre
+	//
+	// Requires the ADMIN role. Replaces the genre's name and parent. After a rename a `genre_cascade` job updates the copies of the genre embedded in movies, users and profiles.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /admin/genres/{genre_id} (the `UpdateGenre` operationId).
+	UpdateGenreWithResponse(ctx context.Context, genreId int, body UpdateGenreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGenreResponse, error)
+
+	// GetJobWithResponse Get a background job
+	//
+	// Requires the ADMIN role.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /admin/jobs/{job_id} (the `GetJob` operationId).
+	GetJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*GetJobResponse, error)
+
+	// GetLLMUsageWithResponse Get today's LLM usage
+	//
+	// Requires the ADMIN role. Reports today's (UTC) model calls, cache hits, tokens and estimated cost against the daily budget. Once a budget is spent, review ranking jobs wait until it resets.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /admin/llm/usage (the `GetLLMUsage` operationId).
+	GetLLMUsageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLLMUsageResponse, error)
+
+	// ExportMoviesWithResponse Export the catalogue
+	//
+	// Requires the ADMIN role. Streams every movie ordered by `imdb_id` in a form the import endpoint accepts.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /admin/movies/export (the `ExportMovies` operationId).
+	ExportMoviesWithResponse(ctx context.Context, params *ExportMoviesParams, reqEditors ...RequestEditorFn) (*ExportMoviesResponse, error)
+
+	// ImportMoviesWithBodyWithResponse Bulk import movies
+	//
+	// Requires the ADMIN role. Upserts movies by `imdb_id`. The body is a JSON array of movies or a CSV file with the header `imdb_id,title,poster_path,youtube_id,genre,admin_review,ranking_value,ranking_name`; CSV genres are written as `4:Fantasy|7:Action`. Invalid rows, including rows naming a `genre_id` that does not exist, are reported and skipped. An empty `admin_review` keeps the stored review; a movie whose review changes is ranked and summarized again, as after a review update.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /admin/movies/import (the `ImportMovies` operationId).
+	ImportMoviesWithBodyWithResponse(ctx context.Context, params *ImportMoviesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportMoviesResponse, error)
+
+	// ImportMoviesWithResponse Bulk import movies
+	//
+	// Requires the ADMIN role. Upserts movies by `imdb_id`. The body is a JSON array of movies or a CSV file with the header `imdb_id,title,poster_path,youtube_id,genre,admin_review,ranking_value,ranking_name`; CSV genres are written as `4:Fantasy|7:Action`. Invalid rows, including rows naming a `genre_id` that does not exist, are reported and skipped. An empty `admin_review` keeps the stored review; a movie whose review changes is ranked and summarized again, as after a review update.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /admin/movies/import (the `ImportMovies` operationId).
+	ImportMoviesWithResponse(ctx context.Context, params *ImportMoviesParams, body ImportMoviesJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportMoviesResponse, error)
+
+	// RerankMoviesWithResponse Re-rank every reviewed movie
+	//
+	// Requires the ADMIN role. Queues a job that queues a review ranking job for every movie with an admin review. If one is already pending, that job is returned. The server also queues it by itself when the rankings collection changes.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /admin/movies/rerank (the `RerankMovies` operationId).
+	RerankMoviesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RerankMoviesResponse, error)
+
+	// EnrichMovieWithResponse Refresh a movie's metadata
+	//
+	// Requires the ADMIN role. Looks the movie up in the configured metadata provider and stores the result. Movies are also enriched when added and by a background refresh job.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /admin/movies/{imdb_id}/enrich (the `EnrichMovie` operationId).
+	EnrichMovieWithResponse(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*EnrichMovieResponse, error)
+
+	// GetReviewStatusWithResponse Get a movie's review ranking status
+	//
+	// Requires the ADMIN role. Returns the most recently queued review ranking job for the movie.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /admin/movies/{imdb_id}/review-status (the `GetReviewStatus` operationId).
+	GetReviewStatusWithResponse(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*GetReviewStatusResponse, error)
+
+	// GetMovieStatsWithResponse Get a movie's popularity statistics
+	//
+	// Requires the ADMIN role. Returns the movie's activity and score in each trending window, with its ratings and watchlist entries of all time.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /admin/movies/{imdb_id}/stats (the `GetMovieStats` operationId).
+	GetMovieStatsWithResponse(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*GetMovieStatsResponse, error)
+
+	// SummarizeMovieWithResponse Regenerate a movie's summary
+	//
+	// Requires the ADMIN role. Queues a job that has the LLM write the movie's synopsis, mood tags and content warnings from its admin review and metadata. A movie with neither a review nor a metadata overview cannot be summarized, and its job fails.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /admin/movies/{imdb_id}/summarize (the `SummarizeMovie` operationId).
+	SummarizeMovieWithResponse(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*SummarizeMovieResponse, error)
+
+	// ListPromptTemplatesWithResponse List the versions of a prompt
+	//
+	// Requires the ADMIN role. Returns built-in and saved versions, newest first. The first one is in use.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /admin/prompts/{name} (the `ListPromptTemplates` operationId).
+	ListPromptTemplatesWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*ListPromptTemplatesResponse, error)
+
+	// CreatePromptTemplateWithBodyWithResponse Save a new prompt version
+	//
+	// Requires the ADMIN role. The template is rendered with sample data before it is saved. The new version is used for every ranking from then on; existing rankings keep the version recorded in `ranking_prompt` until they are re-ranked.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /admin/prompts/{name} (the `CreatePromptTemplate` operationId).
+	CreatePromptTemplateWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePromptTemplateResponse, error)
+
+	// CreatePromptTemplateWithResponse Save a new prompt version
+	//
+	// Requires the ADMIN role. The template is rendered with sample data before it is saved. The new version is used for every ranking from then on; existing rankings keep the version recorded in `ranking_prompt` until they are re-ranked.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /admin/prompts/{name} (the `CreatePromptTemplate` operationId).
+	CreatePromptTemplateWithResponse(ctx context.Context, name string, body CreatePromptTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePromptTemplateResponse, error)
+
+	// PreviewPromptTemplateWithBodyWithResponse Preview a prompt against sample reviews
+	//
+	// Requires the ADMIN role. Classifies each sample review with a stored version or an unsaved draft. No movie is changed. Only `review_ranking` can be previewed.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /admin/prompts/{name}/preview (the `PreviewPromptTemplate` operationId).
+	PreviewPromptTemplateWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewPromptTemplateResponse, error)
+
+	// PreviewPromptTemplateWithResponse Preview a prompt against sample reviews
+	//
+	// Requires the ADMIN role. Classifies each sample review with a stored version or an unsaved draft. No movie is changed. Only `review_ranking` can be previewed.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /admin/prompts/{name}/preview (the `PreviewPromptTemplate` operationId).
+	PreviewPromptTemplateWithResponse(ctx context.Context, name string, body PreviewPromptTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewPromptTemplateResponse, error)
+
+	// GetRankingsWithResponse List the ranking scale
+	//
+	// Requires the ADMIN role. Returns every tier, best first.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /admin/rankings (the `GetRankings` operationId).
+	GetRankingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRankingsResponse, error)
+
+	// ReplaceRankingsWithBodyWithResponse Replace the ranking scale
+	//
+	// Requires the ADMIN role. Replaces every tier. Each movie moves to the tier of the same name, to the tier that `replaces` its old one, or, when its tier was dropped, to the unranked tier. When the tiers offered to the LLM changed, a `rerank_all` job re-ranks every reviewed movie.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /admin/rankings (the `ReplaceRankings` operationId).
+	ReplaceRankingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceRankingsResponse, error)
+
+	// ReplaceRankingsWithResponse Replace the ranking scale
+	//
+	// Requires the ADMIN role. Replaces every tier. Each movie moves to the tier of the same name, to the tier that `replaces` its old one, or, when its tier was dropped, to the unranked tier. When the tiers offered to the LLM changed, a `rerank_all` job re-ranks every reviewed movie.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /admin/rankings (the `ReplaceRankings` operationId).
+	ReplaceRankingsWithResponse(ctx context.Context, body ReplaceRankingsJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceRankingsResponse, error)
+
+	// GetFeedWithResponse The user's personalized home feed
+	//
+	// Rows of top picks from the user's favourite genres, one row for each of their first favourite genres, movies trending this week and newly added movies. A movie appears only in the first row that has it. Row sizes are set by the FEED_ROW_LIMITS environment variable, e.g. `top_picks=5,genre=8,genre_rows=3,trending=10,new=10`; a size of 0 leaves the row out. With a profile selected, recommendations follow the profile's favourite genres and maturity setting.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /feed (the `GetFeed` operationId).
+	GetFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFeedResponse, error)
+
+	// GetGenresWithResponse List all genres
+	//
+	// Served from a short-lived in-process cache that is invalidated when the catalogue changes. Responses carry an ETag and a `public` Cache-Control header for revalidation.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /genres (the `GetGenres` operationId).
+	GetGenresWithResponse(ctx context.Context, params *GetGenresParams, reqEditors ...RequestEditorFn) (*GetGenresResponse, error)
+
+	// LoginUserWithBodyWithResponse Log in and receive auth cookies
+	//
+	// Sets the `access_token` and `refresh_token` HttpOnly cookies.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /login (the `LoginUser` operationId).
+	LoginUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserResponse, error)
+
+	// LoginUserWithResponse Log in and receive auth cookies
+	//
+	// Sets the `access_token` and `refresh_token` HttpOnly cookies.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /login (the `LoginUser` operationId).
+	LoginUserWithResponse(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserResponse, error)
+
+	// LogoutUserWithBodyWithResponse Log out and clear auth cookies
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /logout (the `LogoutUser` operationId).
+	LogoutUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LogoutUserResponse, error)
+
+	// LogoutUserWithResponse Log out and clear auth cookies
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /logout (the `LogoutUser` operationId).
+	LogoutUserWithResponse(ctx context.Context, body LogoutUserJSONRequestBody, reqEditors ...RequestEditorFn) (*LogoutUserResponse, error)
+
+	// GetMovieWithResponse Get a movie by IMDb ID
+	//
+	// Served from a short-lived in-process cache that is invalidated when the catalogue changes. Responses carry an ETag and a `private` Cache-Control header for revalidation.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /movie/{imdb_id} (the `GetMovie` operationId).
+	GetMovieWithResponse(ctx context.Context, imdbId string, params *GetMovieParams, reqEditors ...RequestEditorFn) (*GetMovieResponse, error)
+
+	// RateMovieWithBodyWithResponse Rate a movie
+	//
+	// Stores the user's score for the movie, replacing any earlier one. Only the first rating of a movie by a user counts towards its popularity.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /movie/{imdb_id}/rating (the `RateMovie` operationId).
+	RateMovieWithBodyWithResponse(ctx context.Context, imdbId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RateMovieResponse, error)
+
+	// RateMovieWithResponse Rate a movie
+	//
+	// Stores the user's score for the movie, replacing any earlier one. Only the first rating of a movie by a user counts towards its popularity.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /movie/{imdb_id}/rating (the `RateMovie` operationId).
+	RateMovieWithResponse(ctx context.Context, imdbId string, body RateMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*RateMovieResponse, error)
+
+	// GetMoviesWithResponse List all movies
+	//
+	// Served from a short-lived in-process cache that is invalidated when the catalogue changes. Responses carry an ETag and a `public` Cache-Control header for revalidation.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /movies (the `GetMovies` operationId).
+	GetMoviesWithResponse(ctx context.Context, params *GetMoviesParams, reqEditors ...RequestEditorFn) (*GetMoviesResponse, error)
+
+	// StreamCatalogEventsWithResponse Stream changes to the catalog
+	//
+	// Changes are streamed as server-sent events named after their type, each carrying a CatalogEvent:
+	//
+	// - `movie-added`: a movie was added or imported
+	// - `review-updated`: a movie's admin review changed
+	// - `ranking-changed`: a movie was given another tier, by a review ranking or a new ranking scale
+	//
+	// Idle streams get a `: keep-alive` comment every 25 seconds. A client that falls too far behind is disconnected and should reconnect and reload the catalog. On a replica set the events come from a change stream and include writes made through any server; on a standalone MongoDB only writes made through this server are streamed.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /movies/events (the `StreamCatalogEvents` operationId).
+	StreamCatalogEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamCatalogEventsResponse, error)
+
+	// GetTrendingMoviesWithResponse List trending movies
+	//
+	// Movies are scored by their views (weight 1), first ratings (3) and watchlist adds (5) in the window. Each hour's activity is halved for every half-life of its age: 6 hours for `day`, 2 days for `week` and 7 days for `month`. Movies without activity in the window are left out. Served from the response cache like `/movies`.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /movies/trending (the `GetTrendingMovies` operationId).
+	GetTrendingMoviesWithResponse(ctx context.Context, params *GetTrendingMoviesParams, reqEditors ...RequestEditorFn) (*GetTrendingMoviesResponse, error)
+
+	// CompleteOnboardingWithResponse Derive favourite genres from the user's ratings
+	//
+	// Each rating counts for the genres of its movie by how far it is above or below 3. Up to three genres that come out ahead replace the favourite genres of the user, or of their selected profile.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /onboarding/complete (the `CompleteOnboarding` operationId).
+	CompleteOnboardingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CompleteOnboardingResponse, error)
+
+	// GetOnboardingMoviesWithResponse Movies for a new user to rate
+	//
+	// A spread of movies across the catalog for a user without favourite genres to rate with PUT /movie/{imdb_id}/rating. Movies the user already rated are left out.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /onboarding/movies (the `GetOnboardingMovies` operationId).
+	GetOnboardingMoviesWithResponse(ctx context.Context, params *GetOnboardingMoviesParams, reqEditors ...RequestEditorFn) (*GetOnboardingMoviesResponse, error)
+
+	// ListProfilesWithResponse The profiles of the user's household
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /profiles (the `ListProfiles` operationId).
+	ListProfilesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProfilesResponse, error)
+
+	// CreateProfileWithBodyWithResponse Add a profile to the household
+	//
+	// A profile has its own favourite genres, ratings, watchlist and maturity setting. An account can have at most 5 profiles.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /profiles (the `CreateProfile` operationId).
+	CreateProfileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProfileResponse, error)
+
+	// CreateProfileWithResponse Add a profile to the household
+	//
+	// A profile has its own favourite genres, ratings, watchlist and maturity setting. An account can have at most 5 profiles.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /profiles (the `CreateProfile` operationId).
+	CreateProfileWithResponse(ctx context.Context, body CreateProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProfileResponse, error)
+
+	// DeleteProfileWithResponse Delete a profile with its ratings and watchlist
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /profiles/{profile_id} (the `DeleteProfile` operationId).
+	DeleteProfileWithResponse(ctx context.Context, profileId string, reqEditors ...RequestEditorFn) (*DeleteProfileResponse, error)
+
+	// UpdateProfileWithBodyWithResponse Update a profile
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /profiles/{profile_id} (the `UpdateProfile` operationId).
+	UpdateProfileWithBodyWithResponse(ctx context.Context, profileId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProfileResponse, error)
+
+	// UpdateProfileWithResponse Update a profile
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /profiles/{profile_id} (the `UpdateProfile` operationId).
+	UpdateProfileWithResponse(ctx context.Context, profileId string, body UpdateProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProfileResponse, error)
+
+	// SelectProfileWithResponse Act for a profile of the household
+	//
+	// Issues new access and refresh token cookies scoped to the profile. Ratings, the watchlist, onboarding and recommendations then belong to the profile, and only movies its maturity setting allows are recommended. Logging in again goes back to the account itself.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /profiles/{profile_id}/select (the `SelectProfile` operationId).
+	SelectProfileWithResponse(ctx context.Context, profileId string, reqEditors ...RequestEditorFn) (*SelectProfileResponse, error)
+
+	// ChatRecommendationsWithBodyWithResponse Movies recommended from a request in the user's own words
+	//
+	// The LLM turns the message into catalog filters, and the answer is streamed as server-sent events:
+	//
+	// - `filters`: the ChatFilters that were applied
+	// - `message`: one sentence introducing the picks, as plain text
+	// - `movie`: one Movie per match, best ranked first
+	// - `done`: `{"count": n}`
+	// - `error`: a Problem, if the stream fails after it started
+	//
+	// Errors before the stream starts are ordinary problem responses. With a profile selected, only movies its maturity setting allows are streamed.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /recommendations/chat (the `ChatRecommendations` operationId).
+	ChatRecommendationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChatRecommendationsResponse, error)
+
+	// ChatRecommendationsWithResponse Movies recommended from a request in the user's own words
+	//
+	// The LLM turns the message into catalog filters, and the answer is streamed as server-sent events:
+	//
+	// - `filters`: the ChatFilters that were applied
+	// - `message`: one sentence introducing the picks, as plain text
+	// - `movie`: one Movie per match, best ranked first
+	// - `done`: `{"count": n}`
+	// - `error`: a Problem, if the stream fails after it started
+	//
+	// Errors before the stream starts are ordinary problem responses. With a profile selected, only movies its maturity setting allows are streamed.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /recommendations/chat (the `ChatRecommendations` operationId).
+	ChatRecommendationsWithResponse(ctx context.Context, body ChatRecommendationsJSONRequestBody, reqEditors ...RequestEditorFn) (*ChatRecommendationsResponse, error)
+
+	// GetRecommendedMoviesWithResponse Movies recommended from the user's favourite genres
+	//
+	// Movies in the user's favourite genres and their sub-genres, best ranked first. Users without favourite genres get popular movies instead: this month's trending movies, topped up with the best ranked ones. The count is the top_picks limit of FEED_ROW_LIMITS. While an experiment is active, the user's variant chooses the strategy instead and the response is logged as an exposure. With a profile selected, recommendations follow the profile's favourite genres and maturity setting.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /recommendedmovies (the `GetRecommendedMovies` operationId).
+	GetRecommendedMoviesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRecommendedMoviesResponse, error)
+
+	// RefreshTokensWithResponse Rotate auth cookies using the refresh token
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /refresh (the `RefreshTokens` operationId).
+	RefreshTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RefreshTokensResponse, error)
+
+	// RegisterUserWithBodyWithResponse Register a new user
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /register (the `RegisterUser` operationId).
+	RegisterUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterUserResponse, error)
+
+	// RegisterUserWithResponse Register a new user
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /register (the `RegisterUser` operationId).
+	RegisterUserWithResponse(ctx context.Context, body RegisterUserJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterUserResponse, error)
+
+	// UpdateAdminReviewWithBodyWithResponse Update the admin review and queue re-ranking
+	//
+	// Requires the ADMIN role. Stores the review and queues a background job that classifies it with the LLM into one of the configured rankings. Poll `/admin/movies/{imdb_id}/review-status` for the result. Unless the server runs with `AUTO_SUMMARIZE=off`, a job that rewrites the movie's summary is queued too.
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PATCH /updatereview/{imdb_id} (the `UpdateAdminReview` operationId).
+	UpdateAdminReviewWithBodyWithResponse(ctx context.Context, imdbId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAdminReviewResponse, error)
+
+	// UpdateAdminReviewWithResponse Update the admin review and queue re-ranking
+	//
+	// Requires the ADMIN role. Stores the review and queues a background job that classifies it with the LLM into one of the configured rankings. Poll `/admin/movies/{imdb_id}/review-status` for the result. Unless the server runs with `AUTO_SUMMARIZE=off`, a job that rewrites the movie's summary is queued too.
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PATCH /updatereview/{imdb_id} (the `UpdateAdminReview` operationId).
+	UpdateAdminReviewWithResponse(ctx context.Context, imdbId string, body UpdateAdminReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAdminReviewResponse, error)
+
+	// GetWatchlistWithResponse List the movies on the user's watchlist
+	//
+	// Most recently added first.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /watchlist (the `GetWatchlist` operationId).
+	GetWatchlistWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWatchlistResponse, error)
+
+	// RemoveFromWatchlistWithResponse Remove a movie from the user's watchlist
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /watchlist/{imdb_id} (the `RemoveFromWatchlist` operationId).
+	RemoveFromWatchlistWithResponse(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*RemoveFromWatchlistResponse, error)
+
+	// AddToWatchlistWithResponse Add a movie to the user's watchlist
+	//
+	// Adding a movie that is already on the watchlist changes nothing.
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /watchlist/{imdb_id} (the `AddToWatchlist` operationId).
+	AddToWatchlistWithResponse(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*AddToWatchlistResponse, error)
+}
+
+type AddMovieResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *InsertResult
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON409 the response for an HTTP 409 `application/problem+json` response
+	ApplicationproblemJSON409 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r AddMovieResponse) GetJSON201() *InsertResult {
+	return r.JSON201
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r AddMovieResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r AddMovieResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON409 returns the response for an HTTP 409 `application/problem+json` response
+func (r AddMovieResponse) GetApplicationproblemJSON409() *Problem {
+	return r.ApplicationproblemJSON409
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r AddMovieResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r AddMovieResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r AddMovieResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddMovieResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r AddMovieResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListExperimentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]Experiment
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListExperimentsResponse) GetJSON200() *[]Experiment {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r ListExperimentsResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r ListExperimentsResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r ListExperimentsResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r ListExperimentsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListExperimentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListExperimentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListExperimentsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type SaveExperimentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Experiment
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *Experiment
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON409 the response for an HTTP 409 `application/problem+json` response
+	ApplicationproblemJSON409 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r SaveExperimentResponse) GetJSON200() *Experiment {
+	return r.JSON200
+}
+
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r SaveExperimentResponse) GetJSON201() *Experiment {
+	return r.JSON201
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r SaveExperimentResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r SaveExperimentResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r SaveExperimentResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON409 returns the response for an HTTP 409 `application/problem+json` response
+func (r SaveExperimentResponse) GetApplicationproblemJSON409() *Problem {
+	return r.ApplicationproblemJSON409
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r SaveExperimentResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r SaveExperimentResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r SaveExperimentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SaveExperimentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r SaveExperimentResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetExperimentResultsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ExperimentResults
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetExperimentResultsResponse) GetJSON200() *ExperimentResults {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r GetExperimentResultsResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r GetExperimentResultsResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r GetExperimentResultsResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r GetExperimentResultsResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r GetExperimentResultsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetExperimentResultsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetExperimentResultsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetExperimentResultsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// CreateGenreResponse201Headers the declared response headers of an HTTP 201 response for CreateGenre
+type CreateGenreResponse201Headers struct {
+	Location *string
+}
+
+type CreateGenreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *Genre
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON409 the response for an HTTP 409 `application/problem+json` response
+	ApplicationproblemJSON409 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+	// Headers201 the parsed response headers for an HTTP 201 response
+	Headers201 *CreateGenreResponse201Headers
+}
+
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r CreateGenreResponse) GetJSON201() *Genre {
+	return r.JSON201
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r CreateGenreResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r CreateGenreResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r CreateGenreResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON409 returns the response for an HTTP 409 `application/problem+json` response
+func (r CreateGenreResponse) GetApplicationproblemJSON409() *Problem {
+	return r.ApplicationproblemJSON409
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r CreateGenreResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r CreateGenreResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateGenreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateGenreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateGenreResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteGenreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON409 the response for an HTTP 409 `application/problem+json` response
+	ApplicationproblemJSON409 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r DeleteGenreResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r DeleteGenreResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r DeleteGenreResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON409 returns the response for an HTTP 409 `application/problem+json` response
+func (r DeleteGenreResponse) GetApplicationproblemJSON409() *Problem {
+	return r.ApplicationproblemJSON409
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r DeleteGenreResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r DeleteGenreResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteGenreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteGenreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteGenreResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateGenreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *GenreUpdate
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r UpdateGenreResponse) GetJSON200() *GenreUpdate {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r UpdateGenreResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r UpdateGenreResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r UpdateGenreResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r UpdateGenreResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r UpdateGenreResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r UpdateGenreResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateGenreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateGenreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateGenreResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Job
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetJobResponse) GetJSON200() *Job {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r GetJobResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r GetJobResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r GetJobResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r GetJobResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r GetJobResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetJobResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetLLMUsageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *LLMUsage
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetLLMUsageResponse) GetJSON200() *LLMUsage {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r GetLLMUsageResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r GetLLMUsageResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r GetLLMUsageResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r GetLLMUsageResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLLMUsageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLLMUsageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetLLMUsageResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ExportMoviesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]Movie
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ExportMoviesResponse) GetJSON200() *[]Movie {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r ExportMoviesResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r ExportMoviesResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r ExportMoviesResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r ExportMoviesResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r ExportMoviesResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportMoviesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportMoviesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ExportMoviesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ImportMoviesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ImportReport
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ImportMoviesResponse) GetJSON200() *ImportReport {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r ImportMoviesResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r ImportMoviesResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r ImportMoviesResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r ImportMoviesResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r ImportMoviesResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportMoviesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportMoviesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ImportMoviesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// RerankMoviesResponse202Headers the declared response headers of an HTTP 202 response for RerankMovies
+type RerankMoviesResponse202Headers struct {
+	Location *string
+}
+
+type RerankMoviesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON202 the response for an HTTP 202 `application/json` response
+	JSON202 *Job
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+	// Headers202 the parsed response headers for an HTTP 202 response
+	Headers202 *RerankMoviesResponse202Headers
+}
+
+// GetJSON202 returns the response for an HTTP 202 `application/json` response
+func (r RerankMoviesResponse) GetJSON202() *Job {
+	return r.JSON202
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r RerankMoviesResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r RerankMoviesResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r RerankMoviesResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r RerankMoviesResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r RerankMoviesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RerankMoviesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r RerankMoviesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type EnrichMovieResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Metadata
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+	// ApplicationproblemJSON502 the response for an HTTP 502 `application/problem+json` response
+	ApplicationproblemJSON502 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r EnrichMovieResponse) GetJSON200() *Metadata {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r EnrichMovieResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r EnrichMovieResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r EnrichMovieResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r EnrichMovieResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetApplicationproblemJSON502 returns the response for an HTTP 502 `application/problem+json` response
+func (r EnrichMovieResponse) GetApplicationproblemJSON502() *Problem {
+	return r.ApplicationproblemJSON502
+}
+
+// GetBody returns the raw response body bytes
+func (r EnrichMovieResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r EnrichMovieResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnrichMovieResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r EnrichMovieResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetReviewStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Job
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetReviewStatusResponse) GetJSON200() *Job {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r GetReviewStatusResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r GetReviewStatusResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r GetReviewStatusResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r GetReviewStatusResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r GetReviewStatusResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReviewStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReviewStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetReviewStatusResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetMovieStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *MovieStats
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetMovieStatsResponse) GetJSON200() *MovieStats {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r GetMovieStatsResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r GetMovieStatsResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r GetMovieStatsResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r GetMovieStatsResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r GetMovieStatsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMovieStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMovieStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetMovieStatsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// SummarizeMovieResponse202Headers the declared response headers of an HTTP 202 response for SummarizeMovie
+type SummarizeMovieResponse202Headers struct {
+	Location *string
+}
+
+type SummarizeMovieResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON202 the response for an HTTP 202 `application/json` response
+	JSON202 *Job
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+	// Headers202 the parsed response headers for an HTTP 202 response
+	Headers202 *SummarizeMovieResponse202Headers
+}
+
+// GetJSON202 returns the response for an HTTP 202 `application/json` response
+func (r SummarizeMovieResponse) GetJSON202() *Job {
+	return r.JSON202
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r SummarizeMovieResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r SummarizeMovieResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r SummarizeMovieResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r SummarizeMovieResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r SummarizeMovieResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r SummarizeMovieResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SummarizeMovieResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r SummarizeMovieResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListPromptTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]PromptTemplate
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListPromptTemplatesResponse) GetJSON200() *[]PromptTemplate {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r ListPromptTemplatesResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r ListPromptTemplatesResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r ListPromptTemplatesResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r ListPromptTemplatesResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r ListPromptTemplatesResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPromptTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPromptTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListPromptTemplatesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreatePromptTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *PromptTemplate
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON409 the response for an HTTP 409 `application/problem+json` response
+	ApplicationproblemJSON409 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r CreatePromptTemplateResponse) GetJSON201() *PromptTemplate {
+	return r.JSON201
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r CreatePromptTemplateResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r CreatePromptTemplateResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r CreatePromptTemplateResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r CreatePromptTemplateResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON409 returns the response for an HTTP 409 `application/problem+json` response
+func (r CreatePromptTemplateResponse) GetApplicationproblemJSON409() *Problem {
+	return r.ApplicationproblemJSON409
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r CreatePromptTemplateResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r CreatePromptTemplateResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePromptTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePromptTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreatePromptTemplateResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PreviewPromptTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *PromptPreview
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON429 the response for an HTTP 429 `application/problem+json` response
+	ApplicationproblemJSON429 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+	// ApplicationproblemJSON502 the response for an HTTP 502 `application/problem+json` response
+	ApplicationproblemJSON502 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r PreviewPromptTemplateResponse) GetJSON200() *PromptPreview {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r PreviewPromptTemplateResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r PreviewPromptTemplateResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r PreviewPromptTemplateResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r PreviewPromptTemplateResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON429 returns the response for an HTTP 429 `application/problem+json` response
+func (r PreviewPromptTemplateResponse) GetApplicationproblemJSON429() *Problem {
+	return r.ApplicationproblemJSON429
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r PreviewPromptTemplateResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetApplicationproblemJSON502 returns the response for an HTTP 502 `application/problem+json` response
+func (r PreviewPromptTemplateResponse) GetApplicationproblemJSON502() *Problem {
+	return r.ApplicationproblemJSON502
+}
+
+// GetBody returns the raw response body bytes
+func (r PreviewPromptTemplateResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r PreviewPromptTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreviewPromptTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PreviewPromptTemplateResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetRankingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]Ranking
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetRankingsResponse) GetJSON200() *[]Ranking {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r GetRankingsResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r GetRankingsResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r GetRankingsResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r GetRankingsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRankingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRankingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetRankingsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ReplaceRankingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *RankingScaleUpdate
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ReplaceRankingsResponse) GetJSON200() *RankingScaleUpdate {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r ReplaceRankingsResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r ReplaceRankingsResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r ReplaceRankingsResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r ReplaceRankingsResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r ReplaceRankingsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplaceRankingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplaceRankingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ReplaceRankingsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Feed
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetFeedResponse) GetJSON200() *Feed {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r GetFeedResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r GetFeedResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r GetFeedResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r GetFeedResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetFeedResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// GetGenresResponse200Headers the declared response headers of an HTTP 200 response for GetGenres
+type GetGenresResponse200Headers struct {
+	CacheControl *string
+	ETag         *string
+}
+
+// GetGenresResponse304Headers the declared response headers of an HTTP 304 response for GetGenres
+type GetGenresResponse304Headers struct {
+	CacheControl *string
+	ETag         *string
+}
+
+type GetGenresResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]Genre
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+	// Headers200 the parsed response headers for an HTTP 200 response
+	Headers200 *GetGenresResponse200Headers
+	// Headers304 the parsed response headers for an HTTP 304 response
+	Headers304 *GetGenresResponse304Headers
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetGenresResponse) GetJSON200() *[]Genre {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r GetGenresResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r GetGenresResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetGenresResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetGenresResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetGenresResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// LoginUserResponse200Headers the declared response headers of an HTTP 200 response for LoginUser
+type LoginUserResponse200Headers struct {
+	SetCookie *string
+}
+
+type LoginUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *UserResponse
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+	// Headers200 the parsed response headers for an HTTP 200 response
+	Headers200 *LoginUserResponse200Headers
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r LoginUserResponse) GetJSON200() *UserResponse {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r LoginUserResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r LoginUserResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r LoginUserResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r LoginUserResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r LoginUserResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type LogoutUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Message
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r LogoutUserResponse) GetJSON200() *Message {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r LogoutUserResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r LogoutUserResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r LogoutUserResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r LogoutUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogoutUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r LogoutUserResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// GetMovieResponse200Headers the declared response headers of an HTTP 200 response for GetMovie
+type GetMovieResponse200Headers struct {
+	CacheControl *string
+	ETag         *string
+}
+
+// GetMovieResponse304Headers the declared response headers of an HTTP 304 response for GetMovie
+type GetMovieResponse304Headers struct {
+	CacheControl *string
+	ETag         *string
+}
+
+type GetMovieResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Movie
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+	// Headers200 the parsed response headers for an HTTP 200 response
+	Headers200 *GetMovieResponse200Headers
+	// Headers304 the parsed response headers for an HTTP 304 response
+	Headers304 *GetMovieResponse304Headers
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetMovieResponse) GetJSON200() *Movie {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r GetMovieResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r GetMovieResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r GetMovieResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r GetMovieResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r GetMovieResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMovieResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMovieResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetMovieResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type RateMovieResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Rating
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r RateMovieResponse) GetJSON200() *Rating {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r RateMovieResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r RateMovieResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r RateMovieResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r RateMovieResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r RateMovieResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r RateMovieResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RateMovieResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r RateMovieResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// GetMoviesResponse200Headers the declared response headers of an HTTP 200 response for GetMovies
+type GetMoviesResponse200Headers struct {
+	CacheControl *string
+	ETag         *string
+}
+
+// GetMoviesResponse304Headers the declared response headers of an HTTP 304 response for GetMovies
+type GetMoviesResponse304Headers struct {
+	CacheControl *string
+	ETag         *string
+}
+
+type GetMoviesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]Movie
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+	// Headers200 the parsed response headers for an HTTP 200 response
+	Headers200 *GetMoviesResponse200Headers
+	// Headers304 the parsed response headers for an HTTP 304 response
+	Headers304 *GetMoviesResponse304Headers
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetMoviesResponse) GetJSON200() *[]Movie {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r GetMoviesResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r GetMoviesResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMoviesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMoviesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetMoviesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type StreamCatalogEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r StreamCatalogEventsResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r StreamCatalogEventsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamCatalogEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamCatalogEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r StreamCatalogEventsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// GetTrendingMoviesResponse200Headers the declared response headers of an HTTP 200 response for GetTrendingMovies
+type GetTrendingMoviesResponse200Headers struct {
+	CacheControl *string
+	ETag         *string
+}
+
+// GetTrendingMoviesResponse304Headers the declared response headers of an HTTP 304 response for GetTrendingMovies
+type GetTrendingMoviesResponse304Headers struct {
+	CacheControl *string
+	ETag         *string
+}
+
+type GetTrendingMoviesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]TrendingMovie
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+	// Headers200 the parsed response headers for an HTTP 200 response
+	Headers200 *GetTrendingMoviesResponse200Headers
+	// Headers304 the parsed response headers for an HTTP 304 response
+	Headers304 *GetTrendingMoviesResponse304Headers
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetTrendingMoviesResponse) GetJSON200() *[]TrendingMovie {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r GetTrendingMoviesResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r GetTrendingMoviesResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r GetTrendingMoviesResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrendingMoviesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrendingMoviesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetTrendingMoviesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CompleteOnboardingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *OnboardingResult
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON409 the response for an HTTP 409 `application/problem+json` response
+	ApplicationproblemJSON409 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r CompleteOnboardingResponse) GetJSON200() *OnboardingResult {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r CompleteOnboardingResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r CompleteOnboardingResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON409 returns the response for an HTTP 409 `application/problem+json` response
+func (r CompleteOnboardingResponse) GetApplicationproblemJSON409() *Problem {
+	return r.ApplicationproblemJSON409
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r CompleteOnboardingResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r CompleteOnboardingResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteOnboardingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteOnboardingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CompleteOnboardingResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOnboardingMoviesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *OnboardingSample
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetOnboardingMoviesResponse) GetJSON200() *OnboardingSample {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r GetOnboardingMoviesResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r GetOnboardingMoviesResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r GetOnboardingMoviesResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r GetOnboardingMoviesResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r GetOnboardingMoviesResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOnboardingMoviesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOnboardingMoviesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOnboardingMoviesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListProfilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]Profile
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListProfilesResponse) GetJSON200() *[]Profile {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r ListProfilesResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r ListProfilesResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r ListProfilesResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProfilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProfilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListProfilesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *Profile
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON409 the response for an HTTP 409 `application/problem+json` response
+	ApplicationproblemJSON409 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r CreateProfileResponse) GetJSON201() *Profile {
+	return r.JSON201
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r CreateProfileResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r CreateProfileResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r CreateProfileResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON409 returns the response for an HTTP 409 `application/problem+json` response
+func (r CreateProfileResponse) GetApplicationproblemJSON409() *Problem {
+	return r.ApplicationproblemJSON409
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r CreateProfileResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r CreateProfileResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateProfileResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r DeleteProfileResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r DeleteProfileResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r DeleteProfileResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r DeleteProfileResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r DeleteProfileResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteProfileResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Profile
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON409 the response for an HTTP 409 `application/problem+json` response
+	ApplicationproblemJSON409 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r UpdateProfileResponse) GetJSON200() *Profile {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r UpdateProfileResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r UpdateProfileResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r UpdateProfileResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r UpdateProfileResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON409 returns the response for an HTTP 409 `application/problem+json` response
+func (r UpdateProfileResponse) GetApplicationproblemJSON409() *Problem {
+	return r.ApplicationproblemJSON409
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r UpdateProfileResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r UpdateProfileResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateProfileResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type SelectProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Profile
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r SelectProfileResponse) GetJSON200() *Profile {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r SelectProfileResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r SelectProfileResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r SelectProfileResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r SelectProfileResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r SelectProfileResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r SelectProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SelectProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r SelectProfileResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ChatRecommendationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON429 the response for an HTTP 429 `application/problem+json` response
+	ApplicationproblemJSON429 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+	// ApplicationproblemJSON502 the response for an HTTP 502 `application/problem+json` response
+	ApplicationproblemJSON502 *Problem
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r ChatRecommendationsResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r ChatRecommendationsResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r ChatRecommendationsResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON429 returns the response for an HTTP 429 `application/problem+json` response
+func (r ChatRecommendationsResponse) GetApplicationproblemJSON429() *Problem {
+	return r.ApplicationproblemJSON429
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r ChatRecommendationsResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetApplicationproblemJSON502 returns the response for an HTTP 502 `application/problem+json` response
+func (r ChatRecommendationsResponse) GetApplicationproblemJSON502() *Problem {
+	return r.ApplicationproblemJSON502
+}
+
+// GetBody returns the raw response body bytes
+func (r ChatRecommendationsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ChatRecommendationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ChatRecommendationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ChatRecommendationsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// GetRecommendedMoviesResponse200Headers the declared response headers of an HTTP 200 response for GetRecommendedMovies
+type GetRecommendedMoviesResponse200Headers struct {
+	XExperiment *string
+}
+
+type GetRecommendedMoviesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]Movie
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+	// Headers200 the parsed response headers for an HTTP 200 response
+	Headers200 *GetRecommendedMoviesResponse200Headers
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetRecommendedMoviesResponse) GetJSON200() *[]Movie {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r GetRecommendedMoviesResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r GetRecommendedMoviesResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r GetRecommendedMoviesResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r GetRecommendedMoviesResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRecommendedMoviesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRecommendedMoviesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetRecommendedMoviesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type RefreshTokensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Message
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r RefreshTokensResponse) GetJSON200() *Message {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r RefreshTokensResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r RefreshTokensResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r RefreshTokensResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r RefreshTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r RefreshTokensResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type RegisterUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *InsertResult
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON409 the response for an HTTP 409 `application/problem+json` response
+	ApplicationproblemJSON409 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r RegisterUserResponse) GetJSON201() *InsertResult {
+	return r.JSON201
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r RegisterUserResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON409 returns the response for an HTTP 409 `application/problem+json` response
+func (r RegisterUserResponse) GetApplicationproblemJSON409() *Problem {
+	return r.ApplicationproblemJSON409
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r RegisterUserResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r RegisterUserResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r RegisterUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegisterUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r RegisterUserResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// UpdateAdminReviewResponse202Headers the declared response headers of an HTTP 202 response for UpdateAdminReview
+type UpdateAdminReviewResponse202Headers struct {
+	Location *string
+}
+
+type UpdateAdminReviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON202 the response for an HTTP 202 `application/json` response
+	JSON202 *Job
+	// ApplicationproblemJSON400 the response for an HTTP 400 `application/problem+json` response
+	ApplicationproblemJSON400 *Problem
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON403 the response for an HTTP 403 `application/problem+json` response
+	ApplicationproblemJSON403 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+	// Headers202 the parsed response headers for an HTTP 202 response
+	Headers202 *UpdateAdminReviewResponse202Headers
+}
+
+// GetJSON202 returns the response for an HTTP 202 `application/json` response
+func (r UpdateAdminReviewResponse) GetJSON202() *Job {
+	return r.JSON202
+}
+
+// GetApplicationproblemJSON400 returns the response for an HTTP 400 `application/problem+json` response
+func (r UpdateAdminReviewResponse) GetApplicationproblemJSON400() *Problem {
+	return r.ApplicationproblemJSON400
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r UpdateAdminReviewResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON403 returns the response for an HTTP 403 `application/problem+json` response
+func (r UpdateAdminReviewResponse) GetApplicationproblemJSON403() *Problem {
+	return r.ApplicationproblemJSON403
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r UpdateAdminReviewResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r UpdateAdminReviewResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r UpdateAdminReviewResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAdminReviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAdminReviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateAdminReviewResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetWatchlistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]Movie
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetWatchlistResponse) GetJSON200() *[]Movie {
+	return r.JSON200
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r GetWatchlistResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r GetWatchlistResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r GetWatchlistResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWatchlistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWatchlistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetWatchlistResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type RemoveFromWatchlistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r RemoveFromWatchlistResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r RemoveFromWatchlistResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r RemoveFromWatchlistResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveFromWatchlistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveFromWatchlistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r RemoveFromWatchlistResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type AddToWatchlistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// ApplicationproblemJSON401 the response for an HTTP 401 `application/problem+json` response
+	ApplicationproblemJSON401 *Problem
+	// ApplicationproblemJSON404 the response for an HTTP 404 `application/problem+json` response
+	ApplicationproblemJSON404 *Problem
+	// ApplicationproblemJSON500 the response for an HTTP 500 `application/problem+json` response
+	ApplicationproblemJSON500 *Problem
+}
+
+// GetApplicationproblemJSON401 returns the response for an HTTP 401 `application/problem+json` response
+func (r AddToWatchlistResponse) GetApplicationproblemJSON401() *Problem {
+	return r.ApplicationproblemJSON401
+}
+
+// GetApplicationproblemJSON404 returns the response for an HTTP 404 `application/problem+json` response
+func (r AddToWatchlistResponse) GetApplicationproblemJSON404() *Problem {
+	return r.ApplicationproblemJSON404
+}
+
+// GetApplicationproblemJSON500 returns the response for an HTTP 500 `application/problem+json` response
+func (r AddToWatchlistResponse) GetApplicationproblemJSON500() *Problem {
+	return r.ApplicationproblemJSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r AddToWatchlistResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r AddToWatchlistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddToWatchlistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r AddToWatchlistResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// AddMovieWithBodyWithResponse Add a movie
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /addmovie (the `AddMovie` operationId).
+func (c *ClientWithResponses) AddMovieWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddMovieResponse, error) {
+	rsp, err := c.AddMovieWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddMovieResponse(rsp)
+}
+
+// AddMovieWithResponse Add a movie
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /addmovie (the `AddMovie` operationId).
+func (c *ClientWithResponses) AddMovieWithResponse(ctx context.Context, body AddMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*AddMovieResponse, error) {
+	rsp, err := c.AddMovie(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddMovieResponse(rsp)
+}
+
+// ListExperimentsWithResponse List recommendation experiments
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /admin/experiments (the `ListExperiments` operationId).
+func (c *ClientWithResponses) ListExperimentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListExperimentsResponse, error) {
+	rsp, err := c.ListExperiments(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListExperimentsResponse(rsp)
+}
+
+// SaveExperimentWithBodyWithResponse Create or replace a recommendation experiment
+//
+// Users are bucketed into a variant by a hash of the experiment name and their user_id, so each user sees the same variant for as long as the variants and weights stay the same. Changing them while the experiment is active moves some users to another variant.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /admin/experiments/{name} (the `SaveExperiment` operationId).
+func (c *ClientWithResponses) SaveExperimentWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveExperimentResponse, error) {
+	rsp, err := c.SaveExperimentWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveExperimentResponse(rsp)
+}
+
+// SaveExperimentWithResponse Create or replace a recommendation experiment
+//
+// Users are bucketed into a variant by a hash of the experiment name and their user_id, so each user sees the same variant for as long as the variants and weights stay the same. Changing them while the experiment is active moves some users to another variant.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /admin/experiments/{name} (the `SaveExperiment` operationId).
+func (c *ClientWithResponses) SaveExperimentWithResponse(ctx context.Context, name string, body SaveExperimentJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveExperimentResponse, error) {
+	rsp, err := c.SaveExperiment(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveExperimentResponse(rsp)
+}
+
+// GetExperimentResultsWithResponse Compare an experiment's variants
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /admin/experiments/{name}/results (the `GetExperimentResults` operationId).
+func (c *ClientWithResponses) GetExperimentResultsWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetExperimentResultsResponse, error) {
+	rsp, err := c.GetExperimentResults(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetExperimentResultsResponse(rsp)
+}
+
+// CreateGenreWithBodyWithResponse Create a genre
+//
+// Requires the ADMIN role. Set `parent_id` to create a sub-genre.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /admin/genres (the `CreateGenre` operationId).
+func (c *ClientWithResponses) CreateGenreWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGenreResponse, error) {
+	rsp, err := c.CreateGenreWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateGenreResponse(rsp)
+}
+
+// CreateGenreWithResponse Create a genre
+//
+// Requires the ADMIN role. Set `parent_id` to create a sub-genre.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /admin/genres (the `CreateGenre` operationId).
+func (c *ClientWithResponses) CreateGenreWithResponse(ctx context.Context, body CreateGenreJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateGenreResponse, error) {
+	rsp, err := c.CreateGenre(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateGenreResponse(rsp)
+}
+
+// DeleteGenreWithResponse Delete a genre
+//
+// Requires the ADMIN role. Only a genre that no movie, user or sub-genre refers to can be deleted.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /admin/genres/{genre_id} (the `DeleteGenre` operationId).
+func (c *ClientWithResponses) DeleteGenreWithResponse(ctx context.Context, genreId int, reqEditors ...RequestEditorFn) (*DeleteGenreResponse, error) {
+	rsp, err := c.DeleteGenre(ctx, genreId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteGenreResponse(rsp)
+}
+
+// UpdateGenreWithBodyWithResponse Rename or move a genre
+//
+// Requires the ADMIN role. Replaces the genre's name and parent. After a rename a `genre_cascade` job updates the copies of the genre embedded in movies, users and profiles.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /admin/genres/{genre_id} (the `UpdateGenre` operationId).
+func (c *ClientWithResponses) UpdateGenreWithBodyWithResponse(ctx context.Context, genreId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGenreResponse, error) {
+	rsp, err := c.UpdateGenreWithBody(ctx, genreId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateGenreResponse(rsp)
+}
+
+// UpdateGenreWithResponse Rename or move a genre
+//
+// Requires the ADMIN role. Replaces the genre's name and parent. After a rename a `genre_cascade` job updates the copies of the genre embedded in movies, users and profiles.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /admin/genres/{genre_id} (the `UpdateGenre` operationId).
+func (c *ClientWithResponses) UpdateGenreWithResponse(ctx context.Context, genreId int, body UpdateGenreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGenreResponse, error) {
+	rsp, err := c.UpdateGenre(ctx, genreId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateGenreResponse(rsp)
+}
+
+// GetJobWithResponse Get a background job
+//
+// Requires the ADMIN role.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /admin/jobs/{job_id} (the `GetJob` operationId).
+func (c *ClientWithResponses) GetJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*GetJobResponse, error) {
+	rsp, err := c.GetJob(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobResponse(rsp)
+}
+
+// GetLLMUsageWithResponse Get today's LLM usage
+//
+// Requires the ADMIN role. Reports today's (UTC) model calls, cache hits, tokens and estimated cost against the daily budget. Once a budget is spent, review ranking jobs wait until it resets.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /admin/llm/usage (the `GetLLMUsage` operationId).
+func (c *ClientWithResponses) GetLLMUsageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLLMUsageResponse, error) {
+	rsp, err := c.GetLLMUsage(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLLMUsageResponse(rsp)
+}
+
+// ExportMoviesWithResponse Export the catalogue
+//
+// Requires the ADMIN role. Streams every movie ordered by `imdb_id` in a form the import endpoint accepts.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /admin/movies/export (the `ExportMovies` operationId).
+func (c *ClientWithResponses) ExportMoviesWithResponse(ctx context.Context, params *ExportMoviesParams, reqEditors ...RequestEditorFn) (*ExportMoviesResponse, error) {
+	rsp, err := c.ExportMovies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportMoviesResponse(rsp)
+}
+
+// ImportMoviesWithBodyWithResponse Bulk import movies
+//
+// Requires the ADMIN role. Upserts movies by `imdb_id`. The body is a JSON array of movies or a CSV file with the header `imdb_id,title,poster_path,youtube_id,genre,admin_review,ranking_value,ranking_name`; CSV genres are written as `4:Fantasy|7:Action`. Invalid rows, including rows naming a `genre_id` that does not exist, are reported and skipped. An empty `admin_review` keeps the stored review; a movie whose review changes is ranked and summarized again, as after a review update.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /admin/movies/import (the `ImportMovies` operationId).
+func (c *ClientWithResponses) ImportMoviesWithBodyWithResponse(ctx context.Context, params *ImportMoviesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportMoviesResponse, error) {
+	rsp, err := c.ImportMoviesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportMoviesResponse(rsp)
+}
+
+// ImportMoviesWithResponse Bulk import movies
+//
+// Requires the ADMIN role. Upserts movies by `imdb_id`. The body is a JSON array of movies or a CSV file with the header `imdb_id,title,poster_path,youtube_id,genre,admin_review,ranking_value,ranking_name`; CSV genres are written as `4:Fantasy|7:Action`. Invalid rows, including rows naming a `genre_id` that does not exist, are reported and skipped. An empty `admin_review` keeps the stored review; a movie whose review changes is ranked and summarized again, as after a review update.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /admin/movies/import (the `ImportMovies` operationId).
+func (c *ClientWithResponses) ImportMoviesWithResponse(ctx context.Context, params *ImportMoviesParams, body ImportMoviesJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportMoviesResponse, error) {
+	rsp, err := c.ImportMovies(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportMoviesResponse(rsp)
+}
+
+// RerankMoviesWithResponse Re-rank every reviewed movie
+//
+// Requires the ADMIN role. Queues a job that queues a review ranking job for every movie with an admin review. If one is already pending, that job is returned. The server also queues it by itself when the rankings collection changes.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /admin/movies/rerank (the `RerankMovies` operationId).
+func (c *ClientWithResponses) RerankMoviesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RerankMoviesResponse, error) {
+	rsp, err := c.RerankMovies(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRerankMoviesResponse(rsp)
+}
+
+// EnrichMovieWithResponse Refresh a movie's metadata
+//
+// Requires the ADMIN role. Looks the movie up in the configured metadata provider and stores the result. Movies are also enriched when added and by a background refresh job.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /admin/movies/{imdb_id}/enrich (the `EnrichMovie` operationId).
+func (c *ClientWithResponses) EnrichMovieWithResponse(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*EnrichMovieResponse, error) {
+	rsp, err := c.EnrichMovie(ctx, imdbId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnrichMovieResponse(rsp)
+}
+
+// GetReviewStatusWithResponse Get a movie's review ranking status
+//
+// Requires the ADMIN role. Returns the most recently queued review ranking job for the movie.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /admin/movies/{imdb_id}/review-status (the `GetReviewStatus` operationId).
+func (c *ClientWithResponses) GetReviewStatusWithResponse(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*GetReviewStatusResponse, error) {
+	rsp, err := c.GetReviewStatus(ctx, imdbId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReviewStatusResponse(rsp)
+}
+
+// GetMovieStatsWithResponse Get a movie's popularity statistics
+//
+// Requires the ADMIN role. Returns the movie's activity and score in each trending window, with its ratings and watchlist entries of all time.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /admin/movies/{imdb_id}/stats (the `GetMovieStats` operationId).
+func (c *ClientWithResponses) GetMovieStatsWithResponse(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*GetMovieStatsResponse, error) {
+	rsp, err := c.GetMovieStats(ctx, imdbId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMovieStatsResponse(rsp)
+}
+
+// SummarizeMovieWithResponse Regenerate a movie's summary
+//
+// Requires the ADMIN role. Queues a job that has the LLM write the movie's synopsis, mood tags and content warnings from its admin review and metadata. A movie with neither a review nor a metadata overview cannot be summarized, and its job fails.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /admin/movies/{imdb_id}/summarize (the `SummarizeMovie` operationId).
+func (c *ClientWithResponses) SummarizeMovieWithResponse(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*SummarizeMovieResponse, error) {
+	rsp, err := c.SummarizeMovie(ctx, imdbId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSummarizeMovieResponse(rsp)
+}
+
+// ListPromptTemplatesWithResponse List the versions of a prompt
+//
+// Requires the ADMIN role. Returns built-in and saved versions, newest first. The first one is in use.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /admin/prompts/{name} (the `ListPromptTemplates` operationId).
+func (c *ClientWithResponses) ListPromptTemplatesWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*ListPromptTemplatesResponse, error) {
+	rsp, err := c.ListPromptTemplates(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPromptTemplatesResponse(rsp)
+}
+
+// CreatePromptTemplateWithBodyWithResponse Save a new prompt version
+//
+// Requires the ADMIN role. The template is rendered with sample data before it is saved. The new version is used for every ranking from then on; existing rankings keep the version recorded in `ranking_prompt` until they are re-ranked.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /admin/prompts/{name} (the `CreatePromptTemplate` operationId).
+func (c *ClientWithResponses) CreatePromptTemplateWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePromptTemplateResponse, error) {
+	rsp, err := c.CreatePromptTemplateWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePromptTemplateResponse(rsp)
+}
+
+// CreatePromptTemplateWithResponse Save a new prompt version
+//
+// Requires the ADMIN role. The template is rendered with sample data before it is saved. The new version is used for every ranking from then on; existing rankings keep the version recorded in `ranking_prompt` until they are re-ranked.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /admin/prompts/{name} (the `CreatePromptTemplate` operationId).
+func (c *ClientWithResponses) CreatePromptTemplateWithResponse(ctx context.Context, name string, body CreatePromptTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePromptTemplateResponse, error) {
+	rsp, err := c.CreatePromptTemplate(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePromptTemplateResponse(rsp)
+}
+
+// PreviewPromptTemplateWithBodyWithResponse Preview a prompt against sample reviews
+//
+// Requires the ADMIN role. Classifies each sample review with a stored version or an unsaved draft. No movie is changed. Only `review_ranking` can be previewed.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /admin/prompts/{name}/preview (the `PreviewPromptTemplate` operationId).
+func (c *ClientWithResponses) PreviewPromptTemplateWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewPromptTemplateResponse, error) {
+	rsp, err := c.PreviewPromptTemplateWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewPromptTemplateResponse(rsp)
+}
+
+// PreviewPromptTemplateWithResponse Preview a prompt against sample reviews
+//
+// Requires the ADMIN role. Classifies each sample review with a stored version or an unsaved draft. No movie is changed. Only `review_ranking` can be previewed.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /admin/prompts/{name}/preview (the `PreviewPromptTemplate` operationId).
+func (c *ClientWithResponses) PreviewPromptTemplateWithResponse(ctx context.Context, name string, body PreviewPromptTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewPromptTemplateResponse, error) {
+	rsp, err := c.PreviewPromptTemplate(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewPromptTemplateResponse(rsp)
+}
+
+// GetRankingsWithResponse List the ranking scale
+//
+// Requires the ADMIN role. Returns every tier, best first.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /admin/rankings (the `GetRankings` operationId).
+func (c *ClientWithResponses) GetRankingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRankingsResponse, error) {
+	rsp, err := c.GetRankings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRankingsResponse(rsp)
+}
+
+// ReplaceRankingsWithBodyWithResponse Replace the ranking scale
+//
+// Requires the ADMIN role. Replaces every tier. Each movie moves to the tier of the same name, to the tier that `replaces` its old one, or, when its tier was dropped, to the unranked tier. When the tiers offered to the LLM changed, a `rerank_all` job re-ranks every reviewed movie.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /admin/rankings (the `ReplaceRankings` operationId).
+func (c *ClientWithResponses) ReplaceRankingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceRankingsResponse, error) {
+	rsp, err := c.ReplaceRankingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceRankingsResponse(rsp)
+}
+
+// ReplaceRankingsWithResponse Replace the ranking scale
+//
+// Requires the ADMIN role. Replaces every tier. Each movie moves to the tier of the same name, to the tier that `replaces` its old one, or, when its tier was dropped, to the unranked tier. When the tiers offered to the LLM changed, a `rerank_all` job re-ranks every reviewed movie.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /admin/rankings (the `ReplaceRankings` operationId).
+func (c *ClientWithResponses) ReplaceRankingsWithResponse(ctx context.Context, body ReplaceRankingsJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceRankingsResponse, error) {
+	rsp, err := c.ReplaceRankings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceRankingsResponse(rsp)
+}
+
+// GetFeedWithResponse The user's personalized home feed
+//
+// Rows of top picks from the user's favourite genres, one row for each of their first favourite genres, movies trending this week and newly added movies. A movie appears only in the first row that has it. Row sizes are set by the FEED_ROW_LIMITS environment variable, e.g. `top_picks=5,genre=8,genre_rows=3,trending=10,new=10`; a size of 0 leaves the row out. With a profile selected, recommendations follow the profile's favourite genres and maturity setting.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /feed (the `GetFeed` operationId).
+func (c *ClientWithResponses) GetFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFeedResponse, error) {
+	rsp, err := c.GetFeed(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFeedResponse(rsp)
+}
+
+// GetGenresWithResponse List all genres
+//
+// Served from a short-lived in-process cache that is invalidated when the catalogue changes. Responses carry an ETag and a `public` Cache-Control header for revalidation.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /genres (the `GetGenres` operationId).
+func (c *ClientWithResponses) GetGenresWithResponse(ctx context.Context, params *GetGenresParams, reqEditors ...RequestEditorFn) (*GetGenresResponse, error) {
+	rsp, err := c.GetGenres(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetGenresResponse(rsp)
+}
+
+// LoginUserWithBodyWithResponse Log in and receive auth cookies
+//
+// Sets the `access_token` and `refresh_token` HttpOnly cookies.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /login (the `LoginUser` operationId).
+func (c *ClientWithResponses) LoginUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserResponse, error) {
+	rsp, err := c.LoginUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginUserResponse(rsp)
+}
+
+// LoginUserWithResponse Log in and receive auth cookies
+//
+// Sets the `access_token` and `refresh_token` HttpOnly cookies.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /login (the `LoginUser` operationId).
+func (c *ClientWithResponses) LoginUserWithResponse(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserResponse, error) {
+	rsp, err := c.LoginUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginUserResponse(rsp)
+}
+
+// LogoutUserWithBodyWithResponse Log out and clear auth cookies
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /logout (the `LogoutUser` operationId).
+func (c *ClientWithResponses) LogoutUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LogoutUserResponse, error) {
+	rsp, err := c.LogoutUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutUserResponse(rsp)
+}
+
+// LogoutUserWithResponse Log out and clear auth cookies
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /logout (the `LogoutUser` operationId).
+func (c *ClientWithResponses) LogoutUserWithResponse(ctx context.Context, body LogoutUserJSONRequestBody, reqEditors ...RequestEditorFn) (*LogoutUserResponse, error) {
+	rsp, err := c.LogoutUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutUserResponse(rsp)
+}
+
+// GetMovieWithResponse Get a movie by IMDb ID
+//
+// Served from a short-lived in-process cache that is invalidated when the catalogue changes. Responses carry an ETag and a `private` Cache-Control header for revalidation.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /movie/{imdb_id} (the `GetMovie` operationId).
+func (c *ClientWithResponses) GetMovieWithResponse(ctx context.Context, imdbId string, params *GetMovieParams, reqEditors ...RequestEditorFn) (*GetMovieResponse, error) {
+	rsp, err := c.GetMovie(ctx, imdbId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMovieResponse(rsp)
+}
+
+// RateMovieWithBodyWithResponse Rate a movie
+//
+// Stores the user's score for the movie, replacing any earlier one. Only the first rating of a movie by a user counts towards its popularity.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /movie/{imdb_id}/rating (the `RateMovie` operationId).
+func (c *ClientWithResponses) RateMovieWithBodyWithResponse(ctx context.Context, imdbId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RateMovieResponse, error) {
+	rsp, err := c.RateMovieWithBody(ctx, imdbId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRateMovieResponse(rsp)
+}
+
+// RateMovieWithResponse Rate a movie
+//
+// Stores the user's score for the movie, replacing any earlier one. Only the first rating of a movie by a user counts towards its popularity.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /movie/{imdb_id}/rating (the `RateMovie` operationId).
+func (c *ClientWithResponses) RateMovieWithResponse(ctx context.Context, imdbId string, body RateMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*RateMovieResponse, error) {
+	rsp, err := c.RateMovie(ctx, imdbId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRateMovieResponse(rsp)
+}
+
+// GetMoviesWithResponse List all movies
+//
+// Served from a short-lived in-process cache that is invalidated when the catalogue changes. Responses carry an ETag and a `public` Cache-Control header for revalidation.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /movies (the `GetMovies` operationId).
+func (c *ClientWithResponses) GetMoviesWithResponse(ctx context.Context, params *GetMoviesParams, reqEditors ...RequestEditorFn) (*GetMoviesResponse, error) {
+	rsp, err := c.GetMovies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMoviesResponse(rsp)
+}
+
+// StreamCatalogEventsWithResponse Stream changes to the catalog
+//
+// Changes are streamed as server-sent events named after their type, each carrying a CatalogEvent:
+//
+// - `movie-added`: a movie was added or imported
+// - `review-updated`: a movie's admin review changed
+// - `ranking-changed`: a movie was given another tier, by a review ranking or a new ranking scale
+//
+// Idle streams get a `: keep-alive` comment every 25 seconds. A client that falls too far behind is disconnected and should reconnect and reload the catalog. On a replica set the events come from a change stream and include writes made through any server; on a standalone MongoDB only writes made through this server are streamed.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /movies/events (the `StreamCatalogEvents` operationId).
+func (c *ClientWithResponses) StreamCatalogEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamCatalogEventsResponse, error) {
+	rsp, err := c.StreamCatalogEvents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamCatalogEventsResponse(rsp)
+}
+
+// GetTrendingMoviesWithResponse List trending movies
+//
+// Movies are scored by their views (weight 1), first ratings (3) and watchlist adds (5) in the window. Each hour's activity is halved for every half-life of its age: 6 hours for `day`, 2 days for `week` and 7 days for `month`. Movies without activity in the window are left out. Served from the response cache like `/movies`.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /movies/trending (the `GetTrendingMovies` operationId).
+func (c *ClientWithResponses) GetTrendingMoviesWithResponse(ctx context.Context, params *GetTrendingMoviesParams, reqEditors ...RequestEditorFn) (*GetTrendingMoviesResponse, error) {
+	rsp, err := c.GetTrendingMovies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrendingMoviesResponse(rsp)
+}
+
+// CompleteOnboardingWithResponse Derive favourite genres from the user's ratings
+//
+// Each rating counts for the genres of its movie by how far it is above or below 3. Up to three genres that come out ahead replace the favourite genres of the user, or of their selected profile.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /onboarding/complete (the `CompleteOnboarding` operationId).
+func (c *ClientWithResponses) CompleteOnboardingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CompleteOnboardingResponse, error) {
+	rsp, err := c.CompleteOnboarding(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteOnboardingResponse(rsp)
+}
+
+// GetOnboardingMoviesWithResponse Movies for a new user to rate
+//
+// A spread of movies across the catalog for a user without favourite genres to rate with PUT /movie/{imdb_id}/rating. Movies the user already rated are left out.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /onboarding/movies (the `GetOnboardingMovies` operationId).
+func (c *ClientWithResponses) GetOnboardingMoviesWithResponse(ctx context.Context, params *GetOnboardingMoviesParams, reqEditors ...RequestEditorFn) (*GetOnboardingMoviesResponse, error) {
+	rsp, err := c.GetOnboardingMovies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOnboardingMoviesResponse(rsp)
+}
+
+// ListProfilesWithResponse The profiles of the user's household
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /profiles (the `ListProfiles` operationId).
+func (c *ClientWithResponses) ListProfilesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProfilesResponse, error) {
+	rsp, err := c.ListProfiles(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProfilesResponse(rsp)
+}
+
+// CreateProfileWithBodyWithResponse Add a profile to the household
+//
+// A profile has its own favourite genres, ratings, watchlist and maturity setting. An account can have at most 5 profiles.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /profiles (the `CreateProfile` operationId).
+func (c *ClientWithResponses) CreateProfileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProfileResponse, error) {
+	rsp, err := c.CreateProfileWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProfileResponse(rsp)
+}
+
+// CreateProfileWithResponse Add a profile to the household
+//
+// A profile has its own favourite genres, ratings, watchlist and maturity setting. An account can have at most 5 profiles.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /profiles (the `CreateProfile` operationId).
+func (c *ClientWithResponses) CreateProfileWithResponse(ctx context.Context, body CreateProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProfileResponse, error) {
+	rsp, err := c.CreateProfile(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProfileResponse(rsp)
+}
+
+// DeleteProfileWithResponse Delete a profile with its ratings and watchlist
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /profiles/{profile_id} (the `DeleteProfile` operationId).
+func (c *ClientWithResponses) DeleteProfileWithResponse(ctx context.Context, profileId string, reqEditors ...RequestEditorFn) (*DeleteProfileResponse, error) {
+	rsp, err := c.DeleteProfile(ctx, profileId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProfileResponse(rsp)
+}
+
+// UpdateProfileWithBodyWithResponse Update a profile
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /profiles/{profile_id} (the `UpdateProfile` operationId).
+func (c *ClientWithResponses) UpdateProfileWithBodyWithResponse(ctx context.Context, profileId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProfileResponse, error) {
+	rsp, err := c.UpdateProfileWithBody(ctx, profileId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProfileResponse(rsp)
+}
+
+// UpdateProfileWithResponse Update a profile
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /profiles/{profile_id} (the `UpdateProfile` operationId).
+func (c *ClientWithResponses) UpdateProfileWithResponse(ctx context.Context, profileId string, body UpdateProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProfileResponse, error) {
+	rsp, err := c.UpdateProfile(ctx, profileId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProfileResponse(rsp)
+}
+
+// SelectProfileWithResponse Act for a profile of the household
+//
+// Issues new access and refresh token cookies scoped to the profile. Ratings, the watchlist, onboarding and recommendations then belong to the profile, and only movies its maturity setting allows are recommended. Logging in again goes back to the account itself.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /profiles/{profile_id}/select (the `SelectProfile` operationId).
+func (c *ClientWithResponses) SelectProfileWithResponse(ctx context.Context, profileId string, reqEditors ...RequestEditorFn) (*SelectProfileResponse, error) {
+	rsp, err := c.SelectProfile(ctx, profileId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSelectProfileResponse(rsp)
+}
+
+// ChatRecommendationsWithBodyWithResponse Movies recommended from a request in the user's own words
+//
+// The LLM turns the message into catalog filters, and the answer is streamed as server-sent events:
+//
+// - `filters`: the ChatFilters that were applied
+// - `message`: one sentence introducing the picks, as plain text
+// - `movie`: one Movie per match, best ranked first
+// - `done`: `{"count": n}`
+// - `error`: a Problem, if the stream fails after it started
+//
+// Errors before the stream starts are ordinary problem responses. With a profile selected, only movies its maturity setting allows are streamed.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /recommendations/chat (the `ChatRecommendations` operationId).
+func (c *ClientWithResponses) ChatRecommendationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChatRecommendationsResponse, error) {
+	rsp, err := c.ChatRecommendationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChatRecommendationsResponse(rsp)
+}
+
+// ChatRecommendationsWithResponse Movies recommended from a request in the user's own words
+//
+// The LLM turns the message into catalog filters, and the answer is streamed as server-sent events:
+//
+// - `filters`: the ChatFilters that were applied
+// - `message`: one sentence introducing the picks, as plain text
+// - `movie`: one Movie per match, best ranked first
+// - `done`: `{"count": n}`
+// - `error`: a Problem, if the stream fails after it started
+//
+// Errors before the stream starts are ordinary problem responses. With a profile selected, only movies its maturity setting allows are streamed.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /recommendations/chat (the `ChatRecommendations` operationId).
+func (c *ClientWithResponses) ChatRecommendationsWithResponse(ctx context.Context, body ChatRecommendationsJSONRequestBody, reqEditors ...RequestEditorFn) (*ChatRecommendationsResponse, error) {
+	rsp, err := c.ChatRecommendations(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChatRecommendationsResponse(rsp)
+}
+
+// GetRecommendedMoviesWithResponse Movies recommended from the user's favourite genres
+//
+// Movies in the user's favourite genres and their sub-genres, best ranked first. Users without favourite genres get popular movies instead: this month's trending movies, topped up with the best ranked ones. The count is the top_picks limit of FEED_ROW_LIMITS. While an experiment is active, the user's variant chooses the strategy instead and the response is logged as an exposure. With a profile selected, recommendations follow the profile's favourite genres and maturity setting.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /recommendedmovies (the `GetRecommendedMovies` operationId).
+func (c *ClientWithResponses) GetRecommendedMoviesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRecommendedMoviesResponse, error) {
+	rsp, err := c.GetRecommendedMovies(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRecommendedMoviesResponse(rsp)
+}
+
+// RefreshTokensWithResponse Rotate auth cookies using the refresh token
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /refresh (the `RefreshTokens` operationId).
+func (c *ClientWithResponses) RefreshTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RefreshTokensResponse, error) {
+	rsp, err := c.RefreshTokens(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshTokensResponse(rsp)
+}
+
+// RegisterUserWithBodyWithResponse Register a new user
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /register (the `RegisterUser` operationId).
+func (c *ClientWithResponses) RegisterUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterUserResponse, error) {
+	rsp, err := c.RegisterUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterUserResponse(rsp)
+}
+
+// RegisterUserWithResponse Register a new user
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /register (the `RegisterUser` operationId).
+func (c *ClientWithResponses) RegisterUserWithResponse(ctx context.Context, body RegisterUserJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterUserResponse, error) {
+	rsp, err := c.RegisterUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterUserResponse(rsp)
+}
+
+// UpdateAdminReviewWithBodyWithResponse Update the admin review and queue re-ranking
+//
+// Requires the ADMIN role. Stores the review and queues a background job that classifies it with the LLM into one of the configured rankings. Poll `/admin/movies/{imdb_id}/review-status` for the result. Unless the server runs with `AUTO_SUMMARIZE=off`, a job that rewrites the movie's summary is queued too.
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PATCH /updatereview/{imdb_id} (the `UpdateAdminReview` operationId).
+func (c *ClientWithResponses) UpdateAdminReviewWithBodyWithResponse(ctx context.Context, imdbId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAdminReviewResponse, error) {
+	rsp, err := c.UpdateAdminReviewWithBody(ctx, imdbId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAdminReviewResponse(rsp)
+}
+
+// UpdateAdminReviewWithResponse Update the admin review and queue re-ranking
+//
+// Requires the ADMIN role. Stores the review and queues a background job that classifies it with the LLM into one of the configured rankings. Poll `/admin/movies/{imdb_id}/review-status` for the result. Unless the server runs with `AUTO_SUMMARIZE=off`, a job that rewrites the movie's summary is queued too.
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PATCH /updatereview/{imdb_id} (the `UpdateAdminReview` operationId).
+func (c *ClientWithResponses) UpdateAdminReviewWithResponse(ctx context.Context, imdbId string, body UpdateAdminReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAdminReviewResponse, error) {
+	rsp, err := c.UpdateAdminReview(ctx, imdbId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAdminReviewResponse(rsp)
+}
+
+// GetWatchlistWithResponse List the movies on the user's watchlist
+//
+// Most recently added first.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /watchlist (the `GetWatchlist` operationId).
+func (c *ClientWithResponses) GetWatchlistWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWatchlistResponse, error) {
+	rsp, err := c.GetWatchlist(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWatchlistResponse(rsp)
+}
+
+// RemoveFromWatchlistWithResponse Remove a movie from the user's watchlist
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /watchlist/{imdb_id} (the `RemoveFromWatchlist` operationId).
+func (c *ClientWithResponses) RemoveFromWatchlistWithResponse(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*RemoveFromWatchlistResponse, error) {
+	rsp, err := c.RemoveFromWatchlist(ctx, imdbId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveFromWatchlistResponse(rsp)
+}
+
+// AddToWatchlistWithResponse Add a movie to the user's watchlist
+//
+// Adding a movie that is already on the watchlist changes nothing.
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /watchlist/{imdb_id} (the `AddToWatchlist` operationId).
+func (c *ClientWithResponses) AddToWatchlistWithResponse(ctx context.Context, imdbId string, reqEditors ...RequestEditorFn) (*AddToWatchlistResponse, error) {
+	rsp, err := c.AddToWatchlist(ctx, imdbId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddToWatchlistResponse(rsp)
+}
+
+// ParseAddMovieResponse parses an HTTP response from a AddMovieWithResponse call
+func ParseAddMovieResponse(rsp *http.Response) (*AddMovieResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddMovieResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest InsertResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListExperimentsResponse parses an HTTP response from a ListExperimentsWithResponse call
+func ParseListExperimentsResponse(rsp *http.Response) (*ListExperimentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListExperimentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Experiment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSaveExperimentResponse parses an HTTP response from a SaveExperimentWithResponse call
+func ParseSaveExperimentResponse(rsp *http.Response) (*SaveExperimentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SaveExperimentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Experiment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Experiment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetExperimentResultsResponse parses an HTTP response from a GetExperimentResultsWithResponse call
+func ParseGetExperimentResultsResponse(rsp *http.Response) (*GetExperimentResultsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetExperimentResultsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExperimentResults
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateGenreResponse parses an HTTP response from a CreateGenreWithResponse call
+func ParseCreateGenreResponse(rsp *http.Response) (*CreateGenreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateGenreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Genre
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 201:
+		var headers CreateGenreResponse201Headers
+		if values := rsp.Header.Values("Location"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "Location", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.Location = &value
+		}
+		response.Headers201 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseDeleteGenreResponse parses an HTTP response from a DeleteGenreWithResponse call
+func ParseDeleteGenreResponse(rsp *http.Response) (*DeleteGenreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteGenreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 204:
+		break // No content-type
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateGenreResponse parses an HTTP response from a UpdateGenreWithResponse call
+func ParseUpdateGenreResponse(rsp *http.Response) (*UpdateGenreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateGenreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GenreUpdate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetJobResponse parses an HTTP response from a GetJobWithResponse call
+func ParseGetJobResponse(rsp *http.Response) (*GetJobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLLMUsageResponse parses an HTTP response from a GetLLMUsageWithResponse call
+func ParseGetLLMUsageResponse(rsp *http.Response) (*GetLLMUsageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLLMUsageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LLMUsage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportMoviesResponse parses an HTTP response from a ExportMoviesWithResponse call
+func ParseExportMoviesResponse(rsp *http.Response) (*ExportMoviesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportMoviesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Movie
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseImportMoviesResponse parses an HTTP response from a ImportMoviesWithResponse call
+func ParseImportMoviesResponse(rsp *http.Response) (*ImportMoviesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportMoviesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRerankMoviesResponse parses an HTTP response from a RerankMoviesWithResponse call
+func ParseRerankMoviesResponse(rsp *http.Response) (*RerankMoviesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RerankMoviesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 202:
+		var headers RerankMoviesResponse202Headers
+		if values := rsp.Header.Values("Location"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "Location", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.Location = &value
+		}
+		response.Headers202 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseEnrichMovieResponse parses an HTTP response from a EnrichMovieWithResponse call
+func ParseEnrichMovieResponse(rsp *http.Response) (*EnrichMovieResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EnrichMovieResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Metadata
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetReviewStatusResponse parses an HTTP response from a GetReviewStatusWithResponse call
+func ParseGetReviewStatusResponse(rsp *http.Response) (*GetReviewStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReviewStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMovieStatsResponse parses an HTTP response from a GetMovieStatsWithResponse call
+func ParseGetMovieStatsResponse(rsp *http.Response) (*GetMovieStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMovieStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MovieStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSummarizeMovieResponse parses an HTTP response from a SummarizeMovieWithResponse call
+func ParseSummarizeMovieResponse(rsp *http.Response) (*SummarizeMovieResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SummarizeMovieResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 202:
+		var headers SummarizeMovieResponse202Headers
+		if values := rsp.Header.Values("Location"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "Location", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.Location = &value
+		}
+		response.Headers202 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseListPromptTemplatesResponse parses an HTTP response from a ListPromptTemplatesWithResponse call
+func ParseListPromptTemplatesResponse(rsp *http.Response) (*ListPromptTemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPromptTemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PromptTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePromptTemplateResponse parses an HTTP response from a CreatePromptTemplateWithResponse call
+func ParseCreatePromptTemplateResponse(rsp *http.Response) (*CreatePromptTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePromptTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PromptTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePreviewPromptTemplateResponse parses an HTTP response from a PreviewPromptTemplateWithResponse call
+func ParsePreviewPromptTemplateResponse(rsp *http.Response) (*PreviewPromptTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PreviewPromptTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PromptPreview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRankingsResponse parses an HTTP response from a GetRankingsWithResponse call
+func ParseGetRankingsResponse(rsp *http.Response) (*GetRankingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRankingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Ranking
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReplaceRankingsResponse parses an HTTP response from a ReplaceRankingsWithResponse call
+func ParseReplaceRankingsResponse(rsp *http.Response) (*ReplaceRankingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReplaceRankingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RankingScaleUpdate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFeedResponse parses an HTTP response from a GetFeedWithResponse call
+func ParseGetFeedResponse(rsp *http.Response) (*GetFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Feed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetGenresResponse parses an HTTP response from a GetGenresWithResponse call
+func ParseGetGenresResponse(rsp *http.Response) (*GetGenresResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetGenresResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Genre
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 304:
+		break // No content-type
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers GetGenresResponse200Headers
+		if values := rsp.Header.Values("Cache-Control"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "Cache-Control", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.CacheControl = &value
+		}
+		if values := rsp.Header.Values("ETag"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "ETag", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.ETag = &value
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 304:
+		var headers GetGenresResponse304Headers
+		if values := rsp.Header.Values("Cache-Control"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "Cache-Control", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.CacheControl = &value
+		}
+		if values := rsp.Header.Values("ETag"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "ETag", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.ETag = &value
+		}
+		response.Headers304 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseLoginUserResponse parses an HTTP response from a LoginUserWithResponse call
+func ParseLoginUserResponse(rsp *http.Response) (*LoginUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers LoginUserResponse200Headers
+		if values := rsp.Header.Values("Set-Cookie"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "Set-Cookie", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.SetCookie = &value
+		}
+		response.Headers200 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseLogoutUserResponse parses an HTTP response from a LogoutUserWithResponse call
+func ParseLogoutUserResponse(rsp *http.Response) (*LogoutUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogoutUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMovieResponse parses an HTTP response from a GetMovieWithResponse call
+func ParseGetMovieResponse(rsp *http.Response) (*GetMovieResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMovieResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Movie
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 304:
+		break // No content-type
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers GetMovieResponse200Headers
+		if values := rsp.Header.Values("Cache-Control"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "Cache-Control", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.CacheControl = &value
+		}
+		if values := rsp.Header.Values("ETag"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "ETag", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.ETag = &value
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 304:
+		var headers GetMovieResponse304Headers
+		if values := rsp.Header.Values("Cache-Control"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "Cache-Control", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.CacheControl = &value
+		}
+		if values := rsp.Header.Values("ETag"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "ETag", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.ETag = &value
+		}
+		response.Headers304 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseRateMovieResponse parses an HTTP response from a RateMovieWithResponse call
+func ParseRateMovieResponse(rsp *http.Response) (*RateMovieResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RateMovieResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Rating
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMoviesResponse parses an HTTP response from a GetMoviesWithResponse call
+func ParseGetMoviesResponse(rsp *http.Response) (*GetMoviesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMoviesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Movie
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 304:
+		break // No content-type
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers GetMoviesResponse200Headers
+		if values := rsp.Header.Values("Cache-Control"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "Cache-Control", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.CacheControl = &value
+		}
+		if values := rsp.Header.Values("ETag"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "ETag", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.ETag = &value
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 304:
+		var headers GetMoviesResponse304Headers
+		if values := rsp.Header.Values("Cache-Control"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "Cache-Control", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.CacheControl = &value
+		}
+		if values := rsp.Header.Values("ETag"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "ETag", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.ETag = &value
+		}
+		response.Headers304 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseStreamCatalogEventsResponse parses an HTTP response from a StreamCatalogEventsWithResponse call
+func ParseStreamCatalogEventsResponse(rsp *http.Response) (*StreamCatalogEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamCatalogEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTrendingMoviesResponse parses an HTTP response from a GetTrendingMoviesWithResponse call
+func ParseGetTrendingMoviesResponse(rsp *http.Response) (*GetTrendingMoviesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTrendingMoviesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TrendingMovie
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 304:
+		break // No content-type
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers GetTrendingMoviesResponse200Headers
+		if values := rsp.Header.Values("Cache-Control"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "Cache-Control", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.CacheControl = &value
+		}
+		if values := rsp.Header.Values("ETag"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "ETag", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.ETag = &value
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 304:
+		var headers GetTrendingMoviesResponse304Headers
+		if values := rsp.Header.Values("Cache-Control"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "Cache-Control", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.CacheControl = &value
+		}
+		if values := rsp.Header.Values("ETag"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "ETag", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.ETag = &value
+		}
+		response.Headers304 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseCompleteOnboardingResponse parses an HTTP response from a CompleteOnboardingWithResponse call
+func ParseCompleteOnboardingResponse(rsp *http.Response) (*CompleteOnboardingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompleteOnboardingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OnboardingResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOnboardingMoviesResponse parses an HTTP response from a GetOnboardingMoviesWithResponse call
+func ParseGetOnboardingMoviesResponse(rsp *http.Response) (*GetOnboardingMoviesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOnboardingMoviesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OnboardingSample
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListProfilesResponse parses an HTTP response from a ListProfilesWithResponse call
+func ParseListProfilesResponse(rsp *http.Response) (*ListProfilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProfilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Profile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateProfileResponse parses an HTTP response from a CreateProfileWithResponse call
+func ParseCreateProfileResponse(rsp *http.Response) (*CreateProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Profile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteProfileResponse parses an HTTP response from a DeleteProfileWithResponse call
+func ParseDeleteProfileResponse(rsp *http.Response) (*DeleteProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 204:
+		break // No content-type
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateProfileResponse parses an HTTP response from a UpdateProfileWithResponse call
+func ParseUpdateProfileResponse(rsp *http.Response) (*UpdateProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Profile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSelectProfileResponse parses an HTTP response from a SelectProfileWithResponse call
+func ParseSelectProfileResponse(rsp *http.Response) (*SelectProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SelectProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Profile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseChatRecommendationsResponse parses an HTTP response from a ChatRecommendationsWithResponse call
+func ParseChatRecommendationsResponse(rsp *http.Response) (*ChatRecommendationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ChatRecommendationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRecommendedMoviesResponse parses an HTTP response from a GetRecommendedMoviesWithResponse call
+func ParseGetRecommendedMoviesResponse(rsp *http.Response) (*GetRecommendedMoviesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRecommendedMoviesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Movie
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers GetRecommendedMoviesResponse200Headers
+		if values := rsp.Header.Values("X-Experiment"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Experiment", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.XExperiment = &value
+		}
+		response.Headers200 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseRefreshTokensResponse parses an HTTP response from a RefreshTokensWithResponse call
+func ParseRefreshTokensResponse(rsp *http.Response) (*RefreshTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RefreshTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegisterUserResponse parses an HTTP response from a RegisterUserWithResponse call
+func ParseRegisterUserResponse(rsp *http.Response) (*RegisterUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegisterUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest InsertResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateAdminReviewResponse parses an HTTP response from a UpdateAdminReviewWithResponse call
+func ParseUpdateAdminReviewResponse(rsp *http.Response) (*UpdateAdminReviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAdminReviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 202:
+		var headers UpdateAdminReviewResponse202Headers
+		if values := rsp.Header.Values("Location"); len(values) > 0 {
+			var value string
+			if err := runtime.BindStyledParameterWithOptions("simple", "Location", values[0], &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			}
+			headers.Location = &value
+		}
+		response.Headers202 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseGetWatchlistResponse parses an HTTP response from a GetWatchlistWithResponse call
+func ParseGetWatchlistResponse(rsp *http.Response) (*GetWatchlistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWatchlistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Movie
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveFromWatchlistResponse parses an HTTP response from a RemoveFromWatchlistWithResponse call
+func ParseRemoveFromWatchlistResponse(rsp *http.Response) (*RemoveFromWatchlistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveFromWatchlistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 204:
+		break // No content-type
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddToWatchlistResponse parses an HTTP response from a AddToWatchlistWithResponse call
+func ParseAddToWatchlistResponse(rsp *http.Response) (*AddToWatchlistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddToWatchlistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 204:
+		break // No content-type
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
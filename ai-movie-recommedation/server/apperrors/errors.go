@@ -0,0 +1,185 @@
+package apperrors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Error is a domain error with a stable machine-readable code. Only Status,
+// Code, Detail and Fields are ever shown to clients; Cause is for logs.
+type Error struct {
+	Status int
+	Code   string
+	Detail string
+	Fields []FieldError
+	Cause  error
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+func New(status int, code, detail string) *Error {
+	return &Error{Status: status, Code: code, Detail: detail}
+}
+
+func (e *Error) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Detail, e.Cause)
+	}
+	return e.Code + ": " + e.Detail
+}
+
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// Is matches on code so that wrapped copies still compare equal to the sentinel.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+func (e *Error) WithCause(err error) *Error {
+	copied := *e
+	copied.Cause = err
+	return &copied
+}
+
+func (e *Error) WithDetail(detail string) *Error {
+	copied := *e
+	copied.Detail = detail
+	return &copied
+}
+
+var (
+	ErrInvalidInput        = New(http.StatusBadRequest, "invalid_input", "The request body could not be parsed.")
+	ErrValidation          = New(http.StatusBadRequest, "validation_failed", "One or more fields are invalid.")
+	ErrMissingParameter    = New(http.StatusBadRequest, "missing_parameter", "A required parameter is missing.")
+	ErrUnauthenticated     = New(http.StatusUnauthorized, "unauthenticated", "Authentication is required.")
+	ErrInvalidToken        = New(http.StatusUnauthorized, "invalid_token", "The access token is invalid or expired.")
+	ErrInvalidRefresh      = New(http.StatusUnauthorized, "invalid_refresh_token", "The refresh token is missing, invalid or expired.")
+	ErrInvalidCredentials  = New(http.StatusUnauthorized, "invalid_credentials", "Invalid email or password.")
+	ErrAdminRequired       = New(http.StatusForbidden, "admin_required", "User must be part of the ADMIN role.")
+	ErrMovieNotFound       = New(http.StatusNotFound, "movie_not_found", "Movie not found.")
+	ErrRouteNotFound       = New(http.StatusNotFound, "route_not_found", "No route matches the requested path.")
+	ErrJobNotFound         = New(http.StatusNotFound, "job_not_found", "Job not found.")
+	ErrGenreNotFound       = New(http.StatusNotFound, "genre_not_found", "Genre not found.")
+	ErrPromptNotFound      = New(http.StatusNotFound, "prompt_not_found", "Prompt template not found.")
+	ErrExperimentNotFound  = New(http.StatusNotFound, "experiment_not_found", "Experiment not found.")
+	ErrProfileNotFound     = New(http.StatusNotFound, "profile_not_found", "Profile not found.")
+	ErrInvalidTemplate     = New(http.StatusBadRequest, "invalid_template", "The prompt template could not be rendered.")
+	ErrUserExists          = New(http.StatusConflict, "user_already_exists", "A user with this email already exists.")
+	ErrMovieExists         = New(http.StatusConflict, "movie_already_exists", "A movie with this IMDb ID already exists.")
+	ErrGenreExists         = New(http.StatusConflict, "genre_already_exists", "A genre with this ID already exists.")
+	ErrGenreInUse          = New(http.StatusConflict, "genre_in_use", "The genre is still referenced.")
+	ErrInvalidGenreParent  = New(http.StatusBadRequest, "invalid_genre_parent", "The parent genre does not exist or would create a cycle.")
+	ErrConflict            = New(http.StatusConflict, "conflict", "The resource conflicts with an existing one.")
+	ErrExperimentActive    = New(http.StatusConflict, "experiment_active", "Another experiment is already active.")
+	ErrNotEnoughRatings    = New(http.StatusConflict, "not_enough_ratings", "Rate more movies before finishing onboarding.")
+	ErrProfileExists       = New(http.StatusConflict, "profile_already_exists", "A profile with this name already exists.")
+	ErrProfileLimit        = New(http.StatusConflict, "profile_limit", "The account has the most profiles allowed.")
+	ErrKidsProfile         = New(http.StatusForbidden, "kids_profile", "Kids profiles cannot manage or switch profiles.")
+	ErrLLMUnavailable      = New(http.StatusBadGateway, "llm_unavailable", "The LLM service is unavailable.")
+	ErrLLMBudgetExceeded   = New(http.StatusTooManyRequests, "llm_budget_exceeded", "The daily LLM budget is spent; it resets at midnight UTC.")
+	ErrMetadataNotFound    = New(http.StatusNotFound, "metadata_not_found", "The metadata provider has no entry for this movie.")
+	ErrMetadataUnavailable = New(http.StatusBadGateway, "metadata_unavailable", "The movie metadata provider is unavailable.")
+	ErrInternal            = New(http.StatusInternalServerError, "internal_error", "An unexpected error occurred.")
+)
+
+// From normalises any error into an *Error, hiding unknown errors behind ErrInternal.
+func From(err error) *Error {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	return Internal(err)
+}
+
+// Internal wraps a storage or other unexpected error. A unique index violation
+// is the client's doing, so it becomes ErrConflict rather than a 500.
+func Internal(err error) *Error {
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrConflict.WithCause(err)
+	}
+	return ErrInternal.WithCause(err)
+}
+
+// Duplicate returns conflict when err is a unique index violation and
+// Internal(err) otherwise.
+func Duplicate(err error, conflict *Error) *Error {
+	if mongo.IsDuplicateKeyError(err) {
+		return conflict.WithCause(err)
+	}
+	return Internal(err)
+}
+
+// Binding maps a JSON decoding failure to ErrInvalidInput, naming the offending
+// field when the decoder reports one.
+func Binding(err error) *Error {
+	appErr := ErrInvalidInput.WithCause(err)
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		appErr.Fields = []FieldError{{
+			Field:   typeErr.Field,
+			Rule:    "type",
+			Message: "must be of type " + typeErr.Type.String(),
+		}}
+	}
+	return appErr
+}
+
+// Validation converts validator errors into per-field details.
+func Validation(err error) *Error {
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return ErrValidation.WithCause(err)
+	}
+
+	appErr := ErrValidation.WithCause(err)
+	for _, fe := range validationErrs {
+		appErr.Fields = append(appErr.Fields, FieldError{
+			Field:   fieldPath(fe),
+			Rule:    fe.Tag(),
+			Message: fieldMessage(fe),
+		})
+	}
+	return appErr
+}
+
+// fieldPath drops the top-level struct name, e.g. "Movie.genre[0].genre_name" -> "genre[0].genre_name".
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return fe.Field()
+}
+
+func fieldMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email address"
+	case "url":
+		return "must be a valid URL"
+	case "min":
+		return "must be at least " + fe.Param() + " characters"
+	case "max":
+		return "must be at most " + fe.Param() + " characters"
+	case "oneof":
+		return "must be one of: " + fe.Param()
+	default:
+		return "failed the " + fe.Tag() + " rule"
+	}
+}
@@ -0,0 +1,30 @@
+package apperrors
+
+import "net/http"
+
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem details document extended with a stable code.
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Instance  string       `json:"instance,omitempty"`
+	Code      string       `json:"code"`
+	RequestId string       `json:"request_id,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
+}
+
+func (e *Error) Problem(instance, requestId string) Problem {
+	return Problem{
+		Type:      "urn:magicstream:problem:" + e.Code,
+		Title:     http.StatusText(e.Status),
+		Status:    e.Status,
+		Detail:    e.Detail,
+		Instance:  instance,
+		Code:      e.Code,
+		RequestId: requestId,
+		Errors:    e.Fields,
+	}
+}
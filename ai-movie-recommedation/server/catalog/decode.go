@@ -0,0 +1,163 @@
+package catalog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+)
+
+var csvHeader = []string{"imdb_id", "title", "poster_path", "youtube_id", "genre", "admin_review", "ranking_value", "ranking_name"}
+
+// Row is one decoded movie. Errors holds problems found while decoding it,
+// such as a value of the wrong type; the row is then not imported.
+type Row struct {
+	Number int
+	Movie  models.Movie
+	Errors []apperrors.FieldError
+}
+
+// Decode reads every movie in r. Per-row problems are recorded on the row;
+// an error is returned only when the input as a whole cannot be read.
+func Decode(format Format, r io.Reader) ([]Row, error) {
+	switch format {
+	case FormatJSON:
+		return decodeJSON(r)
+	case FormatCSV:
+		return decodeCSV(r)
+	}
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+func decodeJSON(r io.Reader) ([]Row, error) {
+	decoder := json.NewDecoder(r)
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, fmt.Errorf("reading JSON: %w", err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, errors.New("JSON import must be an array of movies")
+	}
+
+	var rows []Row
+	for decoder.More() {
+		row := Row{Number: len(rows) + 1}
+		if err := decoder.Decode(&row.Movie); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if !errors.As(err, &typeErr) {
+				return nil, fmt.Errorf("reading movie %d: %w", row.Number, err)
+			}
+			row.Errors = apperrors.Binding(err).Fields
+		}
+		rows = append(rows, row)
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return nil, fmt.Errorf("reading JSON: %w", err)
+	}
+	return rows, nil
+}
+
+func decodeCSV(r io.Reader) ([]Row, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV header: %w", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		if !isCSVColumn(name) {
+			return nil, fmt.Errorf("unknown CSV column %q: expected %s", name, strings.Join(csvHeader, ","))
+		}
+		columns[name] = i
+	}
+
+	var rows []Row
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading CSV: %w", err)
+		}
+
+		row := Row{Number: len(rows) + 1}
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		row.Movie = models.Movie{
+			ImdbID:      field("imdb_id"),
+			Title:       field("title"),
+			PosterPath:  field("poster_path"),
+			YouTubeID:   field("youtube_id"),
+			AdminReview: field("admin_review"),
+			Ranking:     models.Ranking{RankingName: field("ranking_name")},
+		}
+
+		genres, err := parseGenres(field("genre"))
+		if err != nil {
+			row.Errors = append(row.Errors, apperrors.FieldError{Field: "genre", Rule: "format", Message: err.Error()})
+		}
+		row.Movie.Genre = genres
+
+		if value := field("ranking_value"); value != "" {
+			rankingValue, err := strconv.Atoi(value)
+			if err != nil {
+				row.Errors = append(row.Errors, apperrors.FieldError{Field: "ranking.ranking_value", Rule: "type", Message: "must be of type int"})
+			}
+			row.Movie.Ranking.RankingValue = rankingValue
+		}
+
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func isCSVColumn(name string) bool {
+	for _, column := range csvHeader {
+		if column == name {
+			return true
+		}
+	}
+	return false
+}
+
+// parseGenres reads a genre cell such as "4:Fantasy|7:Action".
+func parseGenres(cell string) ([]models.Genre, error) {
+	genres := []models.Genre{}
+	if cell == "" {
+		return genres, nil
+	}
+	for _, pair := range strings.Split(cell, "|") {
+		id, name, found := strings.Cut(pair, ":")
+		genreID, err := strconv.Atoi(strings.TrimSpace(id))
+		if !found || err != nil {
+			return genres, fmt.Errorf("must be genre_id:genre_name pairs separated by |, got %q", pair)
+		}
+		genres = append(genres, models.Genre{GenreID: genreID, GenreName: strings.TrimSpace(name)})
+	}
+	return genres, nil
+}
+
+func formatGenres(genres []models.Genre) string {
+	pairs := make([]string, len(genres))
+	for i, genre := range genres {
+		pairs[i] = strconv.Itoa(genre.GenreID) + ":" + genre.GenreName
+	}
+	return strings.Join(pairs, "|")
+}
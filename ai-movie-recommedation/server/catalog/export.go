@@ -0,0 +1,150 @@
+package catalog
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// flushEvery bounds how many movies are buffered before they are pushed to
+// the client, so large exports stream instead of building up in memory.
+const flushEvery = 100
+
+// Export streams every movie, ordered by imdb_id, in a form Import accepts.
+// It returns the number of movies written.
+func Export(ctx context.Context, movies *mongo.Collection, format Format, w io.Writer) (int, error) {
+	cursor, err := movies.Find(ctx, bson.D{}, options.Find().SetSort(bson.D{{Key: "imdb_id", Value: 1}}))
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var enc encoder
+	switch format {
+	case FormatJSON:
+		enc = &jsonEncoder{w: w}
+	case FormatCSV:
+		enc = &csvEncoder{w: csv.NewWriter(w)}
+	default:
+		return 0, fmt.Errorf("unsupported format %q", format)
+	}
+
+	if err := enc.begin(); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for cursor.Next(ctx) {
+		var movie models.Movie
+		if err := cursor.Decode(&movie); err != nil {
+			return count, err
+		}
+
+		if err := enc.write(movie); err != nil {
+			return count, err
+		}
+		count++
+
+		if count%flushEvery == 0 {
+			if err := enc.flush(); err != nil {
+				return count, err
+			}
+			if flusher, ok := w.(interface{ Flush() }); ok {
+				flusher.Flush()
+			}
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return count, err
+	}
+
+	return count, enc.end()
+}
+
+type encoder interface {
+	begin() error
+	write(movie models.Movie) error
+	flush() error
+	end() error
+}
+
+type jsonEncoder struct {
+	w     io.Writer
+	wrote bool
+}
+
+func (e *jsonEncoder) begin() error {
+	_, err := io.WriteString(e.w, "[")
+	return err
+}
+
+// exportedMovie hides the _id, which json's omitempty does not do for a zero
+// ObjectID, so exports match the import shape.
+type exportedMovie struct {
+	models.Movie
+	ID *struct{} `json:"_id,omitempty"`
+}
+
+func (e *jsonEncoder) write(movie models.Movie) error {
+	data, err := json.Marshal(exportedMovie{Movie: movie})
+	if err != nil {
+		return err
+	}
+	separator := "\n  "
+	if e.wrote {
+		separator = ",\n  "
+	}
+	e.wrote = true
+	if _, err := io.WriteString(e.w, separator); err != nil {
+		return err
+	}
+	_, err = e.w.Write(data)
+	return err
+}
+
+func (e *jsonEncoder) flush() error {
+	return nil
+}
+
+func (e *jsonEncoder) end() error {
+	_, err := io.WriteString(e.w, "\n]\n")
+	return err
+}
+
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func (e *csvEncoder) begin() error {
+	return e.w.Write(csvHeader)
+}
+
+func (e *csvEncoder) write(movie models.Movie) error {
+	return e.w.Write([]string{
+		movie.ImdbID,
+		movie.Title,
+		movie.PosterPath,
+		movie.YouTubeID,
+		formatGenres(movie.Genre),
+		movie.AdminReview,
+		strconv.Itoa(movie.Ranking.RankingValue),
+		movie.Ranking.RankingName,
+	})
+}
+
+func (e *csvEncoder) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvEncoder) end() error {
+	return e.flush()
+}
@@ -0,0 +1,55 @@
+// Package catalog bulk imports and exports movies as JSON or CSV. JSON uses
+// the same shape as migrations/data/movies.json; CSV has one movie per line
+// with the columns in csvHeader.
+package catalog
+
+import (
+	"fmt"
+	"mime"
+	"path/filepath"
+	"strings"
+)
+
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatCSV  Format = "csv"
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(s)) {
+	case FormatJSON:
+		return FormatJSON, nil
+	case FormatCSV:
+		return FormatCSV, nil
+	}
+	return "", fmt.Errorf("unsupported format %q: must be json or csv", s)
+}
+
+// FormatFromContentType maps a request Content-Type to a format.
+func FormatFromContentType(contentType string) (Format, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", fmt.Errorf("unsupported content type %q", contentType)
+	}
+	switch mediaType {
+	case "application/json":
+		return FormatJSON, nil
+	case "text/csv":
+		return FormatCSV, nil
+	}
+	return "", fmt.Errorf("unsupported content type %q: must be application/json or text/csv", mediaType)
+}
+
+// FormatFromPath picks the format from a file extension.
+func FormatFromPath(path string) (Format, error) {
+	return ParseFormat(strings.TrimPrefix(filepath.Ext(path), "."))
+}
+
+func (f Format) ContentType() string {
+	if f == FormatCSV {
+		return "text/csv; charset=utf-8"
+	}
+	return "application/json; charset=utf-8"
+}
@@ -0,0 +1,194 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/events"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/respcache"
+	"github.com/princepal9120/ai-movie-recommedation/server/taxonomy"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const batchSize = 500
+
+// Import validates rows and upserts the valid ones by imdb_id. Invalid rows,
+// including rows naming a genre_id that genres does not have, are reported
+// and skipped; they never stop the rest of the import. With dryRun nothing is
+// written and Inserted/Updated say what would happen.
+func Import(ctx context.Context, movies *mongo.Collection, genres taxonomy.Index, rows []Row, dryRun bool) (models.ImportReport, error) {
+	report := models.ImportReport{Total: len(rows), DryRun: dryRun, Errors: []models.RowError{}}
+
+	valid := make([]Row, 0, len(rows))
+	seen := map[string]int{}
+	for _, row := range rows {
+		fields := row.Errors
+		if len(fields) == 0 {
+			if err := models.Validate.Struct(row.Movie); err != nil {
+				fields = apperrors.Validation(err).Fields
+			}
+		}
+		if len(fields) == 0 {
+			row.Movie.Genre, fields = genres.Resolve(row.Movie.Genre, "genre")
+		}
+		if first, ok := seen[row.Movie.ImdbID]; ok && len(fields) == 0 {
+			fields = []apperrors.FieldError{{Field: "imdb_id", Rule: "unique", Message: fmt.Sprintf("duplicates row %d", first)}}
+		}
+		if len(fields) > 0 {
+			report.Errors = append(report.Errors, models.RowError{Row: row.Number, ImdbID: row.Movie.ImdbID, Errors: fields})
+			continue
+		}
+		seen[row.Movie.ImdbID] = row.Number
+		valid = append(valid, row)
+	}
+
+	if dryRun {
+		existing, err := existingIDs(ctx, movies, valid)
+		if err != nil {
+			return report, err
+		}
+		report.Updated = existing
+		report.Inserted = len(valid) - existing
+	} else {
+		for start := 0; start < len(valid); start += batchSize {
+			end := min(start+batchSize, len(valid))
+			if err := writeBatch(ctx, movies, valid[start:end], &report); err != nil {
+				return report, err
+			}
+		}
+	}
+
+	report.Failed = len(report.Errors)
+	return report, nil
+}
+
+func writeBatch(ctx context.Context, movies *mongo.Collection, batch []Row, report *models.ImportReport) error {
+	// The movies as they were before the write tell which reviews changed,
+	// and without a change stream the events are worked out from them too.
+	before, err := existingMovies(ctx, movies, batch)
+	if err != nil {
+		return err
+	}
+
+	writes := make([]mongo.WriteModel, len(batch))
+	for i, row := range batch {
+		set, err := movieFields(row.Movie)
+		if err != nil {
+			return err
+		}
+		update := bson.D{{Key: "$set", Value: set}}
+		if row.Movie.AdminReview == "" {
+			update = append(update, bson.E{Key: "$setOnInsert", Value: bson.D{{Key: "admin_review", Value: ""}}})
+		}
+		writes[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "imdb_id", Value: row.Movie.ImdbID}}).
+			SetUpdate(update).
+			SetUpsert(true)
+	}
+
+	result, err := movies.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+
+	var bulkErr mongo.BulkWriteException
+	if err != nil && !errors.As(err, &bulkErr) {
+		return err
+	}
+	failed := map[int]bool{}
+	for _, writeErr := range bulkErr.WriteErrors {
+		failed[writeErr.Index] = true
+		row := batch[writeErr.Index]
+		report.Errors = append(report.Errors, models.RowError{
+			Row:    row.Number,
+			ImdbID: row.Movie.ImdbID,
+			Errors: []apperrors.FieldError{{Field: "imdb_id", Rule: "write", Message: writeErr.Message}},
+		})
+	}
+
+	if result != nil {
+		if result.UpsertedCount+result.ModifiedCount > 0 {
+			respcache.Invalidate(respcache.ScopeMovies)
+		}
+		report.Inserted += int(result.UpsertedCount)
+		report.Updated += int(result.ModifiedCount)
+		report.Unchanged += int(result.MatchedCount - result.ModifiedCount)
+	}
+
+	local := events.Source() == events.SourceLocal
+	for i, row := range batch {
+		if failed[i] {
+			continue
+		}
+		movie := row.Movie
+		existing := before[movie.ImdbID]
+		if existing != nil && movie.AdminReview == "" {
+			movie.AdminReview = existing.AdminReview
+		}
+		if existing != nil && existing.AdminReview != movie.AdminReview {
+			report.Reviewed = append(report.Reviewed, models.ReviewChange{ImdbID: movie.ImdbID, AdminReview: movie.AdminReview})
+		}
+		if local {
+			events.Publish(events.Diff(before[movie.ImdbID], &movie)...)
+		}
+	}
+	return nil
+}
+
+// movieFields is what an import sets on a movie. A row without a review
+// keeps the one that is stored, so the field is left out; writeBatch sets it
+// to empty only when the movie is inserted.
+func movieFields(movie models.Movie) (bson.D, error) {
+	movie.ID = bson.ObjectID{}
+	raw, err := bson.Marshal(movie)
+	if err != nil {
+		return nil, err
+	}
+	var fields bson.D
+	if err := bson.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	if movie.AdminReview == "" {
+		fields = slices.DeleteFunc(fields, func(field bson.E) bool { return field.Key == "admin_review" })
+	}
+	return fields, nil
+}
+
+// existingMovies returns the reviews and rankings of the batch's movies that
+// are already in the catalog, by imdb_id.
+func existingMovies(ctx context.Context, movies *mongo.Collection, rows []Row) (map[string]*models.Movie, error) {
+	ids := make(bson.A, len(rows))
+	for i, row := range rows {
+		ids[i] = row.Movie.ImdbID
+	}
+	cursor, err := movies.Find(ctx,
+		bson.D{{Key: "imdb_id", Value: bson.D{{Key: "$in", Value: ids}}}},
+		options.Find().SetProjection(bson.D{{Key: "imdb_id", Value: 1}, {Key: "admin_review", Value: 1}, {Key: "ranking", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	var found []models.Movie
+	if err := cursor.All(ctx, &found); err != nil {
+		return nil, err
+	}
+	existing := make(map[string]*models.Movie, len(found))
+	for i := range found {
+		existing[found[i].ImdbID] = &found[i]
+	}
+	return existing, nil
+}
+
+func existingIDs(ctx context.Context, movies *mongo.Collection, rows []Row) (int, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	ids := make(bson.A, len(rows))
+	for i, row := range rows {
+		ids[i] = row.Movie.ImdbID
+	}
+	count, err := movies.CountDocuments(ctx, bson.D{{Key: "imdb_id", Value: bson.D{{Key: "$in", Value: ids}}}})
+	return int(count), err
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/princepal9120/ai-movie-recommedation/server/catalog"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/jobs"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/taxonomy"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const usage = `Usage: catalogctl [flags] <command> [file]
+
+Commands:
+  import   upsert movies from a JSON or CSV file (stdin when file is - or omitted)
+  export   write every movie to file (stdout when file is - or omitted)
+
+Flags:
+`
+
+func main() {
+	envFile := flag.String("env-file", ".env", "path of the .env file to load")
+	formatFlag := flag.String("format", "", "json or csv (defaults to the file extension, then json)")
+	dryRun := flag.Bool("dry-run", false, "import: validate and report without writing")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	err := godotenv.Load(*envFile)
+
+	logging.Init()
+
+	if err != nil {
+		slog.Warn("unable to load env file", "path", *envFile)
+	}
+
+	command, path := flag.Arg(0), flag.Arg(1)
+	if command != "import" && command != "export" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	format, err := resolveFormat(*formatFlag, path)
+	if err != nil {
+		slog.Error("invalid format", "error", err)
+		os.Exit(2)
+	}
+
+	client := database.Connect()
+	if client == nil {
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	movies := database.OpenCollection("movies", client)
+
+	if command == "import" {
+		err = importMovies(ctx, client, movies, format, path, *dryRun)
+	} else {
+		err = exportMovies(ctx, movies, format, path)
+	}
+
+	cancel()
+	if disconnectErr := client.Disconnect(context.Background()); disconnectErr != nil {
+		slog.Error("failed to disconnect from MongoDB", "error", disconnectErr)
+	}
+	if err != nil {
+		slog.Error(command+" failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+func resolveFormat(flagValue, path string) (catalog.Format, error) {
+	if flagValue != "" {
+		return catalog.ParseFormat(flagValue)
+	}
+	if path != "" && path != "-" {
+		return catalog.FormatFromPath(path)
+	}
+	return catalog.FormatJSON, nil
+}
+
+func importMovies(ctx context.Context, client *mongo.Client, movies *mongo.Collection, format catalog.Format, path string, dryRun bool) error {
+	var r io.Reader = os.Stdin
+	if path != "" && path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	rows, err := catalog.Decode(format, r)
+	if err != nil {
+		return err
+	}
+
+	genres, err := taxonomy.Load(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	report, err := catalog.Import(ctx, movies, genres, rows, dryRun)
+	if err != nil {
+		return err
+	}
+
+	printReport(report)
+	if err := queueReviewed(ctx, client, report.Reviewed); err != nil {
+		return err
+	}
+	if report.Failed > 0 {
+		return fmt.Errorf("%d of %d movies were rejected", report.Failed, report.Total)
+	}
+	return nil
+}
+
+// queueReviewed ranks and, unless AUTO_SUMMARIZE is "off", summarizes the
+// movies whose review the import changed, as the server does after a review
+// update. The server's worker runs the jobs.
+func queueReviewed(ctx context.Context, client *mongo.Client, reviewed []models.ReviewChange) error {
+	summarize := !strings.EqualFold(os.Getenv("AUTO_SUMMARIZE"), "off")
+	for _, change := range reviewed {
+		if _, err := jobs.EnqueueReviewRanking(ctx, client, change.ImdbID, change.AdminReview); err != nil {
+			return fmt.Errorf("queueing the ranking of %s: %w", change.ImdbID, err)
+		}
+		if !summarize {
+			continue
+		}
+		if _, err := jobs.EnqueueMovieSummary(ctx, client, change.ImdbID); err != nil {
+			return fmt.Errorf("queueing the summary of %s: %w", change.ImdbID, err)
+		}
+	}
+	if len(reviewed) > 0 {
+		fmt.Printf("queued review ranking of %d movies with a changed review\n", len(reviewed))
+	}
+	return nil
+}
+
+func printReport(report models.ImportReport) {
+	prefix := ""
+	if report.DryRun {
+		prefix = "dry run: would have "
+	}
+	fmt.Printf("%sinserted %d, updated %d, unchanged %d, rejected %d of %d movies\n",
+		prefix, report.Inserted, report.Updated, report.Unchanged, report.Failed, report.Total)
+
+	for _, rowErr := range report.Errors {
+		for _, fieldErr := range rowErr.Errors {
+			fmt.Printf("  row %d (%s): %s %s\n", rowErr.Row, rowErr.ImdbID, fieldErr.Field, fieldErr.Message)
+		}
+	}
+}
+
+func exportMovies(ctx context.Context, movies *mongo.Collection, format catalog.Format, path string) (err error) {
+	var w io.Writer = os.Stdout
+	if path != "" && path != "-" {
+		file, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			err = errors.Join(err, file.Close())
+		}()
+		w = file
+	}
+
+	count, err := catalog.Export(ctx, movies, format, w)
+	if err != nil {
+		return err
+	}
+	slog.Info("movies exported", "format", format, "count", count)
+	return nil
+}
@@ -0,0 +1,189 @@
+// Package chat turns a viewer's request in their own words into catalog
+// filters with the LLM selected by the llm package.
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/llm"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/prompts"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const operation = "recommendation_filters"
+
+// ErrInvalidAnswer is returned when the LLM does not answer with the
+// requested JSON object.
+var ErrInvalidAnswer = errors.New("LLM answered with invalid filters")
+
+// Vocabulary is what the catalog can be filtered by.
+type Vocabulary struct {
+	Genres          []string
+	MoodTags        []string
+	ContentWarnings []string
+}
+
+// Interpretation is what the LLM made of a request.
+type Interpretation struct {
+	Filters models.ChatFilters
+	// Reply is a sentence introducing the results.
+	Reply  string
+	Prompt models.PromptRef
+}
+
+// LoadVocabulary reads the genre names and the mood tags and content warnings
+// used by movie summaries.
+func LoadVocabulary(ctx context.Context, client *mongo.Client) (*Vocabulary, error) {
+	var genres []models.Genre
+	cursor, err := database.OpenCollection("genres", client).Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &genres); err != nil {
+		return nil, err
+	}
+
+	vocabulary := &Vocabulary{}
+	for _, genre := range genres {
+		vocabulary.Genres = append(vocabulary.Genres, genre.GenreName)
+	}
+	sort.Strings(vocabulary.Genres)
+
+	movies := database.OpenCollection("movies", client)
+	if vocabulary.MoodTags, err = distinct(ctx, movies, "summary.mood_tags"); err != nil {
+		return nil, err
+	}
+	if vocabulary.ContentWarnings, err = distinct(ctx, movies, "summary.content_warnings"); err != nil {
+		return nil, err
+	}
+	return vocabulary, nil
+}
+
+func distinct(ctx context.Context, collection *mongo.Collection, field string) ([]string, error) {
+	var values []string
+	if err := collection.Distinct(ctx, field, bson.D{}).Decode(&values); err != nil {
+		return nil, err
+	}
+	sort.Strings(values)
+	return values, nil
+}
+
+// Interpret asks the LLM for filters matching message, rendering prompt with
+// the values in vocabulary.
+func Interpret(ctx context.Context, gateway *llm.Gateway, prompt *models.PromptTemplate, vocabulary *Vocabulary, message string) (*Interpretation, error) {
+	rendered, err := prompts.Render(prompt, prompts.RecommendationFiltersData{
+		Genres:          vocabulary.Genres,
+		MoodTags:        vocabulary.MoodTags,
+		ContentWarnings: vocabulary.ContentWarnings,
+		Message:         strings.Join(strings.Fields(message), " "),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	completion, err := gateway.Complete(ctx, operation, rendered)
+	if err != nil {
+		return nil, err
+	}
+
+	interpretation, err := parse(completion.Text, vocabulary)
+	if err != nil {
+		// Do not let a bad answer stick: asking again should ask the model.
+		if err := gateway.Forget(ctx, operation, rendered); err != nil {
+			return nil, err
+		}
+		return nil, err
+	}
+	interpretation.Prompt = prompts.Ref(prompt)
+	return interpretation, nil
+}
+
+// parse reads the JSON object out of answer. Values outside the vocabulary are
+// dropped rather than matching nothing.
+func parse(answer string, vocabulary *Vocabulary) (*Interpretation, error) {
+	text := strings.TrimSpace(answer)
+	if start, end := strings.Index(text, "{"), strings.LastIndex(text, "}"); start >= 0 && end > start {
+		text = text[start : end+1]
+	}
+
+	var parsed struct {
+		models.ChatFilters
+		Reply string `json:"reply"`
+	}
+	if err := json.Unmarshal([]byte(text), &parsed); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAnswer, err)
+	}
+
+	filters := models.ChatFilters{
+		Genres:                 known(parsed.Genres, vocabulary.Genres),
+		MoodTags:               known(parsed.MoodTags, vocabulary.MoodTags),
+		ExcludeContentWarnings: known(parsed.ExcludeContentWarnings, vocabulary.ContentWarnings),
+		MinYear:                max(parsed.MinYear, 0),
+		MaxYear:                max(parsed.MaxYear, 0),
+		MaxRuntimeMinutes:      max(parsed.MaxRuntimeMinutes, 0),
+	}
+	if filters.MaxYear > 0 && filters.MinYear > filters.MaxYear {
+		filters.MinYear, filters.MaxYear = filters.MaxYear, filters.MinYear
+	}
+	return &Interpretation{Filters: filters, Reply: strings.TrimSpace(parsed.Reply)}, nil
+}
+
+// known maps values onto the vocabulary's spelling, ignoring case, and drops
+// the ones it does not contain.
+func known(values, vocabulary []string) []string {
+	canonical := map[string]string{}
+	for _, word := range vocabulary {
+		canonical[strings.ToLower(word)] = word
+	}
+
+	seen := map[string]bool{}
+	matched := []string{}
+	for _, value := range values {
+		word, ok := canonical[strings.ToLower(strings.TrimSpace(value))]
+		if ok && !seen[word] {
+			seen[word] = true
+			matched = append(matched, word)
+		}
+	}
+	return matched
+}
+
+// Query is the movies collection filter for filters.
+func Query(filters models.ChatFilters) bson.D {
+	query := bson.D{}
+	if len(filters.Genres) > 0 {
+		query = append(query, bson.E{Key: "genre.genre_name", Value: bson.D{{Key: "$in", Value: filters.Genres}}})
+	}
+	if len(filters.MoodTags) > 0 {
+		query = append(query, bson.E{Key: "summary.mood_tags", Value: bson.D{{Key: "$in", Value: filters.MoodTags}}})
+	}
+	if len(filters.ExcludeContentWarnings) > 0 {
+		// A movie without a summary might have any warning, so it is left out.
+		query = append(query,
+			bson.E{Key: "summary", Value: bson.D{{Key: "$exists", Value: true}}},
+			bson.E{Key: "summary.content_warnings", Value: bson.D{{Key: "$nin", Value: filters.ExcludeContentWarnings}}})
+	}
+
+	year := bson.D{}
+	if filters.MinYear > 0 {
+		year = append(year, bson.E{Key: "$gte", Value: filters.MinYear})
+	}
+	if filters.MaxYear > 0 {
+		year = append(year, bson.E{Key: "$lte", Value: filters.MaxYear})
+	}
+	if len(year) > 0 {
+		query = append(query, bson.E{Key: "metadata.release_year", Value: year})
+	}
+	if filters.MaxRuntimeMinutes > 0 {
+		query = append(query, bson.E{Key: "metadata.runtime_minutes", Value: bson.D{{Key: "$lte", Value: filters.MaxRuntimeMinutes}}})
+	}
+	return query
+}
@@ -0,0 +1,198 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/popularity"
+	"github.com/princepal9120/ai-movie-recommedation/server/profile"
+	"github.com/princepal9120/ai-movie-recommedation/server/recommend"
+	"github.com/princepal9120/ai-movie-recommedation/server/utils"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// RateMovie stores the score of the user, or their selected profile, for a
+// movie, replacing any earlier one. Only a movie's first rating by a profile
+// counts towards its popularity.
+func RateMovie(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		userId, err := utils.GetUserIdFromContext(c)
+		if err != nil {
+			c.Error(apperrors.ErrUnauthenticated.WithCause(err))
+			return
+		}
+
+		var req models.RatingRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(apperrors.Binding(err))
+			return
+		}
+		if err := validate.Struct(req); err != nil {
+			c.Error(apperrors.Validation(err))
+			return
+		}
+
+		movieId := c.Param("imdb_id")
+		if appErr := movieExists(ctx, client, movieId); appErr != nil {
+			c.Error(appErr)
+			return
+		}
+
+		now := time.Now().UTC()
+		filter := append(profile.Filter(userId, utils.GetProfileIdFromContext(c)), bson.E{Key: "imdb_id", Value: movieId})
+		update := bson.D{
+			{Key: "$set", Value: bson.D{{Key: "score", Value: req.Score}, {Key: "updated_at", Value: now}}},
+			{Key: "$setOnInsert", Value: bson.D{{Key: "rated_at", Value: now}}},
+		}
+
+		var ratingCollection *mongo.Collection = database.OpenCollection("ratings", client)
+
+		result, err := ratingCollection.UpdateOne(ctx, filter, update, options.UpdateOne().SetUpsert(true))
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		if result.UpsertedCount > 0 {
+			recordActivity(ctx, c, client, movieId, popularity.Rating)
+		}
+
+		var rating models.Rating
+		if err := ratingCollection.FindOne(ctx, filter).Decode(&rating); err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		c.JSON(http.StatusOK, rating)
+	}
+}
+
+// GetWatchlist returns the movies on the watchlist of the user or their
+// selected profile, most recently added first.
+func GetWatchlist(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		userId, err := utils.GetUserIdFromContext(c)
+		if err != nil {
+			c.Error(apperrors.ErrUnauthenticated.WithCause(err))
+			return
+		}
+
+		cursor, err := database.OpenCollection("watchlist", client).Find(ctx,
+			profile.Filter(userId, utils.GetProfileIdFromContext(c)),
+			options.Find().SetSort(bson.D{{Key: "added_at", Value: -1}}))
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		var entries []models.WatchlistEntry
+		if err := cursor.All(ctx, &entries); err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		ids := make([]string, len(entries))
+		for i, entry := range entries {
+			ids[i] = entry.ImdbID
+		}
+		movies, err := recommend.ByID(ctx, client, ids)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		c.JSON(http.StatusOK, movies)
+	}
+}
+
+// AddToWatchlist saves a movie on the user's watchlist. Adding a movie that is
+// already there changes nothing.
+func AddToWatchlist(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		userId, err := utils.GetUserIdFromContext(c)
+		if err != nil {
+			c.Error(apperrors.ErrUnauthenticated.WithCause(err))
+			return
+		}
+
+		movieId := c.Param("imdb_id")
+		if appErr := movieExists(ctx, client, movieId); appErr != nil {
+			c.Error(appErr)
+			return
+		}
+
+		result, err := database.OpenCollection("watchlist", client).UpdateOne(ctx,
+			append(profile.Filter(userId, utils.GetProfileIdFromContext(c)), bson.E{Key: "imdb_id", Value: movieId}),
+			bson.D{{Key: "$setOnInsert", Value: bson.D{{Key: "added_at", Value: time.Now().UTC()}}}},
+			options.UpdateOne().SetUpsert(true))
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		if result.UpsertedCount > 0 {
+			recordActivity(ctx, c, client, movieId, popularity.WatchlistAdd)
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// RemoveFromWatchlist takes a movie off the user's watchlist.
+func RemoveFromWatchlist(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		userId, err := utils.GetUserIdFromContext(c)
+		if err != nil {
+			c.Error(apperrors.ErrUnauthenticated.WithCause(err))
+			return
+		}
+
+		_, err = database.OpenCollection("watchlist", client).DeleteOne(ctx,
+			append(profile.Filter(userId, utils.GetProfileIdFromContext(c)), bson.E{Key: "imdb_id", Value: c.Param("imdb_id")}))
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// recordActivity counts an activity towards the movie's popularity. Losing
+// one only makes the scores slightly less accurate, so it never fails the
+// request.
+func recordActivity(ctx context.Context, c *gin.Context, client *mongo.Client, imdbID string, activity popularity.Activity) {
+	if err := popularity.Record(ctx, client, imdbID, activity); err != nil {
+		logging.FromContext(c).Warn("failed to record movie activity", "imdb_id", imdbID, "activity", activity, "error", err)
+	}
+}
+
+func movieExists(ctx context.Context, client *mongo.Client, imdbID string) *apperrors.Error {
+	opts := options.FindOne().SetProjection(bson.D{{Key: "imdb_id", Value: 1}})
+	err := database.OpenCollection("movies", client).FindOne(ctx, bson.D{{Key: "imdb_id", Value: imdbID}}, opts).Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return apperrors.ErrMovieNotFound
+	}
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/catalog"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/jobs"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/taxonomy"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const maxImportBytes = 32 << 20
+
+// ImportMovies upserts movies from a JSON or CSV body. The format comes from
+// the format query parameter, falling back to the Content-Type header.
+func ImportMovies(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		format, err := catalogFormat(c, func() (catalog.Format, error) {
+			return catalog.FormatFromContentType(c.ContentType())
+		})
+		if err != nil {
+			c.Error(apperrors.ErrInvalidInput.WithDetail(err.Error()))
+			return
+		}
+
+		dryRun, _ := strconv.ParseBool(c.Query("dry_run"))
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportBytes)
+		rows, err := catalog.Decode(format, c.Request.Body)
+		if err != nil {
+			c.Error(apperrors.ErrInvalidInput.WithDetail(err.Error()).WithCause(err))
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		var movieCollection *mongo.Collection = database.OpenCollection("movies", client)
+
+		genres, err := taxonomy.Load(ctx, client)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		report, err := catalog.Import(ctx, movieCollection, genres, rows, dryRun)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		logger := logging.FromContext(c)
+		logger.Info("movies imported",
+			"format", format, "dry_run", dryRun, "total", report.Total,
+			"inserted", report.Inserted, "updated", report.Updated, "failed", report.Failed)
+
+		// Movies whose review changed are ranked again, as after a review
+		// update. The import itself has succeeded, so failing to queue only
+		// leaves the old ranking until an admin reranks.
+		for _, change := range report.Reviewed {
+			job, err := jobs.EnqueueReviewRanking(ctx, client, change.ImdbID, change.AdminReview)
+			if err != nil {
+				logger.Warn("failed to queue review ranking", "imdb_id", change.ImdbID, "error", err)
+				continue
+			}
+			logger.Info("review ranking queued", "imdb_id", change.ImdbID, "job_id", job.ID.Hex())
+			queueSummary(ctx, c, client, change.ImdbID)
+		}
+
+		c.JSON(http.StatusOK, report)
+	}
+}
+
+// ExportMovies streams the whole catalog as JSON (default) or CSV.
+func ExportMovies(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		format, err := catalogFormat(c, func() (catalog.Format, error) {
+			return catalog.FormatJSON, nil
+		})
+		if err != nil {
+			c.Error(apperrors.ErrInvalidInput.WithDetail(err.Error()))
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		var movieCollection *mongo.Collection = database.OpenCollection("movies", client)
+
+		c.Header("Content-Type", format.ContentType())
+		c.Header("Content-Disposition", `attachment; filename="movies.`+string(format)+`"`)
+		c.Status(http.StatusOK)
+
+		count, err := catalog.Export(ctx, movieCollection, format, c.Writer)
+		if err != nil {
+			// Headers are already sent, so the client sees a truncated body.
+			logging.FromContext(c).Error("movie export failed", "format", format, "exported", count, "error", err)
+			c.Abort()
+			return
+		}
+
+		logging.FromContext(c).Info("movies exported", "format", format, "count", count)
+	}
+}
+
+func catalogFormat(c *gin.Context, fallback func() (catalog.Format, error)) (catalog.Format, error) {
+	if format := c.Query("format"); format != "" {
+		return catalog.ParseFormat(format)
+	}
+	return fallback()
+}
@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/chat"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/llm"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/metrics"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/prompts"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const defaultChatLimit = 10
+
+// ChatRecommendations answers a request in the viewer's own words. The LLM
+// turns it into catalog filters, within the selected profile's maturity
+// setting, and the answer is streamed as server-sent events: "filters", then
+// a "message" introducing the picks, one "movie" per match and finally
+// "done". Errors before the stream starts are ordinary problem responses; a
+// failure while streaming ends it with an "error" event.
+func ChatRecommendations(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		var req models.ChatRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(apperrors.Binding(err))
+			return
+		}
+		if err := validate.Struct(req); err != nil {
+			c.Error(apperrors.Validation(err))
+			return
+		}
+		if req.Limit == 0 {
+			req.Limit = defaultChatLimit
+		}
+
+		viewer, appErr := currentViewer(ctx, c, client)
+		if appErr != nil {
+			c.Error(appErr)
+			return
+		}
+
+		vocabulary, err := chat.LoadVocabulary(ctx, client)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		prompt, err := prompts.Latest(ctx, client, prompts.RecommendationFilters)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		gateway, err := llm.NewGateway(client)
+		if err != nil {
+			c.Error(apperrors.ErrLLMUnavailable.WithCause(err))
+			return
+		}
+
+		interpretation, err := chat.Interpret(ctx, gateway, prompt, vocabulary, req.Message)
+		if err != nil {
+			switch {
+			case errors.Is(err, llm.ErrBudgetExceeded):
+				c.Error(apperrors.ErrLLMBudgetExceeded.WithCause(err))
+			case errors.Is(err, chat.ErrInvalidAnswer):
+				c.Error(apperrors.ErrLLMUnavailable.WithDetail("The recommendation assistant gave an answer that could not be understood.").WithCause(err))
+			case errors.Is(err, prompts.ErrInvalid):
+				c.Error(apperrors.Internal(err))
+			default:
+				c.Error(apperrors.ErrLLMUnavailable.WithCause(err))
+			}
+			return
+		}
+
+		logger := logging.FromContext(c)
+		logger.Info("chat request interpreted", "filters", interpretation.Filters, "prompt_version", interpretation.Prompt.Version)
+
+		findOptions := options.Find().
+			SetSort(bson.D{{Key: "ranking.ranking_value", Value: 1}}).
+			SetLimit(int64(req.Limit))
+
+		var movieCollection *mongo.Collection = database.OpenCollection("movies", client)
+
+		// The filters narrow the catalog; the profile's maturity setting still
+		// bounds what may be recommended.
+		cursor, err := movieCollection.Find(ctx, viewer.Filter(chat.Query(interpretation.Filters)), findOptions)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		defer cursor.Close(ctx)
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("X-Accel-Buffering", "no")
+		c.Status(http.StatusOK)
+
+		send := func(event string, data any) {
+			c.SSEvent(event, data)
+			c.Writer.Flush()
+		}
+
+		send("filters", interpretation.Filters)
+		send("message", interpretation.Reply)
+
+		count := 0
+		for cursor.Next(ctx) {
+			var movie models.Movie
+			if err := cursor.Decode(&movie); err != nil {
+				streamError(c, send, apperrors.Internal(err))
+				return
+			}
+			send("movie", movie)
+			count++
+		}
+		if err := cursor.Err(); err != nil {
+			streamError(c, send, apperrors.Internal(err))
+			return
+		}
+
+		logger.Info("chat recommendations streamed", "count", count)
+		metrics.ObserveRecommendations(count)
+
+		send("done", gin.H{"count": count})
+	}
+}
+
+// streamError ends a stream that has already started, where a problem response
+// can no longer be sent. The error is still attached for the request log.
+func streamError(c *gin.Context, send func(string, any), appErr *apperrors.Error) {
+	c.Error(appErr)
+	logging.FromContext(c).Error("chat stream failed", "code", appErr.Code, "error", appErr.Cause)
+	send("error", appErr.Problem(c.Request.URL.Path, c.GetString("requestId")))
+}
@@ -0,0 +1,78 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/enrichment"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// enrichTimeout bounds how long AddMovie waits on the metadata provider.
+const enrichTimeout = 5 * time.Second
+
+// enrichMovie fills in metadata for a newly added movie. It never fails the
+// request: a movie without metadata is picked up by the refresh job later.
+func enrichMovie(c *gin.Context, movieCollection *mongo.Collection, imdbID string) {
+	logger := logging.FromContext(c)
+
+	provider, err := enrichment.New()
+	if err != nil {
+		if !errors.Is(err, enrichment.ErrDisabled) {
+			logger.Warn("metadata provider unavailable", "error", err)
+		}
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c, enrichTimeout)
+	defer cancel()
+
+	_, err = enrichment.Enrich(ctx, movieCollection, provider, imdbID)
+	switch {
+	case errors.Is(err, enrichment.ErrNotFound):
+		logger.Info("no metadata found for movie", "imdb_id", imdbID, "provider", provider.Name())
+	case err != nil:
+		logger.Warn("metadata enrichment failed", "imdb_id", imdbID, "provider", provider.Name(), "error", err)
+	}
+}
+
+// EnrichMovie refreshes one movie's metadata on demand.
+func EnrichMovie(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		movieId := c.Param("imdb_id")
+
+		provider, err := enrichment.New()
+		if err != nil {
+			c.Error(apperrors.ErrMetadataUnavailable.WithCause(err))
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		var movieCollection *mongo.Collection = database.OpenCollection("movies", client)
+
+		metadata, err := enrichment.Enrich(ctx, movieCollection, provider, movieId)
+		if err != nil {
+			switch {
+			case errors.Is(err, mongo.ErrNoDocuments):
+				c.Error(apperrors.ErrMovieNotFound)
+			case errors.Is(err, enrichment.ErrNotFound):
+				c.Error(apperrors.ErrMetadataNotFound.WithCause(err))
+			default:
+				c.Error(apperrors.ErrMetadataUnavailable.WithCause(err))
+			}
+			return
+		}
+
+		logging.FromContext(c).Info("movie enriched", "imdb_id", movieId, "provider", provider.Name())
+
+		c.JSON(http.StatusOK, metadata)
+	}
+}
@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/events"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+)
+
+// eventsKeepAlive is how often an idle event stream gets a comment line, so
+// proxies do not close it.
+const eventsKeepAlive = 25 * time.Second
+
+// StreamCatalogEvents streams changes to the catalog as server-sent events
+// named after their type: movie-added, review-updated and ranking-changed.
+// The stream only ends when the client goes away or falls too far behind; in
+// the latter case the client should reconnect and reload the catalog.
+func StreamCatalogEvents() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		feed, unsubscribe := events.Subscribe()
+		defer unsubscribe()
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("X-Accel-Buffering", "no")
+		c.Status(http.StatusOK)
+		c.Writer.Flush()
+
+		keepAlive := time.NewTicker(eventsKeepAlive)
+		defer keepAlive.Stop()
+
+		for {
+			select {
+			case <-c.Request.Context().Done():
+				return
+			case event, ok := <-feed:
+				if !ok {
+					logging.FromContext(c).Warn("catalog event stream fell behind, closing it")
+					return
+				}
+				c.SSEvent(event.Type, event)
+				c.Writer.Flush()
+			case <-keepAlive.C:
+				c.Writer.WriteString(": keep-alive\n\n")
+				c.Writer.Flush()
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/experiment"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// ListExperiments returns every recommendation experiment by name.
+func ListExperiments(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		experiments, err := experiment.List(ctx, client)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		c.JSON(http.StatusOK, experiments)
+	}
+}
+
+// SaveExperiment creates or replaces the experiment named in the path. Only
+// one experiment can be active at a time.
+func SaveExperiment(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		var req models.ExperimentRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(apperrors.Binding(err))
+			return
+		}
+		if err := validate.Struct(req); err != nil {
+			c.Error(apperrors.Validation(err))
+			return
+		}
+
+		saved, created, err := experiment.Save(ctx, client, c.Param("name"), req)
+		if err != nil {
+			var validationErr *experiment.ValidationError
+			switch {
+			case errors.As(err, &validationErr):
+				appErr := apperrors.ErrValidation.WithDetail("The experiment is not valid.")
+				appErr.Fields = validationErr.Fields
+				c.Error(appErr)
+			case errors.Is(err, experiment.ErrAnotherActive):
+				c.Error(apperrors.ErrExperimentActive.WithDetail("Deactivate the active experiment before activating another one.").WithCause(err))
+			default:
+				c.Error(apperrors.Internal(err))
+			}
+			return
+		}
+
+		logging.FromContext(c).Info("experiment saved", "experiment", saved.Name, "active", saved.Active, "variants", len(saved.Variants), "created", created)
+
+		status := http.StatusOK
+		if created {
+			status = http.StatusCreated
+		}
+		c.JSON(status, saved)
+	}
+}
+
+// GetExperimentResults compares the variants of an experiment by exposures
+// and the saves and likes that followed them.
+func GetExperimentResults(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		found, err := experiment.Get(ctx, client, c.Param("name"))
+		if errors.Is(err, experiment.ErrNotFound) {
+			c.Error(apperrors.ErrExperimentNotFound)
+			return
+		}
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		results, err := experiment.Results(ctx, client, found)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		c.JSON(http.StatusOK, results)
+	}
+}
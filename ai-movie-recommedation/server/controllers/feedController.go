@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/recommend"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// GetFeed returns the home feed of the user or their selected profile: rows
+// of top picks, movies in their favourite genres, trending and newly added
+// movies, with no movie in more than one row. Row sizes come from
+// FEED_ROW_LIMITS.
+func GetFeed(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		viewer, appErr := currentViewer(ctx, c, client)
+		if appErr != nil {
+			c.Error(appErr)
+			return
+		}
+
+		rows, err := recommend.Feed(ctx, client, viewer, recommend.Limits())
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		shown := 0
+		for _, row := range rows {
+			shown += len(row.Movies)
+		}
+		logging.FromContext(c).Info("feed built", "rows", len(rows), "movies", shown)
+
+		c.JSON(http.StatusOK, models.Feed{Rows: rows})
+	}
+}
@@ -0,0 +1,158 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/jobs"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/taxonomy"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// genreError maps errors from the taxonomy package to API errors.
+func genreError(err error) *apperrors.Error {
+	var inUse *taxonomy.InUseError
+	switch {
+	case errors.Is(err, taxonomy.ErrNotFound):
+		return apperrors.ErrGenreNotFound.WithCause(err)
+	case errors.Is(err, taxonomy.ErrUnknownParent), errors.Is(err, taxonomy.ErrCycle):
+		return apperrors.ErrInvalidGenreParent.WithDetail(err.Error()).WithCause(err)
+	case errors.As(err, &inUse):
+		return apperrors.ErrGenreInUse.WithDetail(fmt.Sprintf(
+			"The genre is used by %d movies, %d users, %d profiles and %d sub-genres.", inUse.Movies, inUse.Users, inUse.Profiles, inUse.SubGenres)).WithCause(err)
+	default:
+		return apperrors.Duplicate(err, apperrors.ErrGenreExists)
+	}
+}
+
+// genreID reads the genre_id path parameter. A malformed id cannot name a
+// genre, so it is reported as not found.
+func genreID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("genre_id"))
+	if err != nil || id < 1 {
+		c.Error(apperrors.ErrGenreNotFound)
+		return 0, false
+	}
+	return id, true
+}
+
+// CreateGenre adds a genre, optionally as a sub-genre of an existing one.
+func CreateGenre(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		var req models.GenreRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(apperrors.Binding(err))
+			return
+		}
+		if err := validate.Struct(req); err != nil {
+			c.Error(apperrors.Validation(err))
+			return
+		}
+
+		genre, err := taxonomy.Create(ctx, client, models.Genre{GenreID: req.GenreID, GenreName: req.GenreName, ParentID: req.ParentID})
+		if err != nil {
+			c.Error(genreError(err))
+			return
+		}
+
+		logging.FromContext(c).Info("genre created", "genre_id", genre.GenreID)
+
+		// Genres are only read as a list, so that is where the new one is.
+		c.Header("Location", "/genres")
+		c.JSON(http.StatusCreated, genre)
+	}
+}
+
+// UpdateGenre renames a genre or moves it in the tree. Movies, users and
+// profiles keep their own copy of the name, so a rename queues a job to
+// update them.
+func UpdateGenre(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		id, ok := genreID(c)
+		if !ok {
+			return
+		}
+
+		var req models.GenreUpdateRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(apperrors.Binding(err))
+			return
+		}
+		if err := validate.Struct(req); err != nil {
+			c.Error(apperrors.Validation(err))
+			return
+		}
+
+		genre, renamed, err := taxonomy.Update(ctx, client, id, req.GenreName, req.ParentID)
+		if err != nil {
+			c.Error(genreError(err))
+			return
+		}
+
+		response := models.GenreUpdate{Genre: *genre}
+		if renamed {
+			job, err := jobs.EnqueueGenreCascade(ctx, client, id)
+			if err != nil {
+				c.Error(apperrors.Internal(err))
+				return
+			}
+			logging.FromContext(c).Info("genre renamed, cascade queued", "genre_id", id, "job_id", job.ID.Hex())
+			response.Job = job
+		}
+
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// DeleteGenre removes a genre that no movie, user or sub-genre refers to.
+func DeleteGenre(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		id, ok := genreID(c)
+		if !ok {
+			return
+		}
+
+		if err := taxonomy.Delete(ctx, client, id); err != nil {
+			c.Error(genreError(err))
+			return
+		}
+
+		logging.FromContext(c).Info("genre deleted", "genre_id", id)
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// resolveGenres checks that every genre a request refers to exists and
+// returns copies carrying the current names, so a client cannot embed a stale
+// or made-up name. field is where the genres sit in the request body.
+func resolveGenres(ctx context.Context, client *mongo.Client, refs []models.Genre, field string) ([]models.Genre, *apperrors.Error) {
+	index, err := taxonomy.Load(ctx, client)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+	genres, fieldErrs := index.Resolve(refs, field)
+	if len(fieldErrs) > 0 {
+		appErr := apperrors.ErrValidation.WithDetail("The request refers to genres that do not exist.")
+		appErr.Fields = fieldErrs
+		return nil, appErr
+	}
+	return genres, nil
+}
@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/jobs"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// GetReviewStatus returns the latest review ranking job for a movie.
+func GetReviewStatus(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		movieId := c.Param("imdb_id")
+
+		job, err := jobs.LatestForMovie(ctx, client, movieId)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				c.Error(apperrors.ErrJobNotFound.WithDetail("No review has been queued for this movie."))
+				return
+			}
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		c.JSON(http.StatusOK, job)
+	}
+}
+
+// RerankMovies queues a job that re-ranks every reviewed movie. The worker
+// also queues one by itself when the rankings collection changes.
+func RerankMovies(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		job, err := jobs.EnqueueRerankAll(ctx, client, "requested by admin")
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		logging.FromContext(c).Info("re-rank queued", "job_id", job.ID.Hex())
+
+		c.Header("Location", "/admin/jobs/"+job.ID.Hex())
+		c.JSON(http.StatusAccepted, job)
+	}
+}
+
+func GetJob(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		id, err := bson.ObjectIDFromHex(c.Param("job_id"))
+		if err != nil {
+			c.Error(apperrors.ErrJobNotFound)
+			return
+		}
+
+		job, err := jobs.Get(ctx, client, id)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				c.Error(apperrors.ErrJobNotFound)
+				return
+			}
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		c.JSON(http.StatusOK, job)
+	}
+}
@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/llm"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// GetLLMUsage reports today's LLM calls, cache hits, tokens and estimated
+// cost against the configured daily budget.
+func GetLLMUsage(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		budget, err := llm.BudgetFromEnv(database.OpenCollection("llm_usage", client))
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		usage, err := budget.Today(ctx)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		c.JSON(http.StatusOK, usage)
+	}
+}
@@ -3,26 +3,27 @@ package controllers
 import (
 	"context"
 	"errors"
-	"log"
 	"net/http"
-	"os"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
-	"github.com/joho/godotenv"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
 	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/events"
+	"github.com/princepal9120/ai-movie-recommedation/server/experiment"
+	"github.com/princepal9120/ai-movie-recommedation/server/jobs"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/metrics"
 	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/recommend"
+	"github.com/princepal9120/ai-movie-recommedation/server/respcache"
 	"github.com/princepal9120/ai-movie-recommedation/server/utils"
-	"github.com/tmc/langchaingo/llms/openai"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
-var validate = validator.New()
+var validate = models.Validate
 
 func GetMovies(client *mongo.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -34,7 +35,7 @@ func GetMovies(client *mongo.Client) gin.HandlerFunc {
 		cursor, err := movieCollection.Find(ctx, bson.D{})
 
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch movies."})
+			c.Error(apperrors.Internal(err))
 			return
 		}
 		defer cursor.Close(ctx)
@@ -42,7 +43,7 @@ func GetMovies(client *mongo.Client) gin.HandlerFunc {
 		var movies []models.Movie
 
 		if err = cursor.All(ctx, &movies); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to decode movies."})
+			c.Error(apperrors.Internal(err))
 			return
 		}
 
@@ -64,7 +65,7 @@ func GetMovie(client *mongo.Client) gin.HandlerFunc {
 		movieID := c.Param("imdb_id")
 
 		if movieID == "" {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Movie ID is required"})
+			c.Error(apperrors.ErrMissingParameter.WithDetail("Movie ID is required."))
 			return
 		}
 
@@ -75,7 +76,11 @@ func GetMovie(client *mongo.Client) gin.HandlerFunc {
 		err := movieCollection.FindOne(ctx, bson.D{{Key: "imdb_id", Value: movieID}}).Decode(&movie)
 
 		if err != nil {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Movie not found"})
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				c.Error(apperrors.ErrMovieNotFound)
+				return
+			}
+			c.Error(apperrors.Internal(err))
 			return
 		}
 
@@ -91,62 +96,72 @@ func AddMovie(client *mongo.Client) gin.HandlerFunc {
 
 		var movie models.Movie
 		if err := c.ShouldBindJSON(&movie); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+			c.Error(apperrors.Binding(err))
 			return
 		}
 
 		if err := validate.Struct(movie); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Validation failed", "details": err.Error()})
+			c.Error(apperrors.Validation(err))
 			return
 		}
+
+		genres, appErr := resolveGenres(ctx, client, movie.Genre, "genre")
+		if appErr != nil {
+			c.Error(appErr)
+			return
+		}
+		movie.Genre = genres
+
 		var movieCollection *mongo.Collection = database.OpenCollection("movies", client)
 
 		result, err := movieCollection.InsertOne(ctx, movie)
 
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add movie"})
+			c.Error(apperrors.Duplicate(err, apperrors.ErrMovieExists))
 			return
 		}
+		respcache.Invalidate(respcache.ScopeMovies)
+		movie.ID, _ = result.InsertedID.(bson.ObjectID)
+		events.Publish(events.Diff(nil, &movie)...)
+
+		enrichMovie(c, movieCollection, movie.ImdbID)
 
 		c.JSON(http.StatusCreated, result)
 
 	}
 }
 
+// AdminReviewUpdate stores the review and queues a job to rank the movie by
+// it. Progress is polled at /admin/movies/{imdb_id}/review-status.
 func AdminReviewUpdate(client *mongo.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 
 		role, err := utils.GetRoleFromContext(c)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Role not found in context"})
+			c.Error(apperrors.ErrUnauthenticated.WithCause(err))
 			return
 		}
 
 		if role != "ADMIN" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "User must be part of the ADMIN role"})
+			c.Error(apperrors.ErrAdminRequired)
 			return
 		}
 
 		movieId := c.Param("imdb_id")
 		if movieId == "" {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Movie Id required"})
+			c.Error(apperrors.ErrMissingParameter.WithDetail("Movie ID is required."))
 			return
 		}
 		var req struct {
-			AdminReview string `json:"admin_review"`
-		}
-		var resp struct {
-			RankingName string `json:"ranking_name"`
-			AdminReview string `json:"admin_review"`
+			AdminReview string `json:"admin_review" validate:"required"`
 		}
 
 		if err := c.ShouldBind(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+			c.Error(apperrors.Binding(err))
 			return
 		}
-		sentiment, rankVal, err := GetReviewRanking(req.AdminReview, client, c)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Error getting review ranking"})
+		if err := validate.Struct(req); err != nil {
+			c.Error(apperrors.Validation(err))
 			return
 		}
 
@@ -155,10 +170,6 @@ func AdminReviewUpdate(client *mongo.Client) gin.HandlerFunc {
 		update := bson.M{
 			"$set": bson.M{
 				"admin_review": req.AdminReview,
-				"ranking": bson.M{
-					"ranking_value": rankVal,
-					"ranking_name":  sentiment,
-				},
 			},
 		}
 		var ctx, cancel = context.WithTimeout(c, 100*time.Second)
@@ -169,168 +180,87 @@ func AdminReviewUpdate(client *mongo.Client) gin.HandlerFunc {
 		result, err := movieCollection.UpdateOne(ctx, filter, update)
 
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Error updating movie"})
+			c.Error(apperrors.Internal(err))
 			return
 		}
 
 		if result.MatchedCount == 0 {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Movie not found"})
+			c.Error(apperrors.ErrMovieNotFound)
 			return
 		}
-		resp.RankingName = sentiment
-		resp.AdminReview = req.AdminReview
-
-		c.JSON(http.StatusOK, resp)
-
-	}
-}
-
-func GetReviewRanking(admin_review string, client *mongo.Client, c *gin.Context) (string, int, error) {
-	rankings, err := GetRankings(client, c)
-
-	if err != nil {
-		return "", 0, err
-	}
-
-	sentimentDelimited := ""
-
-	for _, ranking := range rankings {
-		if ranking.RankingValue != 999 {
-			sentimentDelimited = sentimentDelimited + ranking.RankingName + ","
+		respcache.Invalidate(respcache.ScopeMovies)
+		if result.ModifiedCount > 0 {
+			events.Publish(events.ReviewEvent(movieId, req.AdminReview))
 		}
-	}
-
-	sentimentDelimited = strings.Trim(sentimentDelimited, ",")
 
-	err = godotenv.Load(".env")
-
-	if err != nil {
-		log.Println("Warning: .env file not found")
-	}
-
-	OpenAiApiKey := os.Getenv("OPENAI_API_KEY")
-
-	if OpenAiApiKey == "" {
-		return "", 0, errors.New("could not read OPENAI_API_KEY")
-	}
-
-	llm, err := openai.New(openai.WithToken(OpenAiApiKey))
-
-	if err != nil {
-		return "", 0, err
-	}
-
-	base_prompt_template := os.Getenv("BASE_PROMPT_TEMPLATE")
-
-	base_prompt := strings.Replace(base_prompt_template, "{rankings}", sentimentDelimited, 1)
-
-	response, err := llm.Call(c, base_prompt+admin_review)
-
-	if err != nil {
-		return "", 0, err
-	}
-	rankVal := 0
-
-	for _, ranking := range rankings {
-		if ranking.RankingName == response {
-			rankVal = ranking.RankingValue
-			break
+		job, err := jobs.EnqueueReviewRanking(ctx, client, movieId, req.AdminReview)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
 		}
-	}
-	return response, rankVal, nil
-
-}
-
-func GetRankings(client *mongo.Client, c *gin.Context) ([]models.Ranking, error) {
-	var rankings []models.Ranking
-
-	var ctx, cancel = context.WithTimeout(c, 100*time.Second)
-	defer cancel()
 
-	var rankingCollection *mongo.Collection = database.OpenCollection("rankings", client)
+		logging.FromContext(c).Info("review ranking queued", "imdb_id", movieId, "job_id", job.ID.Hex())
 
-	cursor, err := rankingCollection.Find(ctx, bson.D{})
+		queueSummary(ctx, c, client, movieId)
 
-	if err != nil {
-		return nil, err
-	}
-	defer cursor.Close(ctx)
+		c.Header("Location", "/admin/movies/"+movieId+"/review-status")
+		c.JSON(http.StatusAccepted, job)
 
-	if err := cursor.All(ctx, &rankings); err != nil {
-		return nil, err
 	}
-
-	return rankings, nil
-
 }
 
 func GetRecommendedMovies(client *mongo.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		userId, err := utils.GetUserIdFromContext(c)
+		var ctx, cancel = context.WithTimeout(c, 100*time.Second)
+		defer cancel()
 
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "User Id not found in context"})
+		viewer, appErr := currentViewer(ctx, c, client)
+		if appErr != nil {
+			c.Error(appErr)
 			return
 		}
 
-		favourite_genres, err := GetUsersFavouriteGenres(userId, client, c)
-
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
+		logger := logging.FromContext(c)
+		logger.Debug("loaded favourite genres", "favourite_genres", viewer.Genres)
 
-		log.Printf("User ID: %s, Favourite Genres: %v", userId, favourite_genres)
+		// Users without favourite genres get popular movies until they have
+		// some, through onboarding or otherwise.
+		strategy := recommend.TopPicks(client, nil, viewer)
 
-		err = godotenv.Load(".env")
+		// The active experiment, if any, picks the strategy instead.
+		running, err := experiment.Active(ctx, client)
 		if err != nil {
-			log.Println("Warning: .env file not found")
-		}
-		var recommendedMovieLimitVal int64 = 5
-
-		recommendedMovieLimitStr := os.Getenv("RECOMMENDED_MOVIE_LIMIT")
-
-		if recommendedMovieLimitStr != "" {
-			recommendedMovieLimitVal, _ = strconv.ParseInt(recommendedMovieLimitStr, 10, 64)
+			c.Error(apperrors.Internal(err))
+			return
 		}
-
-		findOptions := options.Find()
-
-		findOptions.SetSort(bson.D{{Key: "ranking.ranking_value", Value: 1}})
-
-		findOptions.SetLimit(recommendedMovieLimitVal)
-
-		filter := bson.D{
-			{Key: "genre.genre_name", Value: bson.D{
-				{Key: "$in", Value: favourite_genres},
-			}},
+		var variant models.ExperimentVariant
+		if running != nil {
+			variant = experiment.Assign(running, viewer)
+			if named, ok := recommend.Named(variant.Strategy, client, viewer); ok {
+				strategy = named
+			} else {
+				logger.Warn("experiment variant has an unknown strategy", "experiment", running.Name, "variant", variant.Name, "strategy", variant.Strategy)
+				running = nil
+			}
 		}
 
-		var ctx, cancel = context.WithTimeout(c, 100*time.Second)
-		defer cancel()
-
-		var movieCollection *mongo.Collection = database.OpenCollection("movies", client)
-
-		cursor, err := movieCollection.Find(ctx, filter, findOptions)
+		recommendedMovies, err := strategy.Recommend(ctx, viewer, recommend.Limits().TopPicks, nil)
 
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Error fetching recommended movies"})
+			c.Error(apperrors.Internal(err))
 			return
 		}
-		defer cursor.Close(ctx)
 
-		var recommendedMovies []models.Movie
+		logger.Info("recommended movies found", "count", len(recommendedMovies), "strategy", strategy.Name())
+		metrics.ObserveRecommendations(len(recommendedMovies))
 
-		if err := cursor.All(ctx, &recommendedMovies); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-
-		log.Printf("Found %d recommended movies for user %s", len(recommendedMovies), userId)
-
-		// Ensure we return an empty array instead of null
-		if recommendedMovies == nil {
-			recommendedMovies = []models.Movie{}
+		if running != nil {
+			c.Header("X-Experiment", running.Name+"/"+variant.Name)
+			// A lost exposure skews the results slightly; failing the
+			// request would be worse.
+			if err := experiment.LogExposure(ctx, client, running, variant, viewer, recommendedMovies); err != nil {
+				logger.Warn("failed to log experiment exposure", "experiment", running.Name, "variant", variant.Name, "error", err)
+			}
 		}
 
 		c.JSON(http.StatusOK, recommendedMovies)
@@ -395,14 +325,14 @@ func GetGenres(client *mongo.Client) gin.HandlerFunc {
 
 		cursor, err := genreCollection.Find(ctx, bson.D{})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Error fetching movie genres"})
+			c.Error(apperrors.Internal(err))
 			return
 		}
 		defer cursor.Close(ctx)
 
 		var genres []models.Genre
 		if err := cursor.All(ctx, &genres); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(apperrors.Internal(err))
 			return
 		}
 
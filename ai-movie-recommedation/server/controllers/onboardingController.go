@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/profile"
+	"github.com/princepal9120/ai-movie-recommedation/server/recommend"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const defaultOnboardingLimit = 12
+
+// GetOnboardingMovies offers a new user, or a new profile, movies from across
+// the catalog to rate, leaving out those they already rated. Ratings are
+// given through PUT /movie/{imdb_id}/rating.
+func GetOnboardingMovies(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		viewer, appErr := currentViewer(ctx, c, client)
+		if appErr != nil {
+			c.Error(appErr)
+			return
+		}
+
+		var query models.OnboardingQuery
+		if err := c.ShouldBindQuery(&query); err != nil {
+			c.Error(apperrors.Binding(err))
+			return
+		}
+		if err := validate.Struct(query); err != nil {
+			c.Error(apperrors.Validation(err))
+			return
+		}
+		if query.Limit == 0 {
+			query.Limit = defaultOnboardingLimit
+		}
+
+		rated, err := recommend.RatingCount(ctx, client, viewer)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		movies, err := recommend.Sample(ctx, client, viewer, query.Limit)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		c.JSON(http.StatusOK, models.OnboardingSample{Rated: rated, Required: recommend.MinOnboardingRatings, Movies: movies})
+	}
+}
+
+// CompleteOnboarding derives the favourite genres of the user or their
+// selected profile from its ratings and saves them in place of any they had,
+// so that recommendations follow the movies they liked.
+func CompleteOnboarding(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		viewer, appErr := currentViewer(ctx, c, client)
+		if appErr != nil {
+			c.Error(appErr)
+			return
+		}
+
+		rated, err := recommend.RatingCount(ctx, client, viewer)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		if rated < recommend.MinOnboardingRatings {
+			c.Error(apperrors.ErrNotEnoughRatings.WithDetail(fmt.Sprintf("Rate at least %d movies before finishing onboarding; %d rated so far.", recommend.MinOnboardingRatings, rated)))
+			return
+		}
+
+		genres, err := recommend.PreferredGenres(ctx, client, viewer)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		if len(genres) == 0 {
+			c.Error(apperrors.ErrNotEnoughRatings.WithDetail("None of the rated movies scored above 3; rate some movies you liked."))
+			return
+		}
+
+		if appErr := saveFavouriteGenres(ctx, client, viewer, genres); appErr != nil {
+			c.Error(appErr)
+			return
+		}
+
+		logging.FromContext(c).Info("onboarding completed", "ratings", rated, "favourite_genres", len(genres))
+		c.JSON(http.StatusOK, models.OnboardingResult{FavouriteGenres: genres})
+	}
+}
+
+// saveFavouriteGenres stores genres as the favourites of the viewer's profile,
+// or of the account when no profile is selected.
+func saveFavouriteGenres(ctx context.Context, client *mongo.Client, viewer recommend.Viewer, genres []models.Genre) *apperrors.Error {
+	if viewer.ProfileID != "" {
+		err := profile.SetGenres(ctx, client, viewer.UserID, viewer.ProfileID, genres)
+		if errors.Is(err, profile.ErrNotFound) {
+			return apperrors.ErrProfileNotFound
+		}
+		if err != nil {
+			return apperrors.Internal(err)
+		}
+		return nil
+	}
+
+	result, err := database.OpenCollection("users", client).UpdateOne(ctx,
+		bson.D{{Key: "user_id", Value: viewer.UserID}},
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "favourite_genres", Value: genres},
+			{Key: "update_at", Value: time.Now().UTC()},
+		}}})
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+	if result.MatchedCount == 0 {
+		return apperrors.ErrUnauthenticated
+	}
+	return nil
+}
@@ -0,0 +1,258 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/profile"
+	"github.com/princepal9120/ai-movie-recommedation/server/recommend"
+	"github.com/princepal9120/ai-movie-recommedation/server/utils"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// ListProfiles returns the profiles of the user's household.
+func ListProfiles(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		userId, err := utils.GetUserIdFromContext(c)
+		if err != nil {
+			c.Error(apperrors.ErrUnauthenticated.WithCause(err))
+			return
+		}
+
+		profiles, err := profile.List(ctx, client, userId)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		c.JSON(http.StatusOK, profiles)
+	}
+}
+
+// CreateProfile adds a profile to the user's household.
+func CreateProfile(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		userId, req, appErr := bindProfileRequest(ctx, c, client)
+		if appErr != nil {
+			c.Error(appErr)
+			return
+		}
+
+		created, err := profile.Create(ctx, client, userId, req)
+		if errors.Is(err, profile.ErrLimit) {
+			c.Error(apperrors.ErrProfileLimit.WithDetail(fmt.Sprintf("An account can have at most %d profiles.", profile.MaxPerAccount)))
+			return
+		}
+		if err != nil {
+			c.Error(apperrors.Duplicate(err, apperrors.ErrProfileExists))
+			return
+		}
+
+		logging.FromContext(c).Info("profile created", "profile_id", created.ProfileID, "maturity", created.Maturity)
+		c.JSON(http.StatusCreated, created)
+	}
+}
+
+// UpdateProfile replaces a profile's name, favourite genres and maturity
+// setting.
+func UpdateProfile(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		userId, req, appErr := bindProfileRequest(ctx, c, client)
+		if appErr != nil {
+			c.Error(appErr)
+			return
+		}
+
+		updated, err := profile.Update(ctx, client, userId, c.Param("profile_id"), req)
+		if errors.Is(err, profile.ErrNotFound) {
+			c.Error(apperrors.ErrProfileNotFound)
+			return
+		}
+		if err != nil {
+			c.Error(apperrors.Duplicate(err, apperrors.ErrProfileExists))
+			return
+		}
+		c.JSON(http.StatusOK, updated)
+	}
+}
+
+// DeleteProfile removes a profile with its ratings and watchlist.
+func DeleteProfile(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		userId, err := utils.GetUserIdFromContext(c)
+		if err != nil {
+			c.Error(apperrors.ErrUnauthenticated.WithCause(err))
+			return
+		}
+		if appErr := forbidKidsProfile(ctx, c, client, userId); appErr != nil {
+			c.Error(appErr)
+			return
+		}
+
+		err = profile.Delete(ctx, client, userId, c.Param("profile_id"))
+		if errors.Is(err, profile.ErrNotFound) {
+			c.Error(apperrors.ErrProfileNotFound)
+			return
+		}
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		logging.FromContext(c).Info("profile deleted", "profile_id", c.Param("profile_id"))
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// SelectProfile issues tokens that act for one of the household's profiles,
+// so that ratings, the watchlist and recommendations are that profile's.
+// Logging in again goes back to the account itself.
+func SelectProfile(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		userId, err := utils.GetUserIdFromContext(c)
+		if err != nil {
+			c.Error(apperrors.ErrUnauthenticated.WithCause(err))
+			return
+		}
+		if appErr := forbidKidsProfile(ctx, c, client, userId); appErr != nil {
+			c.Error(appErr)
+			return
+		}
+
+		selected, err := profile.Get(ctx, client, userId, c.Param("profile_id"))
+		if errors.Is(err, profile.ErrNotFound) {
+			c.Error(apperrors.ErrProfileNotFound)
+			return
+		}
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		var user models.User
+		err = database.OpenCollection("users", client).FindOne(ctx, bson.D{{Key: "user_id", Value: userId}}).Decode(&user)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			c.Error(apperrors.ErrUnauthenticated.WithCause(err))
+			return
+		}
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		token, refreshToken, err := utils.GenerateAllTokens(user.Email, user.FirstName, user.LastName, user.Role, user.UserID, selected.ProfileID)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		if err := utils.UpdateAllTokens(user.UserID, token, refreshToken, client); err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		setAuthCookies(c, token, refreshToken)
+
+		logging.FromContext(c).Info("profile selected", "profile_id", selected.ProfileID)
+		c.JSON(http.StatusOK, selected)
+	}
+}
+
+// bindProfileRequest reads and validates a profile request, resolving its
+// genres. Kids profiles may not change profiles.
+func bindProfileRequest(ctx context.Context, c *gin.Context, client *mongo.Client) (string, models.ProfileRequest, *apperrors.Error) {
+	var req models.ProfileRequest
+	userId, err := utils.GetUserIdFromContext(c)
+	if err != nil {
+		return "", req, apperrors.ErrUnauthenticated.WithCause(err)
+	}
+	if appErr := forbidKidsProfile(ctx, c, client, userId); appErr != nil {
+		return "", req, appErr
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return "", req, apperrors.Binding(err)
+	}
+	if err := validate.Struct(req); err != nil {
+		return "", req, apperrors.Validation(err)
+	}
+	genres, appErr := resolveGenres(ctx, client, req.FavouriteGenres, "favourite_genres")
+	if appErr != nil {
+		return "", req, appErr
+	}
+	req.FavouriteGenres = genres
+	return userId, req, nil
+}
+
+// forbidKidsProfile keeps a kids profile from managing profiles or switching
+// to another one; only logging in again leaves it.
+func forbidKidsProfile(ctx context.Context, c *gin.Context, client *mongo.Client, userId string) *apperrors.Error {
+	profileId := utils.GetProfileIdFromContext(c)
+	if profileId == "" {
+		return nil
+	}
+	current, err := profile.Get(ctx, client, userId, profileId)
+	if errors.Is(err, profile.ErrNotFound) {
+		// The profile was deleted after its tokens were issued.
+		return nil
+	}
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+	if current.Maturity == models.MaturityKids {
+		return apperrors.ErrKidsProfile
+	}
+	return nil
+}
+
+// currentViewer returns who the request's recommendations are for: the
+// selected profile, or the account itself.
+func currentViewer(ctx context.Context, c *gin.Context, client *mongo.Client) (recommend.Viewer, *apperrors.Error) {
+	userId, err := utils.GetUserIdFromContext(c)
+	if err != nil {
+		return recommend.Viewer{}, apperrors.ErrUnauthenticated.WithCause(err)
+	}
+
+	profileId := utils.GetProfileIdFromContext(c)
+	if profileId == "" {
+		genres, err := GetUsersFavouriteGenres(userId, client, c)
+		if err != nil {
+			return recommend.Viewer{}, apperrors.Internal(err)
+		}
+		return recommend.Viewer{UserID: userId, Genres: genres}, nil
+	}
+
+	selected, err := profile.Get(ctx, client, userId, profileId)
+	if errors.Is(err, profile.ErrNotFound) {
+		return recommend.Viewer{}, apperrors.ErrProfileNotFound.WithDetail("The selected profile no longer exists; select another one or log in again.")
+	}
+	if err != nil {
+		return recommend.Viewer{}, apperrors.Internal(err)
+	}
+	genres := make([]string, len(selected.FavouriteGenres))
+	for i, genre := range selected.FavouriteGenres {
+		genres[i] = genre.GenreName
+	}
+	return recommend.Viewer{UserID: userId, ProfileID: profileId, Genres: genres, Maturity: selected.Maturity}, nil
+}
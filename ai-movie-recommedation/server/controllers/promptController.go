@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/llm"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/prompts"
+	"github.com/princepal9120/ai-movie-recommedation/server/review"
+	"github.com/princepal9120/ai-movie-recommedation/server/utils"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// promptError maps errors from the prompts package to API errors.
+func promptError(err error) *apperrors.Error {
+	switch {
+	case errors.Is(err, prompts.ErrNotFound):
+		return apperrors.ErrPromptNotFound.WithCause(err)
+	case errors.Is(err, prompts.ErrInvalid):
+		return apperrors.ErrInvalidTemplate.WithDetail(err.Error()).WithCause(err)
+	default:
+		return apperrors.Internal(err)
+	}
+}
+
+// ListPromptTemplates returns every version of a prompt, newest first.
+func ListPromptTemplates(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		versions, err := prompts.List(ctx, client, c.Param("name"))
+		if err != nil {
+			c.Error(promptError(err))
+			return
+		}
+
+		c.JSON(http.StatusOK, versions)
+	}
+}
+
+// CreatePromptTemplate saves a new version of a prompt. It is used for every
+// LLM call from then on; re-ranking existing reviews is a separate request.
+func CreatePromptTemplate(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		var req models.PromptTemplateRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(apperrors.Binding(err))
+			return
+		}
+		if err := validate.Struct(req); err != nil {
+			c.Error(apperrors.Validation(err))
+			return
+		}
+
+		userId, err := utils.GetUserIdFromContext(c)
+		if err != nil {
+			c.Error(apperrors.ErrUnauthenticated.WithCause(err))
+			return
+		}
+
+		prompt, err := prompts.Create(ctx, client, c.Param("name"), req.Body, req.Description, userId)
+		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				c.Error(apperrors.ErrConflict.WithDetail("Another version of this prompt was saved at the same time.").WithCause(err))
+				return
+			}
+			c.Error(promptError(err))
+			return
+		}
+
+		logging.FromContext(c).Info("prompt template saved", "name", prompt.Name, "version", prompt.Version)
+
+		c.JSON(http.StatusCreated, prompt)
+	}
+}
+
+// PreviewPromptTemplate classifies sample reviews with a stored version of the
+// review ranking prompt, or with an unsaved draft, without touching any movie.
+func PreviewPromptTemplate(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		name := c.Param("name")
+		if name != prompts.ReviewRanking {
+			if prompts.Known(name) {
+				c.Error(apperrors.ErrPromptNotFound.WithDetail("Only the " + prompts.ReviewRanking + " prompt can be previewed."))
+			} else {
+				c.Error(apperrors.ErrPromptNotFound)
+			}
+			return
+		}
+
+		var req models.PromptPreviewRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(apperrors.Binding(err))
+			return
+		}
+		if err := validate.Struct(req); err != nil {
+			c.Error(apperrors.Validation(err))
+			return
+		}
+
+		var prompt *models.PromptTemplate
+		var err error
+		switch {
+		case req.Body != "":
+			if err := prompts.Validate(name, req.Body); err != nil {
+				c.Error(promptError(err))
+				return
+			}
+			prompt = &models.PromptTemplate{Name: name, Body: req.Body}
+		case req.Version > 0:
+			prompt, err = prompts.Get(ctx, client, name, req.Version)
+		default:
+			prompt, err = prompts.Latest(ctx, client, name)
+		}
+		if err != nil {
+			c.Error(promptError(err))
+			return
+		}
+
+		rankings, err := review.Rankings(ctx, client)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		gateway, err := llm.NewGateway(client)
+		if err != nil {
+			c.Error(apperrors.ErrLLMUnavailable.WithCause(err))
+			return
+		}
+
+		preview := models.PromptPreview{Prompt: prompts.Ref(prompt), Results: []models.PromptPreviewResult{}}
+		for _, sample := range req.Reviews {
+			result, err := review.Rank(ctx, gateway, prompt, rankings, sample)
+			switch {
+			case errors.Is(err, review.ErrUnknownRanking):
+				preview.Results = append(preview.Results, models.PromptPreviewResult{
+					Review: sample,
+					Prompt: result.RenderedPrompt,
+					Answer: result.Answer,
+					Cached: result.Cached,
+					Error:  err.Error(),
+				})
+				continue
+			case errors.Is(err, llm.ErrBudgetExceeded):
+				c.Error(apperrors.ErrLLMBudgetExceeded.WithCause(err))
+				return
+			case errors.Is(err, prompts.ErrInvalid):
+				c.Error(promptError(err))
+				return
+			case err != nil:
+				c.Error(apperrors.ErrLLMUnavailable.WithCause(err))
+				return
+			}
+			ranking := result.Ranking
+			preview.Results = append(preview.Results, models.PromptPreviewResult{
+				Review:  sample,
+				Prompt:  result.RenderedPrompt,
+				Answer:  result.Answer,
+				Ranking: &ranking,
+				Cached:  result.Cached,
+			})
+		}
+
+		c.JSON(http.StatusOK, preview)
+	}
+}
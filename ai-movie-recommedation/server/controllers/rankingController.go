@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/jobs"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/review"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// GetRankings returns the ranking scale, best tier first.
+func GetRankings(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		rankings, err := review.Rankings(ctx, client)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		// Ensure we return an empty array instead of null
+		if rankings == nil {
+			rankings = []models.Ranking{}
+		}
+
+		c.JSON(http.StatusOK, rankings)
+	}
+}
+
+// ReplaceRankings saves a new ranking scale and moves every movie to its tier
+// in it. When the tiers offered to the LLM changed, reviewed movies are
+// re-ranked by a rerank_all job.
+func ReplaceRankings(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		var req models.RankingScaleRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(apperrors.Binding(err))
+			return
+		}
+		if err := validate.Struct(req); err != nil {
+			c.Error(apperrors.Validation(err))
+			return
+		}
+
+		change, err := review.ReplaceScale(ctx, client, req.Rankings)
+		if err != nil {
+			var scaleErr *review.ScaleError
+			if errors.As(err, &scaleErr) {
+				appErr := apperrors.ErrValidation.WithDetail("The ranking scale is not valid.")
+				appErr.Fields = scaleErr.Fields
+				c.Error(appErr)
+				return
+			}
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		// This change is handled here; the worker's periodic check must not
+		// queue the same re-rank again.
+		if err := jobs.RecordRankings(ctx, client); err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		logger := logging.FromContext(c)
+		logger.Info("ranking scale replaced", "tiers", len(change.Rankings), "remapped", change.Remapped)
+
+		response := models.RankingScaleUpdate{Rankings: change.Rankings, Remapped: change.Remapped}
+		if change.RankedChanged {
+			job, err := jobs.EnqueueRerankAll(ctx, client, "ranking scale changed")
+			if err != nil {
+				c.Error(apperrors.Internal(err))
+				return
+			}
+			logger.Info("re-rank queued", "job_id", job.ID.Hex())
+			response.Job = job
+		}
+
+		c.JSON(http.StatusOK, response)
+	}
+}
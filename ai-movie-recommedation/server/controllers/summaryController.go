@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/jobs"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// queueSummary queues a new summary after a movie's review changed, unless
+// AUTO_SUMMARIZE is "off". It never fails the request: an admin can queue the
+// summary by hand.
+func queueSummary(ctx context.Context, c *gin.Context, client *mongo.Client, imdbID string) {
+	if strings.EqualFold(os.Getenv("AUTO_SUMMARIZE"), "off") {
+		return
+	}
+
+	logger := logging.FromContext(c)
+	job, err := jobs.EnqueueMovieSummary(ctx, client, imdbID)
+	if err != nil {
+		logger.Warn("failed to queue movie summary", "imdb_id", imdbID, "error", err)
+		return
+	}
+	logger.Info("movie summary queued", "imdb_id", imdbID, "job_id", job.ID.Hex())
+}
+
+// SummarizeMovie queues a new synopsis, mood tags and content warnings for a
+// movie, for example after its metadata was refreshed.
+func SummarizeMovie(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		movieId := c.Param("imdb_id")
+
+		var movieCollection *mongo.Collection = database.OpenCollection("movies", client)
+
+		opts := options.FindOne().SetProjection(bson.D{{Key: "imdb_id", Value: 1}})
+		err := movieCollection.FindOne(ctx, bson.D{{Key: "imdb_id", Value: movieId}}, opts).Err()
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				c.Error(apperrors.ErrMovieNotFound)
+				return
+			}
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		job, err := jobs.EnqueueMovieSummary(ctx, client, movieId)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		logging.FromContext(c).Info("movie summary queued", "imdb_id", movieId, "job_id", job.ID.Hex())
+
+		c.Header("Location", "/admin/jobs/"+job.ID.Hex())
+		c.JSON(http.StatusAccepted, job)
+	}
+}
@@ -0,0 +1,95 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/popularity"
+	"github.com/princepal9120/ai-movie-recommedation/server/recommend"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const defaultTrendingLimit = 10
+
+// GetTrendingMovies returns the most popular movies of the day, week (the
+// default) or month, by views, ratings and watchlist adds with recent
+// activity weighted up.
+func GetTrendingMovies(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		var query models.TrendingQuery
+		if err := c.ShouldBindQuery(&query); err != nil {
+			c.Error(apperrors.Binding(err))
+			return
+		}
+		if err := validate.Struct(query); err != nil {
+			c.Error(apperrors.Validation(err))
+			return
+		}
+		if query.Window == "" {
+			query.Window = popularity.Week.Name
+		}
+		if query.Limit == 0 {
+			query.Limit = defaultTrendingLimit
+		}
+
+		window, err := popularity.WindowNamed(query.Window)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		scores, err := popularity.Trending(ctx, client, window, query.Limit)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		ids := make([]string, len(scores))
+		byID := make(map[string]float64, len(scores))
+		for i, score := range scores {
+			ids[i] = score.ImdbID
+			byID[score.ImdbID] = score.Score
+		}
+		movies, err := recommend.ByID(ctx, client, ids)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		trending := make([]models.TrendingMovie, len(movies))
+		for i, movie := range movies {
+			trending[i] = models.TrendingMovie{Movie: movie, Score: byID[movie.ImdbID]}
+		}
+
+		c.JSON(http.StatusOK, trending)
+	}
+}
+
+// GetMovieStats returns a movie's activity in each trending window along with
+// its ratings and watchlist entries.
+func GetMovieStats(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c, 100*time.Second)
+		defer cancel()
+
+		movieId := c.Param("imdb_id")
+		if appErr := movieExists(ctx, client, movieId); appErr != nil {
+			c.Error(appErr)
+			return
+		}
+
+		stats, err := popularity.Stats(ctx, client, movieId)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
+
+		c.JSON(http.StatusOK, stats)
+	}
+}
@@ -2,14 +2,16 @@ package controllers
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/go-playground/validator/v10"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
 	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
 	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/profile"
 	"github.com/princepal9120/ai-movie-recommedation/server/utils"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
@@ -26,41 +28,69 @@ func HashPassword(password string) (string, error) {
 
 }
 
+func setAuthCookies(c *gin.Context, token, refreshToken string) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:  "access_token",
+		Value: token,
+		Path:  "/",
+		// Domain:   "localhost",
+		MaxAge:   86400,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteNoneMode,
+	})
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:  "refresh_token",
+		Value: refreshToken,
+		Path:  "/",
+		// Domain:   "localhost",
+		MaxAge:   604800,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteNoneMode,
+	})
+}
+
 func RegisterUser(client *mongo.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var user models.User
 
 		if err := c.ShouldBindJSON(&user); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input data"})
+			c.Error(apperrors.Binding(err))
 			return
 		}
-		validate := validator.New()
-
 		if err := validate.Struct(user); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Validation failed", "details": err.Error()})
+			c.Error(apperrors.Validation(err))
 			return
 		}
 
 		hashedPassword, err := HashPassword(user.Password)
 
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to hash password"})
+			c.Error(apperrors.Internal(err))
 			return
 		}
 
 		var ctx, cancel = context.WithTimeout(c, 100*time.Second)
 		defer cancel()
 
+		genres, appErr := resolveGenres(ctx, client, user.FavouriteGenres, "favourite_genres")
+		if appErr != nil {
+			c.Error(appErr)
+			return
+		}
+		user.FavouriteGenres = genres
+
 		var userCollection *mongo.Collection = database.OpenCollection("users", client)
 
 		count, err := userCollection.CountDocuments(ctx, bson.D{{Key: "email", Value: user.Email}})
 
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check existing user"})
+			c.Error(apperrors.Internal(err))
 			return
 		}
 		if count > 0 {
-			c.JSON(http.StatusConflict, gin.H{"error": "User already exists"})
+			c.Error(apperrors.ErrUserExists)
 			return
 		}
 		user.UserID = bson.NewObjectID().Hex()
@@ -71,10 +101,13 @@ func RegisterUser(client *mongo.Client) gin.HandlerFunc {
 		result, err := userCollection.InsertOne(ctx, user)
 
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create user"})
+			logging.FromContext(c).Error("failed to insert user", "error", err)
+			c.Error(apperrors.Duplicate(err, apperrors.ErrUserExists))
 			return
 		}
 
+		logging.FromContext(c).Info("user registered", "user_id", user.UserID)
+
 		c.JSON(http.StatusCreated, result)
 
 	}
@@ -86,7 +119,7 @@ func LoginUser(client *mongo.Client) gin.HandlerFunc {
 		var userLogin models.UserLogin
 
 		if err := c.ShouldBindJSON(&userLogin); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalide input data"})
+			c.Error(apperrors.Binding(err))
 			return
 		}
 
@@ -98,49 +131,39 @@ func LoginUser(client *mongo.Client) gin.HandlerFunc {
 		var foundUser models.User
 		err := userCollection.FindOne(ctx, bson.D{{Key: "email", Value: userLogin.Email}}).Decode(&foundUser)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
+			logging.FromContext(c).Warn("login failed: unknown email", "email", userLogin.Email)
+			c.Error(apperrors.ErrInvalidCredentials)
 			return
 		}
 
 		err = bcrypt.CompareHashAndPassword([]byte(foundUser.Password), []byte(userLogin.Password))
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
+			logging.FromContext(c).Warn("login failed: wrong password", "user_id", foundUser.UserID)
+			c.Error(apperrors.ErrInvalidCredentials)
 			return
 		}
 
-		token, refreshToken, err := utils.GenerateAllTokens(foundUser.Email, foundUser.FirstName, foundUser.LastName, foundUser.Role, foundUser.UserID)
+		// Logging in acts for the account; a profile is selected afterwards.
+		token, refreshToken, err := utils.GenerateAllTokens(foundUser.Email, foundUser.FirstName, foundUser.LastName, foundUser.Role, foundUser.UserID, "")
 
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate tokens"})
+			c.Error(apperrors.Internal(err))
 			return
 		}
 
 		err = utils.UpdateAllTokens(foundUser.UserID, token, refreshToken, client)
 
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update tokens"})
+			c.Error(apperrors.Internal(err))
+			return
+		}
+		setAuthCookies(c, token, refreshToken)
+
+		profiles, err := profile.List(ctx, client, foundUser.UserID)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
 			return
 		}
-		http.SetCookie(c.Writer, &http.Cookie{
-			Name:  "access_token",
-			Value: token,
-			Path:  "/",
-			// Domain:   "localhost",
-			MaxAge:   86400,
-			Secure:   true,
-			HttpOnly: true,
-			SameSite: http.SameSiteNoneMode,
-		})
-		http.SetCookie(c.Writer, &http.Cookie{
-			Name:  "refresh_token",
-			Value: refreshToken,
-			Path:  "/",
-			// Domain:   "localhost",
-			MaxAge:   604800,
-			Secure:   true,
-			HttpOnly: true,
-			SameSite: http.SameSiteNoneMode,
-		})
 
 		c.JSON(http.StatusOK, models.UserResponse{
 			UserId:    foundUser.UserID,
@@ -151,6 +174,7 @@ func LoginUser(client *mongo.Client) gin.HandlerFunc {
 			//Token:           token,
 			//RefreshToken:    refreshToken,
 			FavouriteGenres: foundUser.FavouriteGenres,
+			Profiles:        profiles,
 		})
 
 	}
@@ -166,17 +190,17 @@ func LogoutHandler(client *mongo.Client) gin.HandlerFunc {
 
 		err := c.ShouldBindJSON(&UserLogout)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request payload"})
+			c.Error(apperrors.Binding(err))
 			return
 		}
 
-		fmt.Println("User ID from Logout request:", UserLogout.UserId)
+		logging.FromContext(c).Info("logout requested", "user_id", UserLogout.UserId)
 
 		err = utils.UpdateAllTokens(UserLogout.UserId, "", "", client) // Clear tokens in the database
 		// Optionally, you can also remove the user session from the database if needed
 
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Error logging out"})
+			c.Error(apperrors.Internal(err))
 			return
 		}
 		// c.SetCookie(
@@ -231,15 +255,15 @@ func RefreshTokenHandler(client *mongo.Client) gin.HandlerFunc {
 		refreshToken, err := c.Cookie("refresh_token")
 
 		if err != nil {
-			fmt.Println("error", err.Error())
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Unable to retrieve refresh token from cookie"})
+			logging.FromContext(c).Warn("refresh token cookie missing", "error", err)
+			c.Error(apperrors.ErrInvalidRefresh.WithCause(err))
 			return
 		}
 
 		claim, err := utils.ValidateRefreshToken(refreshToken)
 		if err != nil || claim == nil {
-			fmt.Println("error", err.Error())
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+			logging.FromContext(c).Warn("refresh token validation failed", "error", err)
+			c.Error(apperrors.ErrInvalidRefresh.WithCause(err))
 			return
 		}
 
@@ -249,14 +273,30 @@ func RefreshTokenHandler(client *mongo.Client) gin.HandlerFunc {
 		err = userCollection.FindOne(ctx, bson.D{{Key: "user_id", Value: claim.UserId}}).Decode(&user)
 
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
+			c.Error(apperrors.ErrInvalidRefresh.WithCause(err))
 			return
 		}
 
-		newToken, newRefreshToken, _ := utils.GenerateAllTokens(user.Email, user.FirstName, user.LastName, user.Role, user.UserID)
+		// The new tokens act for the same profile, unless it was deleted.
+		profileId := claim.ProfileId
+		if profileId != "" {
+			_, err := profile.Get(ctx, client, user.UserID, profileId)
+			if errors.Is(err, profile.ErrNotFound) {
+				profileId = ""
+			} else if err != nil {
+				c.Error(apperrors.Internal(err))
+				return
+			}
+		}
+
+		newToken, newRefreshToken, err := utils.GenerateAllTokens(user.Email, user.FirstName, user.LastName, user.Role, user.UserID, profileId)
+		if err != nil {
+			c.Error(apperrors.Internal(err))
+			return
+		}
 		err = utils.UpdateAllTokens(user.UserID, newToken, newRefreshToken, client)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Error updating tokens"})
+			c.Error(apperrors.Internal(err))
 			return
 		}
 
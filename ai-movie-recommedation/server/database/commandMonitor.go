@@ -0,0 +1,88 @@
+package database
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/metrics"
+	"github.com/princepal9120/ai-movie-recommedation/server/tracing"
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type startedCommand struct {
+	collection string
+	start      time.Time
+	span       trace.Span
+}
+
+// newCommandMonitor times every MongoDB command for Prometheus and records a
+// client span for it. Command bodies are not recorded since they may contain PII.
+func newCommandMonitor() *event.CommandMonitor {
+	var inFlight sync.Map
+
+	finish := func(evt event.CommandFinishedEvent, err error) {
+		value, ok := inFlight.LoadAndDelete(evt.RequestID)
+		if !ok {
+			return
+		}
+		started := value.(startedCommand)
+
+		metrics.MongoCommandDuration.WithLabelValues(evt.CommandName, started.collection, metrics.Outcome(err)).Observe(time.Since(started.start).Seconds())
+
+		if err != nil {
+			started.span.SetStatus(codes.Error, err.Error())
+		}
+		started.span.End()
+	}
+
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, evt *event.CommandStartedEvent) {
+			collection := commandCollection(evt)
+
+			attrs := []attribute.KeyValue{
+				attribute.String("db.system.name", "mongodb"),
+				attribute.String("db.operation.name", evt.CommandName),
+				attribute.String("db.namespace", evt.DatabaseName),
+			}
+			spanName := evt.CommandName
+			if collection != "" {
+				attrs = append(attrs, attribute.String("db.collection.name", collection))
+				spanName = evt.CommandName + " " + collection
+			}
+
+			_, span := tracing.Tracer().Start(ctx, spanName,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...),
+			)
+
+			inFlight.Store(evt.RequestID, startedCommand{
+				collection: collection,
+				start:      time.Now(),
+				span:       span,
+			})
+		},
+		Succeeded: func(_ context.Context, evt *event.CommandSucceededEvent) {
+			finish(evt.CommandFinishedEvent, nil)
+		},
+		Failed: func(_ context.Context, evt *event.CommandFailedEvent) {
+			finish(evt.CommandFinishedEvent, evt.Failure)
+		},
+	}
+}
+
+// commandCollection extracts the collection name, which is the value of the first
+// element for collection-level commands such as find, insert or aggregate.
+func commandCollection(evt *event.CommandStartedEvent) string {
+	elem, err := evt.Command.IndexErr(0)
+	if err != nil {
+		return ""
+	}
+	if collection, ok := elem.Value().StringValueOK(); ok {
+		return collection
+	}
+	return ""
+}
@@ -1,11 +1,11 @@
 package database
 
 import (
-	"fmt"
-	"log"
+	"log/slog"
 	"os"
 
 	"github.com/joho/godotenv"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
@@ -15,22 +15,24 @@ func Connect() *mongo.Client {
 	err := godotenv.Load(".env")
 
 	if err != nil {
-		log.Println("Warning: unable to find .env file")
+		slog.Warn("unable to find .env file")
 	}
 
 	MongoDb := os.Getenv("MONGODB_URI")
 
 	if MongoDb == "" {
-		log.Fatal("MONGODB_URI not set!")
+		slog.Error("MONGODB_URI not set")
+		os.Exit(1)
 	}
 
-	fmt.Println("MongoDB URI: ", MongoDb)
+	slog.Debug("connecting to MongoDB", "mongodb_uri", logging.RedactURI(MongoDb))
 
-	clientOptions := options.Client().ApplyURI(MongoDb)
+	clientOptions := options.Client().ApplyURI(MongoDb).SetMonitor(newCommandMonitor())
 
 	client, err := mongo.Connect(clientOptions)
 
 	if err != nil {
+		slog.Error("failed to create MongoDB client", "error", err)
 		return nil
 	}
 
@@ -39,18 +41,13 @@ func Connect() *mongo.Client {
 
 //var Client *mongo.Client = DBInstance()
 
-func OpenCollection(collectionName string, client *mongo.Client) *mongo.Collection {
-
-	err := godotenv.Load(".env")
-	if err != nil {
-		log.Println("Warning: unable to find .env file")
-	}
-
-	databaseName := os.Getenv("DATABASE_NAME")
+func OpenDatabase(client *mongo.Client) *mongo.Database {
+	return client.Database(os.Getenv("DATABASE_NAME"))
+}
 
-	fmt.Println("DATABASE_NAME: ", databaseName)
+func OpenCollection(collectionName string, client *mongo.Client) *mongo.Collection {
 
-	collection := client.Database(databaseName).Collection(collectionName)
+	collection := OpenDatabase(client).Collection(collectionName)
 
 	if collection == nil {
 		return nil
@@ -0,0 +1,141 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type Index struct {
+	Collection string
+	Keys       bson.D
+	Unique     bool
+	// ExpireAfter makes a TTL index: documents are deleted this long after
+	// the time in the index's single key.
+	ExpireAfter time.Duration
+	// PartialFilter makes a partial index: only documents matching it are
+	// indexed, so a unique index constrains only those.
+	PartialFilter bson.D
+}
+
+// Name follows MongoDB's default naming, e.g. "genre.genre_name_1".
+func (i Index) Name() string {
+	parts := make([]string, 0, len(i.Keys))
+	for _, key := range i.Keys {
+		parts = append(parts, fmt.Sprintf("%s_%v", key.Key, key.Value))
+	}
+	return strings.Join(parts, "_")
+}
+
+func (i Index) Model() mongo.IndexModel {
+	opts := options.Index().SetName(i.Name()).SetUnique(i.Unique)
+	if i.ExpireAfter > 0 {
+		opts.SetExpireAfterSeconds(int32(i.ExpireAfter.Seconds()))
+	}
+	if i.PartialFilter != nil {
+		opts.SetPartialFilterExpression(i.PartialFilter)
+	}
+	return mongo.IndexModel{Keys: i.Keys, Options: opts}
+}
+
+// Indexes is the single source of truth for the indexes the server relies on.
+// They are created at startup, so adding one here is enough to roll it out.
+// An index that replaces another also lists the old one in Superseded.
+var Indexes = []Index{
+	{Collection: "users", Keys: bson.D{{Key: "email", Value: 1}}, Unique: true},
+	{Collection: "users", Keys: bson.D{{Key: "user_id", Value: 1}}, Unique: true},
+	{Collection: "movies", Keys: bson.D{{Key: "imdb_id", Value: 1}}, Unique: true},
+	{Collection: "movies", Keys: bson.D{{Key: "genre.genre_name", Value: 1}}},
+	{Collection: "movies", Keys: bson.D{{Key: "ranking.ranking_value", Value: 1}}},
+	{Collection: "movies", Keys: bson.D{{Key: "metadata.enriched_at", Value: 1}}},
+	{Collection: "genres", Keys: bson.D{{Key: "genre_id", Value: 1}}, Unique: true},
+	{Collection: "rankings", Keys: bson.D{{Key: "ranking_name", Value: 1}}, Unique: true},
+	{Collection: "prompt_templates", Keys: bson.D{{Key: "name", Value: 1}, {Key: "version", Value: 1}}, Unique: true},
+	{Collection: "jobs", Keys: bson.D{{Key: "status", Value: 1}, {Key: "run_after", Value: 1}}},
+	{Collection: "jobs", Keys: bson.D{{Key: "imdb_id", Value: 1}, {Key: "created_at", Value: -1}}},
+	// A missing profile_id is indexed as null, so the account keeps one
+	// rating and watchlist entry per movie too.
+	{Collection: "ratings", Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "profile_id", Value: 1}, {Key: "imdb_id", Value: 1}}, Unique: true},
+	{Collection: "ratings", Keys: bson.D{{Key: "imdb_id", Value: 1}}},
+	{Collection: "watchlist", Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "profile_id", Value: 1}, {Key: "imdb_id", Value: 1}}, Unique: true},
+	{Collection: "watchlist", Keys: bson.D{{Key: "imdb_id", Value: 1}}},
+	{Collection: "movie_activity", Keys: bson.D{{Key: "imdb_id", Value: 1}, {Key: "hour", Value: 1}}, Unique: true},
+	// Activity older than the longest trending window is of no use.
+	{Collection: "movie_activity", Keys: bson.D{{Key: "hour", Value: 1}}, ExpireAfter: 31 * 24 * time.Hour},
+	{Collection: "profiles", Keys: bson.D{{Key: "profile_id", Value: 1}}, Unique: true},
+	{Collection: "profiles", Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "name", Value: 1}}, Unique: true},
+	{Collection: "experiments", Keys: bson.D{{Key: "name", Value: 1}}, Unique: true},
+	// At most one experiment is active.
+	{Collection: "experiments", Keys: bson.D{{Key: "active", Value: 1}}, Unique: true, PartialFilter: bson.D{{Key: "active", Value: true}}},
+	{Collection: "experiment_exposures", Keys: bson.D{{Key: "experiment", Value: 1}, {Key: "variant", Value: 1}}},
+}
+
+// Superseded lists indexes that an entry in Indexes replaced. The server does
+// not run migrations, so EnsureIndexes drops these once their replacements
+// exist; otherwise an old unique index would keep enforcing its narrower
+// constraint on a database that was never migrated.
+var Superseded = []Index{
+	// One rating and watchlist entry per account, before profiles.
+	{Collection: "ratings", Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "imdb_id", Value: 1}}, Unique: true},
+	{Collection: "watchlist", Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "imdb_id", Value: 1}}, Unique: true},
+}
+
+// Server error codes for dropping an index, or from a collection, that does
+// not exist.
+const (
+	namespaceNotFound = 26
+	indexNotFound     = 27
+)
+
+// IndexesByCollection groups indexes by collection, keeping their order.
+func IndexesByCollection(indexes []Index) ([]string, map[string][]Index) {
+	var collections []string
+	grouped := map[string][]Index{}
+	for _, index := range indexes {
+		if _, seen := grouped[index.Collection]; !seen {
+			collections = append(collections, index.Collection)
+		}
+		grouped[index.Collection] = append(grouped[index.Collection], index)
+	}
+	return collections, grouped
+}
+
+// EnsureIndexes creates any missing index in Indexes and then drops the
+// Superseded ones. Creating an index that already exists with the same
+// options, or dropping one that is gone, is a no-op, so this is safe to run on
+// every start. It fails if existing data violates a uniqueness constraint.
+func EnsureIndexes(ctx context.Context, client *mongo.Client) error {
+	collections, grouped := IndexesByCollection(Indexes)
+	for _, collection := range collections {
+		models := make([]mongo.IndexModel, 0, len(grouped[collection]))
+		for _, index := range grouped[collection] {
+			models = append(models, index.Model())
+		}
+
+		names, err := OpenCollection(collection, client).Indexes().CreateMany(ctx, models)
+		if err != nil {
+			return fmt.Errorf("creating indexes on %s: %w", collection, err)
+		}
+		slog.Debug("indexes ensured", "collection", collection, "indexes", names)
+	}
+
+	for _, index := range Superseded {
+		err := OpenCollection(index.Collection, client).Indexes().DropOne(ctx, index.Name())
+		var cmdErr mongo.CommandError
+		if errors.As(err, &cmdErr) && (cmdErr.Code == indexNotFound || cmdErr.Code == namespaceNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("dropping superseded index %s on %s: %w", index.Name(), index.Collection, err)
+		}
+		slog.Info("superseded index dropped", "collection", index.Collection, "index", index.Name())
+	}
+	return nil
+}
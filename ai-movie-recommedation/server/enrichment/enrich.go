@@ -0,0 +1,190 @@
+package enrichment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/metrics"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/respcache"
+	"github.com/princepal9120/ai-movie-recommedation/server/tracing"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Enrich looks the movie up with provider and stores the result in its
+// metadata field. When the provider does not know the movie, only the source
+// and check time are recorded, so the refresh job does not retry it until the
+// metadata is stale, and ErrNotFound is returned. When the lookup fails, only
+// the attempt is recorded, so the refresh job moves on to other movies. A
+// missing movie yields mongo.ErrNoDocuments.
+func Enrich(ctx context.Context, movies *mongo.Collection, provider Provider, imdbID string) (*models.Metadata, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "enrichment lookup")
+	defer span.End()
+	span.SetAttributes(attribute.String("enrichment.provider", provider.Name()), attribute.String("imdb_id", imdbID))
+
+	metadata, err := provider.Lookup(ctx, imdbID)
+	metrics.MetadataLookups.WithLabelValues(provider.Name(), lookupOutcome(err)).Inc()
+
+	now := time.Now().UTC()
+	filter := bson.D{{Key: "imdb_id", Value: imdbID}}
+	var update bson.D
+	switch {
+	case err == nil:
+		metadata.Source = provider.Name()
+		metadata.EnrichedAt = now
+		metadata.AttemptedAt = now
+		update = bson.D{{Key: "metadata", Value: metadata}}
+	case errors.Is(err, ErrNotFound):
+		update = bson.D{{Key: "metadata.source", Value: provider.Name()}, {Key: "metadata.enriched_at", Value: now}, {Key: "metadata.attempted_at", Value: now}}
+	default:
+		span.SetStatus(codes.Error, err.Error())
+		_, updateErr := movies.UpdateOne(ctx, filter, bson.D{{Key: "$set", Value: bson.D{{Key: "metadata.attempted_at", Value: now}}}})
+		return nil, errors.Join(err, updateErr)
+	}
+
+	result, updateErr := movies.UpdateOne(ctx, filter, bson.D{{Key: "$set", Value: update}})
+	if updateErr != nil {
+		return nil, updateErr
+	}
+	if result.MatchedCount == 0 {
+		return nil, mongo.ErrNoDocuments
+	}
+	respcache.Invalidate(respcache.ScopeMovies)
+	return metadata, err
+}
+
+func lookupOutcome(err error) string {
+	if errors.Is(err, ErrNotFound) {
+		return "not_found"
+	}
+	return metrics.Outcome(err)
+}
+
+// Refresher periodically enriches movies that have no metadata yet or whose
+// metadata is older than MaxAge, at most BatchSize per run. Movies that were
+// never tried come first, then those tried longest ago, so lookups that keep
+// failing cannot hold up the rest of the catalog.
+type Refresher struct {
+	Movies    *mongo.Collection
+	Provider  Provider
+	Interval  time.Duration
+	MaxAge    time.Duration
+	BatchSize int64
+	// RetryAfter is how long a movie whose lookup failed waits before it is
+	// tried again.
+	RetryAfter time.Duration
+}
+
+// NewRefresher configures a Refresher from the environment:
+// METADATA_REFRESH_INTERVAL (default 24h, 0 disables), METADATA_MAX_AGE
+// (default 720h) and METADATA_RETRY_AFTER (default 6h). It returns
+// ErrDisabled when there is nothing to run.
+func NewRefresher(movies *mongo.Collection) (*Refresher, error) {
+	provider, err := New()
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := durationEnv("METADATA_REFRESH_INTERVAL", 24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	if interval == 0 {
+		return nil, ErrDisabled
+	}
+	maxAge, err := durationEnv("METADATA_MAX_AGE", 30*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	retryAfter, err := durationEnv("METADATA_RETRY_AFTER", 6*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Refresher{Movies: movies, Provider: provider, Interval: interval, MaxAge: maxAge, BatchSize: 100, RetryAfter: retryAfter}, nil
+}
+
+func durationEnv(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return d, nil
+}
+
+// Run refreshes once immediately and then every Interval until ctx is done.
+func (r *Refresher) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	for {
+		refreshed, failed, err := r.RefreshOnce(ctx)
+		if err != nil {
+			slog.Error("metadata refresh failed", "error", err)
+		} else if refreshed > 0 || failed > 0 {
+			slog.Info("metadata refreshed", "provider", r.Provider.Name(), "refreshed", refreshed, "failed", failed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RefreshOnce enriches one batch of stale movies. Movies the provider does
+// not know count as refreshed; other lookup failures count as failed.
+func (r *Refresher) RefreshOnce(ctx context.Context) (refreshed, failed int, err error) {
+	now := time.Now()
+	filter := bson.D{{Key: "$and", Value: bson.A{
+		bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: "metadata.enriched_at", Value: bson.D{{Key: "$exists", Value: false}}}},
+			bson.D{{Key: "metadata.enriched_at", Value: bson.D{{Key: "$lt", Value: now.Add(-r.MaxAge)}}}},
+		}}},
+		bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: "metadata.attempted_at", Value: bson.D{{Key: "$exists", Value: false}}}},
+			bson.D{{Key: "metadata.attempted_at", Value: bson.D{{Key: "$lte", Value: now.Add(-r.RetryAfter)}}}},
+		}}},
+	}}}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "metadata.attempted_at", Value: 1}, {Key: "metadata.enriched_at", Value: 1}}).
+		SetLimit(r.BatchSize).
+		SetProjection(bson.D{{Key: "imdb_id", Value: 1}})
+
+	cursor, err := r.Movies.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, 0, err
+	}
+	var stale []models.Movie
+	if err := cursor.All(ctx, &stale); err != nil {
+		return 0, 0, err
+	}
+
+	for _, movie := range stale {
+		if ctx.Err() != nil {
+			return refreshed, failed, ctx.Err()
+		}
+		_, err := Enrich(ctx, r.Movies, r.Provider, movie.ImdbID)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			slog.Warn("metadata lookup failed", "imdb_id", movie.ImdbID, "provider", r.Provider.Name(), "error", err)
+			failed++
+			continue
+		}
+		refreshed++
+	}
+	return refreshed, failed, nil
+}
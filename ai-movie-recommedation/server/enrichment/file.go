@@ -0,0 +1,58 @@
+package enrichment
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+)
+
+//go:embed data/metadata.json
+var fixture []byte
+
+// FileProvider serves metadata from a JSON object keyed by IMDb ID. It needs
+// no network, so it backs offline development and tests.
+type FileProvider struct {
+	entries map[string]models.Metadata
+}
+
+func NewFileProvider(path string) (*FileProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metadata file: %w", err)
+	}
+	return parseFixture(data)
+}
+
+// NewFixtureProvider serves the bundled data/metadata.json.
+func NewFixtureProvider() *FileProvider {
+	provider, err := parseFixture(fixture)
+	if err != nil {
+		panic(err)
+	}
+	return provider
+}
+
+func parseFixture(data []byte) (*FileProvider, error) {
+	var entries map[string]models.Metadata
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata file: %w", err)
+	}
+	return &FileProvider{entries: entries}, nil
+}
+
+func (p *FileProvider) Name() string {
+	return "file"
+}
+
+func (p *FileProvider) Lookup(ctx context.Context, imdbID string) (*models.Metadata, error) {
+	entry, ok := p.entries[imdbID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	entry.Cast = append([]string(nil), entry.Cast...)
+	return &entry, nil
+}
@@ -0,0 +1,73 @@
+// Package enrichment fills in models.Metadata (release year, runtime, cast,
+// director, overview and language) from an external movie catalog.
+package enrichment
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+)
+
+var (
+	// ErrNotFound is returned by a Provider that has no entry for the movie.
+	ErrNotFound = errors.New("enrichment: movie not found in metadata provider")
+	// ErrDisabled is returned by New when METADATA_PROVIDER is unset or "none".
+	ErrDisabled = errors.New("enrichment: no metadata provider configured")
+)
+
+// Provider looks up metadata for a movie by IMDb ID. Source and EnrichedAt
+// are set by the caller.
+type Provider interface {
+	Name() string
+	Lookup(ctx context.Context, imdbID string) (*models.Metadata, error)
+}
+
+var (
+	mu       sync.RWMutex
+	override Provider
+)
+
+// New returns the provider selected by METADATA_PROVIDER: "tmdb" for the
+// TMDB API, "file" for the JSON fixture in METADATA_FILE (or the bundled one),
+// or "none".
+func New() (Provider, error) {
+	mu.RLock()
+	provider := override
+	mu.RUnlock()
+	if provider != nil {
+		return provider, nil
+	}
+
+	switch strings.ToLower(os.Getenv("METADATA_PROVIDER")) {
+	case "", "none":
+		return nil, ErrDisabled
+	case "tmdb":
+		return NewTMDB()
+	case "file":
+		if path := os.Getenv("METADATA_FILE"); path != "" {
+			return NewFileProvider(path)
+		}
+		return NewFixtureProvider(), nil
+	default:
+		return nil, errors.New("unsupported METADATA_PROVIDER " + os.Getenv("METADATA_PROVIDER"))
+	}
+}
+
+// Use makes New return provider until the returned restore function is
+// called. It is intended for tests.
+func Use(provider Provider) (restore func()) {
+	mu.Lock()
+	previous := override
+	override = provider
+	mu.Unlock()
+
+	return func() {
+		mu.Lock()
+		override = previous
+		mu.Unlock()
+	}
+}
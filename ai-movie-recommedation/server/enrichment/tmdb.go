@@ -0,0 +1,142 @@
+package enrichment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	defaultTMDBBaseURL = "https://api.themoviedb.org/3"
+	maxCast            = 10
+)
+
+// TMDB looks movies up in a TMDB-compatible API: /find resolves the IMDb ID,
+// then /movie/{id} with credits supplies the details.
+type TMDB struct {
+	BaseURL string
+	// AccessToken is a TMDB read access token. It is sent as a bearer token,
+	// never in the URL, so it stays out of error messages and traces.
+	AccessToken string
+	HTTPClient  *http.Client
+}
+
+// NewTMDB reads TMDB_ACCESS_TOKEN and, optionally, TMDB_BASE_URL.
+func NewTMDB() (*TMDB, error) {
+	accessToken := os.Getenv("TMDB_ACCESS_TOKEN")
+	if accessToken == "" && os.Getenv("TMDB_API_KEY") != "" {
+		return nil, errors.New("TMDB_API_KEY is no longer supported, set TMDB_ACCESS_TOKEN to a TMDB read access token instead")
+	}
+	if accessToken == "" {
+		return nil, errors.New("could not read TMDB_ACCESS_TOKEN")
+	}
+	baseURL := os.Getenv("TMDB_BASE_URL")
+	if baseURL == "" {
+		baseURL = defaultTMDBBaseURL
+	}
+	return &TMDB{
+		BaseURL:     strings.TrimSuffix(baseURL, "/"),
+		AccessToken: accessToken,
+		HTTPClient: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+		},
+	}, nil
+}
+
+func (p *TMDB) Name() string {
+	return "tmdb"
+}
+
+type tmdbFindResponse struct {
+	MovieResults []struct {
+		ID int `json:"id"`
+	} `json:"movie_results"`
+}
+
+type tmdbMovie struct {
+	ReleaseDate      string `json:"release_date"`
+	Runtime          int    `json:"runtime"`
+	Overview         string `json:"overview"`
+	OriginalLanguage string `json:"original_language"`
+	Credits          struct {
+		Cast []struct {
+			Name string `json:"name"`
+		} `json:"cast"`
+		Crew []struct {
+			Name string `json:"name"`
+			Job  string `json:"job"`
+		} `json:"crew"`
+	} `json:"credits"`
+}
+
+func (p *TMDB) Lookup(ctx context.Context, imdbID string) (*models.Metadata, error) {
+	var found tmdbFindResponse
+	if err := p.get(ctx, "/find/"+url.PathEscape(imdbID), url.Values{"external_source": {"imdb_id"}}, &found); err != nil {
+		return nil, err
+	}
+	if len(found.MovieResults) == 0 {
+		return nil, ErrNotFound
+	}
+
+	var movie tmdbMovie
+	path := "/movie/" + strconv.Itoa(found.MovieResults[0].ID)
+	if err := p.get(ctx, path, url.Values{"append_to_response": {"credits"}}, &movie); err != nil {
+		return nil, err
+	}
+
+	metadata := &models.Metadata{
+		RuntimeMinutes: movie.Runtime,
+		Overview:       movie.Overview,
+		Language:       movie.OriginalLanguage,
+	}
+	if year, err := strconv.Atoi(strings.SplitN(movie.ReleaseDate, "-", 2)[0]); err == nil {
+		metadata.ReleaseYear = year
+	}
+	for _, member := range movie.Credits.Cast {
+		if len(metadata.Cast) == maxCast {
+			break
+		}
+		metadata.Cast = append(metadata.Cast, member.Name)
+	}
+	for _, member := range movie.Credits.Crew {
+		if member.Job == "Director" {
+			metadata.Director = member.Name
+			break
+		}
+	}
+	return metadata, nil
+}
+
+func (p *TMDB) get(ctx context.Context, path string, query url.Values, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.BaseURL+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.AccessToken)
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("tmdb: GET %s: unexpected status %s", path, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,94 @@
+package enrichment_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/enrichment"
+)
+
+func newTMDBServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /find/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" || r.URL.Query().Has("api_key") || r.URL.Query().Get("external_source") != "imdb_id" {
+			http.Error(w, "bad request", http.StatusUnauthorized)
+			return
+		}
+		if r.PathValue("id") != "tt0245429" {
+			w.Write([]byte(`{"movie_results": []}`))
+			return
+		}
+		w.Write([]byte(`{"movie_results": [{"id": 129}]}`))
+	})
+	mux.HandleFunc("GET /movie/129", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("append_to_response") != "credits" {
+			http.Error(w, "credits not requested", http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{
+			"release_date": "2001-07-20",
+			"runtime": 125,
+			"overview": "A girl wanders into a world of spirits.",
+			"original_language": "ja",
+			"credits": {
+				"cast": [{"name": "Rumi Hiiragi"}, {"name": "Miyu Irino"}],
+				"crew": [{"name": "Toshio Suzuki", "job": "Producer"}, {"name": "Hayao Miyazaki", "job": "Director"}]
+			}
+		}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestTMDBLookup(t *testing.T) {
+	server := newTMDBServer(t)
+	provider := &enrichment.TMDB{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	metadata, err := provider.Lookup(context.Background(), "tt0245429")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metadata.ReleaseYear != 2001 || metadata.RuntimeMinutes != 125 || metadata.Language != "ja" || metadata.Director != "Hayao Miyazaki" {
+		t.Fatalf("got %+v", metadata)
+	}
+	if want := []string{"Rumi Hiiragi", "Miyu Irino"}; !reflect.DeepEqual(metadata.Cast, want) {
+		t.Fatalf("cast: got %v, want %v", metadata.Cast, want)
+	}
+}
+
+func TestTMDBLookupNotFound(t *testing.T) {
+	server := newTMDBServer(t)
+	provider := &enrichment.TMDB{BaseURL: server.URL, AccessToken: "test-token", HTTPClient: server.Client()}
+
+	if _, err := provider.Lookup(context.Background(), "tt0000001"); !errors.Is(err, enrichment.ErrNotFound) {
+		t.Fatalf("got %v, want ErrNotFound", err)
+	}
+}
+
+func TestTMDBLookupUpstreamError(t *testing.T) {
+	server := newTMDBServer(t)
+	provider := &enrichment.TMDB{BaseURL: server.URL, AccessToken: "wrong-token", HTTPClient: server.Client()}
+
+	_, err := provider.Lookup(context.Background(), "tt0245429")
+	if err == nil || errors.Is(err, enrichment.ErrNotFound) {
+		t.Fatalf("got %v, want an upstream error", err)
+	}
+}
+
+func TestTMDBLookupKeepsTokenOutOfErrors(t *testing.T) {
+	server := newTMDBServer(t)
+	provider := &enrichment.TMDB{BaseURL: server.URL, AccessToken: "secret-token", HTTPClient: server.Client()}
+	server.Close()
+
+	_, err := provider.Lookup(context.Background(), "tt0245429")
+	if err == nil || strings.Contains(err.Error(), "secret-token") {
+		t.Fatalf("got %v, want a transport error without the token", err)
+	}
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/evaluation"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/recommend"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const usage = `Usage: evalctl [flags]
+
+Replays ratings and watchlist entries, holds out each user's latest
+interactions and reports precision@k, recall@k, NDCG@k and catalog coverage
+for each recommender.
+
+Flags:
+`
+
+// recommenders builds the strategies evalctl can evaluate, by name.
+var recommenders = map[string]func(ctx context.Context, client *mongo.Client, dataset *evaluation.Dataset, split evaluation.Split, seed uint64) (recommend.Strategy, error){
+	"genre_match": func(_ context.Context, client *mongo.Client, dataset *evaluation.Dataset, _ evaluation.Split, _ uint64) (recommend.Strategy, error) {
+		return &recommend.GenreMatch{Client: client, Genres: dataset.Taxonomy}, nil
+	},
+	"newest": func(_ context.Context, client *mongo.Client, _ *evaluation.Dataset, _ evaluation.Split, _ uint64) (recommend.Strategy, error) {
+		return &recommend.Newest{Client: client}, nil
+	},
+	"popularity": func(_ context.Context, _ *mongo.Client, dataset *evaluation.Dataset, split evaluation.Split, _ uint64) (recommend.Strategy, error) {
+		return evaluation.NewPopularity(dataset, split), nil
+	},
+	"random": func(_ context.Context, _ *mongo.Client, dataset *evaluation.Dataset, _ evaluation.Split, seed uint64) (recommend.Strategy, error) {
+		return evaluation.NewRandom(dataset, seed), nil
+	},
+}
+
+func main() {
+	envFile := flag.String("env-file", ".env", "path of the .env file to load")
+	k := flag.Int("k", 10, "length of each recommendation list")
+	testFraction := flag.Float64("test-fraction", 0.2, "share of each user's latest interactions held out")
+	minScore := flag.Int("min-score", 4, "lowest rating that counts as liking a movie")
+	names := flag.String("recommenders", "genre_match,popularity,newest,random", "comma separated recommenders to evaluate")
+	seed := flag.Uint64("seed", 1, "seed of the random recommender")
+	format := flag.String("format", "text", "text or json")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	err := godotenv.Load(*envFile)
+
+	logging.Init()
+
+	if err != nil {
+		slog.Warn("unable to load env file", "path", *envFile)
+	}
+
+	if *k < 1 || *testFraction <= 0 || *testFraction >= 1 || (*format != "text" && *format != "json") {
+		flag.Usage()
+		os.Exit(2)
+	}
+	var selected []string
+	for name := range strings.SplitSeq(*names, ",") {
+		name = strings.TrimSpace(name)
+		if _, ok := recommenders[name]; !ok {
+			slog.Error("unknown recommender", "name", name)
+			os.Exit(2)
+		}
+		selected = append(selected, name)
+	}
+
+	client := database.Connect()
+	if client == nil {
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	results, err := evaluate(ctx, client, selected, *k, *testFraction, *minScore, *seed)
+
+	cancel()
+	if disconnectErr := client.Disconnect(context.Background()); disconnectErr != nil {
+		slog.Error("failed to disconnect from MongoDB", "error", disconnectErr)
+	}
+	if err != nil {
+		slog.Error("evaluation failed", "error", err)
+		os.Exit(1)
+	}
+
+	if *format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(results)
+	} else {
+		err = printResults(results)
+	}
+	if err != nil {
+		slog.Error("writing results failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+func evaluate(ctx context.Context, client *mongo.Client, names []string, k int, testFraction float64, minScore int, seed uint64) ([]evaluation.Result, error) {
+	dataset, err := evaluation.Load(ctx, client, minScore)
+	if err != nil {
+		return nil, err
+	}
+	split := dataset.Split(testFraction)
+	slog.Info("dataset loaded", "interactions", len(dataset.Interactions), "users", len(split.Train), "movies", len(dataset.Catalog))
+
+	results := make([]evaluation.Result, 0, len(names))
+	for _, name := range names {
+		strategy, err := recommenders[name](ctx, client, dataset, split, seed)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		result, err := evaluation.Evaluate(ctx, strategy, dataset, split, k)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func printResults(results []evaluation.Result) error {
+	if len(results) == 0 {
+		return nil
+	}
+	k := results[0].K
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(w, "recommender\tusers\tprecision@%d\trecall@%d\tndcg@%d\tcoverage\t\n", k, k, k)
+	for _, r := range results {
+		fmt.Fprintf(w, "%s\t%d\t%.4f\t%.4f\t%.4f\t%.4f\t\n", r.Recommender, r.Users, r.Precision, r.Recall, r.NDCG, r.Coverage)
+	}
+	return w.Flush()
+}
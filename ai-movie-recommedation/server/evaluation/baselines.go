@@ -0,0 +1,91 @@
+package evaluation
+
+import (
+	"cmp"
+	"context"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/recommend"
+)
+
+// The baselines below answer from the dataset alone, without the database, so
+// they cannot see held out activity. Like the served strategies they leave
+// out movies above the viewer's maturity setting.
+
+// Popularity recommends the movies most often liked in training, the same
+// for everyone. Every recommender should beat it.
+type Popularity struct {
+	movies map[string]models.Movie
+	ranked []string
+}
+
+// NewPopularity counts the relevant training interactions of each movie.
+func NewPopularity(dataset *Dataset, split Split) *Popularity {
+	counts := map[string]int{}
+	for _, history := range split.Train {
+		for _, in := range history {
+			if in.Relevant {
+				counts[in.ImdbID]++
+			}
+		}
+	}
+	p := &Popularity{movies: dataset.Movies}
+	for imdbID := range counts {
+		p.ranked = append(p.ranked, imdbID)
+	}
+	slices.SortFunc(p.ranked, func(a, b string) int {
+		if c := cmp.Compare(counts[b], counts[a]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
+	return p
+}
+
+func (p *Popularity) Name() string { return "popularity" }
+
+func (p *Popularity) Recommend(_ context.Context, viewer recommend.Viewer, limit int, exclude []string) ([]models.Movie, error) {
+	return pick(p.ranked, p.movies, viewer, limit, exclude), nil
+}
+
+// Random recommends movies from the catalog at random, the floor any
+// recommender must clear. The same seed gives the same lists.
+type Random struct {
+	catalog []string
+	movies  map[string]models.Movie
+	rng     *rand.Rand
+}
+
+// NewRandom shuffles the dataset's catalog with a generator seeded by seed.
+func NewRandom(dataset *Dataset, seed uint64) *Random {
+	return &Random{catalog: dataset.Catalog, movies: dataset.Movies, rng: rand.New(rand.NewPCG(seed, seed))}
+}
+
+func (r *Random) Name() string { return "random" }
+
+func (r *Random) Recommend(_ context.Context, viewer recommend.Viewer, limit int, exclude []string) ([]models.Movie, error) {
+	shuffled := slices.Clone(r.catalog)
+	r.rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	return pick(shuffled, r.movies, viewer, limit, exclude), nil
+}
+
+// pick takes the first limit movies of ids the viewer may be recommended,
+// leaving out exclude. Movies missing from movies carry only an IMDb ID.
+func pick(ids []string, movies map[string]models.Movie, viewer recommend.Viewer, limit int, exclude []string) []models.Movie {
+	picked := []models.Movie{}
+	for _, id := range ids {
+		if len(picked) == limit {
+			break
+		}
+		movie, ok := movies[id]
+		if !ok {
+			movie = models.Movie{ImdbID: id}
+		}
+		if !slices.Contains(exclude, id) && viewer.Allows(movie) {
+			picked = append(picked, movie)
+		}
+	}
+	return picked
+}
@@ -0,0 +1,196 @@
+// Package evaluation measures recommenders offline. It replays what users
+// rated and saved, holds out each user's latest interactions, asks a
+// recommender for movies given the rest, and scores the answers against what
+// the user went on to like.
+package evaluation
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/recommend"
+	"github.com/princepal9120/ai-movie-recommedation/server/taxonomy"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Interaction is a user rating or saving a movie. A user has at most one
+// interaction per movie in a dataset.
+type Interaction struct {
+	// UserID is the profile ID for activity of a household profile, which is
+	// evaluated as a user of its own.
+	UserID string
+	ImdbID string
+	At     time.Time
+	// Relevant is whether the interaction says the user liked the movie.
+	Relevant bool
+	// Score is the user's rating of the movie, 0 when it was only saved.
+	Score int
+}
+
+// Dataset is the interaction history recommenders are evaluated on.
+type Dataset struct {
+	Interactions []Interaction
+	// Catalog holds the IMDb ID of every movie.
+	Catalog []string
+	// Movies holds the movies of the catalog by IMDb ID.
+	Movies map[string]models.Movie
+	// Taxonomy is the genres collection, for deriving favourite genres.
+	Taxonomy taxonomy.Index
+	// Maturity holds the maturity setting of each household profile.
+	Maturity map[string]string
+}
+
+// Load reads the dataset from the ratings, watchlist, profiles, genres and
+// movies collections. Ratings of at least minScore and watchlist entries are
+// relevant. A movie both rated and saved counts once, at the earlier time,
+// and is relevant if either is.
+func Load(ctx context.Context, client *mongo.Client, minScore int) (*Dataset, error) {
+	type key struct{ userID, imdbID string }
+	merged := map[key]*Interaction{}
+	add := func(in Interaction) {
+		k := key{in.UserID, in.ImdbID}
+		existing, ok := merged[k]
+		if !ok {
+			merged[k] = &in
+			return
+		}
+		if in.At.Before(existing.At) {
+			existing.At = in.At
+		}
+		existing.Relevant = existing.Relevant || in.Relevant
+		existing.Score = max(existing.Score, in.Score)
+	}
+
+	cursor, err := database.OpenCollection("ratings", client).Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	var ratings []models.Rating
+	if err := cursor.All(ctx, &ratings); err != nil {
+		return nil, err
+	}
+	for _, rating := range ratings {
+		add(Interaction{UserID: viewerID(rating.UserID, rating.ProfileID), ImdbID: rating.ImdbID, At: rating.RatedAt, Relevant: rating.Score >= minScore, Score: rating.Score})
+	}
+
+	cursor, err = database.OpenCollection("watchlist", client).Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	var entries []models.WatchlistEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		add(Interaction{UserID: viewerID(entry.UserID, entry.ProfileID), ImdbID: entry.ImdbID, At: entry.AddedAt, Relevant: true})
+	}
+
+	dataset := &Dataset{Movies: map[string]models.Movie{}, Maturity: map[string]string{}}
+	for _, in := range merged {
+		dataset.Interactions = append(dataset.Interactions, *in)
+	}
+
+	cursor, err = database.OpenCollection("profiles", client).Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	var profiles []models.Profile
+	if err := cursor.All(ctx, &profiles); err != nil {
+		return nil, err
+	}
+	for _, profile := range profiles {
+		dataset.Maturity[profile.ProfileID] = profile.Maturity
+	}
+
+	if dataset.Taxonomy, err = taxonomy.Load(ctx, client); err != nil {
+		return nil, err
+	}
+
+	cursor, err = database.OpenCollection("movies", client).Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	var movies []models.Movie
+	if err := cursor.All(ctx, &movies); err != nil {
+		return nil, err
+	}
+	for _, movie := range movies {
+		dataset.Catalog = append(dataset.Catalog, movie.ImdbID)
+		dataset.Movies[movie.ImdbID] = movie
+	}
+	return dataset, nil
+}
+
+// viewerID tells apart the profiles of a household. Profile IDs are
+// ObjectIDs like user IDs, so they cannot collide.
+func viewerID(userID, profileID string) string {
+	if profileID != "" {
+		return profileID
+	}
+	return userID
+}
+
+// Split is a dataset's interactions divided per user into what recommenders
+// are shown and what they are scored against.
+type Split struct {
+	Train map[string][]Interaction
+	Test  map[string][]Interaction
+	// Genres holds each user's favourite genre names, derived from their
+	// training ratings the way onboarding derives them. Genres users picked
+	// themselves are left out, as they may follow held out ratings.
+	Genres map[string][]string
+}
+
+// Split holds out the latest testFraction of each user's interactions, at
+// least one and never all of them, so recommenders are asked to predict the
+// future from the past. Users with a single interaction are train only.
+func (d *Dataset) Split(testFraction float64) Split {
+	byUser := map[string][]Interaction{}
+	for _, in := range d.Interactions {
+		byUser[in.UserID] = append(byUser[in.UserID], in)
+	}
+
+	split := Split{Train: map[string][]Interaction{}, Test: map[string][]Interaction{}, Genres: map[string][]string{}}
+	for userID, history := range byUser {
+		slices.SortFunc(history, func(a, b Interaction) int {
+			if c := a.At.Compare(b.At); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.ImdbID, b.ImdbID)
+		})
+		held := int(float64(len(history))*testFraction + 0.5)
+		held = min(max(held, 1), len(history)-1)
+		split.Train[userID] = history[:len(history)-held]
+		if held > 0 {
+			split.Test[userID] = history[len(history)-held:]
+		}
+		if genres := d.trainGenres(split.Train[userID]); len(genres) > 0 {
+			split.Genres[userID] = genres
+		}
+	}
+	return split
+}
+
+// trainGenres derives favourite genre names from the ratings in train.
+func (d *Dataset) trainGenres(train []Interaction) []string {
+	scores := map[string]int{}
+	var movies []models.Movie
+	for _, in := range train {
+		movie, ok := d.Movies[in.ImdbID]
+		if !ok || in.Score == 0 {
+			continue
+		}
+		scores[in.ImdbID] = in.Score
+		movies = append(movies, movie)
+	}
+	var names []string
+	for _, genre := range recommend.GenresFromRatings(scores, movies, d.Taxonomy) {
+		names = append(names, genre.GenreName)
+	}
+	return names
+}
@@ -0,0 +1,79 @@
+package evaluation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/recommend"
+)
+
+// Result is how well one recommender did, averaged over the users it was
+// evaluated for.
+type Result struct {
+	Recommender string  `json:"recommender"`
+	K           int     `json:"k"`
+	Users       int     `json:"users"`
+	Precision   float64 `json:"precision"`
+	Recall      float64 `json:"recall"`
+	NDCG        float64 `json:"ndcg"`
+	// Coverage is the share of the catalog recommended to anyone.
+	Coverage float64 `json:"coverage"`
+}
+
+// Evaluate asks strategy for k movies for every user with a relevant held out
+// interaction, leaving out the movies the user interacted with in training,
+// and scores the lists against the relevant held out movies. Household
+// profiles are asked for with their maturity setting, as when served.
+func Evaluate(ctx context.Context, strategy recommend.Strategy, dataset *Dataset, split Split, k int) (Result, error) {
+	result := Result{Recommender: strategy.Name(), K: k}
+
+	users := make([]string, 0, len(split.Test))
+	for userID := range split.Test {
+		users = append(users, userID)
+	}
+	slices.Sort(users)
+
+	recommended := map[string]bool{}
+	for _, userID := range users {
+		relevant := map[string]bool{}
+		for _, in := range split.Test[userID] {
+			if in.Relevant {
+				relevant[in.ImdbID] = true
+			}
+		}
+		if len(relevant) == 0 {
+			continue
+		}
+		var seen []string
+		for _, in := range split.Train[userID] {
+			seen = append(seen, in.ImdbID)
+		}
+
+		viewer := recommend.Viewer{UserID: userID, Genres: split.Genres[userID], Maturity: dataset.Maturity[userID]}
+		movies, err := strategy.Recommend(ctx, viewer, k, seen)
+		if err != nil {
+			return result, fmt.Errorf("%s for user %s: %w", strategy.Name(), userID, err)
+		}
+		ids := make([]string, len(movies))
+		for i, movie := range movies {
+			ids[i] = movie.ImdbID
+			recommended[movie.ImdbID] = true
+		}
+
+		result.Users++
+		result.Precision += Precision(ids, relevant, k)
+		result.Recall += Recall(ids, relevant, k)
+		result.NDCG += NDCG(ids, relevant, k)
+	}
+
+	if result.Users > 0 {
+		result.Precision /= float64(result.Users)
+		result.Recall /= float64(result.Users)
+		result.NDCG /= float64(result.Users)
+	}
+	if len(dataset.Catalog) > 0 {
+		result.Coverage = float64(len(recommended)) / float64(len(dataset.Catalog))
+	}
+	return result, nil
+}
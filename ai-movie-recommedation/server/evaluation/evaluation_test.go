@@ -0,0 +1,150 @@
+package evaluation_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/evaluation"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/recommend"
+	"github.com/princepal9120/ai-movie-recommedation/server/taxonomy"
+)
+
+func TestMetrics(t *testing.T) {
+	relevant := map[string]bool{"a": true, "b": true, "c": true}
+	recommended := []string{"a", "x", "b", "y"}
+
+	for _, tc := range []struct {
+		name      string
+		got, want float64
+	}{
+		{"precision@4", evaluation.Precision(recommended, relevant, 4), 0.5},
+		{"precision@2", evaluation.Precision(recommended, relevant, 2), 0.5},
+		// A short list is not rewarded for its length.
+		{"precision@10", evaluation.Precision(recommended, relevant, 10), 0.2},
+		{"recall@4", evaluation.Recall(recommended, relevant, 4), 2.0 / 3},
+		{"recall@1", evaluation.Recall(recommended, relevant, 1), 1.0 / 3},
+		{"ndcg@4", evaluation.NDCG(recommended, relevant, 4), (1 + 1/math.Log2(4)) / (1 + 1/math.Log2(3) + 1/math.Log2(4))},
+		{"ndcg ideal", evaluation.NDCG([]string{"b", "a"}, relevant, 2), 1},
+		{"ndcg none", evaluation.NDCG([]string{"x"}, relevant, 1), 0},
+	} {
+		if math.Abs(tc.got-tc.want) > 1e-9 {
+			t.Errorf("%s: got %v, want %v", tc.name, tc.got, tc.want)
+		}
+	}
+}
+
+func interactions(userID string, start time.Time, items ...string) []evaluation.Interaction {
+	var out []evaluation.Interaction
+	for i, imdbID := range items {
+		out = append(out, evaluation.Interaction{UserID: userID, ImdbID: imdbID, At: start.Add(time.Duration(i) * time.Hour), Relevant: true})
+	}
+	return out
+}
+
+func TestSplitHoldsOutLatest(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	dataset := &evaluation.Dataset{}
+	dataset.Interactions = append(dataset.Interactions, interactions("alice", start, "m1", "m2", "m3", "m4", "m5")...)
+	dataset.Interactions = append(dataset.Interactions, interactions("bob", start, "m1", "m2")...)
+	dataset.Interactions = append(dataset.Interactions, interactions("carol", start, "m1")...)
+
+	split := dataset.Split(0.4)
+	for _, tc := range []struct {
+		user        string
+		train, test int
+		lastTest    string
+	}{
+		{"alice", 3, 2, "m5"},
+		{"bob", 1, 1, "m2"},
+		{"carol", 1, 0, ""},
+	} {
+		train, test := split.Train[tc.user], split.Test[tc.user]
+		if len(train) != tc.train || len(test) != tc.test {
+			t.Errorf("%s: got %d train and %d test, want %d and %d", tc.user, len(train), len(test), tc.train, tc.test)
+			continue
+		}
+		if tc.test > 0 && test[len(test)-1].ImdbID != tc.lastTest {
+			t.Errorf("%s: got last test %s, want %s", tc.user, test[len(test)-1].ImdbID, tc.lastTest)
+		}
+	}
+}
+
+func TestEvaluatePopularity(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	dataset := &evaluation.Dataset{Catalog: []string{"m1", "m2", "m3", "m4"}}
+	// m1 is liked most in training, so popularity recommends it, then m2.
+	dataset.Interactions = append(dataset.Interactions, interactions("alice", start, "m1", "m2")...)
+	dataset.Interactions = append(dataset.Interactions, interactions("bob", start, "m1", "m3")...)
+	dataset.Interactions = append(dataset.Interactions, interactions("carol", start, "m2", "m1")...)
+	split := dataset.Split(0.5)
+
+	result, err := evaluation.Evaluate(context.Background(), evaluation.NewPopularity(dataset, split), dataset, split, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// alice and bob saw m1, so they get m2; carol saw m2 and gets m1. Only
+	// alice and carol held out what they got.
+	if result.Recommender != "popularity" || result.Users != 3 {
+		t.Fatalf("got %+v, want popularity over 3 users", result)
+	}
+	if math.Abs(result.Precision-2.0/3) > 1e-9 || math.Abs(result.Recall-2.0/3) > 1e-9 || math.Abs(result.NDCG-2.0/3) > 1e-9 {
+		t.Fatalf("got precision %v, recall %v, ndcg %v, want 2/3 each", result.Precision, result.Recall, result.NDCG)
+	}
+	if result.Coverage != 0.5 {
+		t.Fatalf("got coverage %v, want 0.5", result.Coverage)
+	}
+}
+
+func TestSplitDerivesGenresFromTraining(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	drama := models.Genre{GenreID: 1, GenreName: "Drama"}
+	comedy := models.Genre{GenreID: 2, GenreName: "Comedy"}
+	dataset := &evaluation.Dataset{
+		Movies: map[string]models.Movie{
+			"m1": {ImdbID: "m1", Genre: []models.Genre{drama}},
+			"m2": {ImdbID: "m2", Genre: []models.Genre{drama}},
+			"m3": {ImdbID: "m3", Genre: []models.Genre{comedy}},
+		},
+		Taxonomy: taxonomy.Index{1: drama, 2: comedy},
+	}
+	// alice loves the comedy she rated last, which is held out.
+	dataset.Interactions = []evaluation.Interaction{
+		{UserID: "alice", ImdbID: "m1", At: start, Score: 4, Relevant: true},
+		{UserID: "alice", ImdbID: "m2", At: start.Add(time.Hour), Score: 5, Relevant: true},
+		{UserID: "alice", ImdbID: "m3", At: start.Add(2 * time.Hour), Score: 5, Relevant: true},
+	}
+
+	split := dataset.Split(0.3)
+	if got := split.Genres["alice"]; len(got) != 1 || got[0] != "Drama" {
+		t.Fatalf("got genres %v, want only Drama from training", got)
+	}
+}
+
+func TestBaselinesRespectMaturity(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	dataset := &evaluation.Dataset{
+		Catalog: []string{"m1", "m2", "m3"},
+		Movies: map[string]models.Movie{
+			"m1": {ImdbID: "m1", Maturity: models.MaturityAdult},
+			"m2": {ImdbID: "m2", Maturity: models.MaturityKids},
+			"m3": {ImdbID: "m3"},
+		},
+	}
+	dataset.Interactions = append(dataset.Interactions, interactions("alice", start, "m1", "m2", "m3")...)
+	dataset.Interactions = append(dataset.Interactions, interactions("bob", start, "m2", "m3")...)
+	split := dataset.Split(0.3)
+
+	kids := recommend.Viewer{UserID: "kid", Maturity: models.MaturityKids}
+	for _, strategy := range []recommend.Strategy{evaluation.NewPopularity(dataset, split), evaluation.NewRandom(dataset, 1)} {
+		movies, err := strategy.Recommend(context.Background(), kids, 3, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(movies) != 1 || movies[0].ImdbID != "m2" {
+			t.Errorf("%s: got %v, want only the kids movie", strategy.Name(), movies)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package evaluation
+
+import "math"
+
+// Precision is the share of the first k recommendations that are relevant.
+// Short lists are still divided by k, so a recommender gains nothing by
+// recommending less.
+func Precision(recommended []string, relevant map[string]bool, k int) float64 {
+	if k <= 0 {
+		return 0
+	}
+	return float64(hits(recommended, relevant, k)) / float64(k)
+}
+
+// Recall is the share of the relevant movies found in the first k
+// recommendations.
+func Recall(recommended []string, relevant map[string]bool, k int) float64 {
+	if len(relevant) == 0 {
+		return 0
+	}
+	return float64(hits(recommended, relevant, k)) / float64(len(relevant))
+}
+
+// NDCG is the discounted cumulative gain of the first k recommendations,
+// divided by that of the best possible list: 1 when every relevant movie that
+// fits comes first, less the lower down they are.
+func NDCG(recommended []string, relevant map[string]bool, k int) float64 {
+	var dcg, ideal float64
+	for i := range min(k, len(recommended)) {
+		if relevant[recommended[i]] {
+			dcg += 1 / math.Log2(float64(i+2))
+		}
+	}
+	for i := range min(k, len(relevant)) {
+		ideal += 1 / math.Log2(float64(i+2))
+	}
+	if ideal == 0 {
+		return 0
+	}
+	return dcg / ideal
+}
+
+func hits(recommended []string, relevant map[string]bool, k int) int {
+	n := 0
+	for i := range min(k, len(recommended)) {
+		if relevant[recommended[i]] {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,123 @@
+// Package events fans catalog changes out to the clients of /movies/events.
+//
+// On a replica set the events come from a change stream on the movies
+// collection, so every server sees the writes of every other server. On a
+// standalone MongoDB, where change streams are not available, the code that
+// writes a movie publishes the event itself and only this server's clients
+// see it.
+package events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+)
+
+// Event types, also used as the SSE event names.
+const (
+	MovieAdded     = "movie-added"
+	ReviewUpdated  = "review-updated"
+	RankingChanged = "ranking-changed"
+)
+
+// Sources of events; see Start.
+const (
+	SourceLocal        = "local"
+	SourceChangeStream = "change_stream"
+)
+
+// subscriberBuffer is how many events a subscriber may fall behind before it
+// is dropped.
+const subscriberBuffer = 64
+
+var (
+	mu          sync.RWMutex
+	source      = SourceLocal
+	subscribers = map[chan models.CatalogEvent]struct{}{}
+)
+
+// Source reports where events come from.
+func Source() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return source
+}
+
+func setSource(s string) {
+	mu.Lock()
+	defer mu.Unlock()
+	source = s
+}
+
+// Subscribe returns a channel of every event published from now on, and a
+// function to stop receiving them. The channel is closed when the subscriber
+// falls too far behind; it should reconnect and reload what it shows.
+func Subscribe() (<-chan models.CatalogEvent, func()) {
+	ch := make(chan models.CatalogEvent, subscriberBuffer)
+	mu.Lock()
+	subscribers[ch] = struct{}{}
+	mu.Unlock()
+
+	return ch, func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if _, ok := subscribers[ch]; ok {
+			delete(subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// Publish records changes made by this server. It does nothing while a change
+// stream is the source, since the stream delivers the same changes.
+func Publish(events ...models.CatalogEvent) {
+	if Source() != SourceLocal {
+		return
+	}
+	broadcast(events...)
+}
+
+func broadcast(events ...models.CatalogEvent) {
+	mu.Lock()
+	defer mu.Unlock()
+	for _, event := range events {
+		if event.At.IsZero() {
+			event.At = time.Now().UTC()
+		}
+		for ch := range subscribers {
+			select {
+			case ch <- event:
+			default:
+				delete(subscribers, ch)
+				close(ch)
+			}
+		}
+	}
+}
+
+// Diff returns the events for a movie that was before and is now after. A nil
+// before means the movie was added.
+func Diff(before, after *models.Movie) []models.CatalogEvent {
+	if before == nil {
+		return []models.CatalogEvent{{Type: MovieAdded, ImdbID: after.ImdbID, Movie: after}}
+	}
+	var events []models.CatalogEvent
+	if before.AdminReview != after.AdminReview {
+		events = append(events, ReviewEvent(after.ImdbID, after.AdminReview))
+	}
+	if before.Ranking != after.Ranking {
+		events = append(events, RankingEvent(after.ImdbID, after.Ranking))
+	}
+	return events
+}
+
+// ReviewEvent is a review-updated event.
+func ReviewEvent(imdbID, review string) models.CatalogEvent {
+	return models.CatalogEvent{Type: ReviewUpdated, ImdbID: imdbID, AdminReview: &review}
+}
+
+// RankingEvent is a ranking-changed event.
+func RankingEvent(imdbID string, ranking models.Ranking) models.CatalogEvent {
+	return models.CatalogEvent{Type: RankingChanged, ImdbID: imdbID, Ranking: &ranking}
+}
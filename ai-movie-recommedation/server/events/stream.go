@@ -0,0 +1,145 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const maxRetryDelay = 30 * time.Second
+
+// Start picks the source of events from CATALOG_EVENTS: "change_stream",
+// "local", or "auto" (the default), which uses a change stream when MongoDB
+// supports one and falls back to local events otherwise. A change stream is
+// watched until ctx is done and resumed after errors.
+func Start(ctx context.Context, client *mongo.Client) (string, error) {
+	mode := os.Getenv("CATALOG_EVENTS")
+	switch mode {
+	case "", "auto", SourceChangeStream:
+	case SourceLocal:
+		setSource(SourceLocal)
+		return SourceLocal, nil
+	default:
+		return "", fmt.Errorf("invalid CATALOG_EVENTS %q: want auto, change_stream or local", mode)
+	}
+
+	movies := database.OpenCollection("movies", client)
+	stream, err := watch(ctx, movies, nil)
+	if err != nil {
+		if mode == SourceChangeStream {
+			return "", fmt.Errorf("open change stream: %w", err)
+		}
+		slog.Info("change streams unavailable, publishing catalog events locally", "error", err)
+		setSource(SourceLocal)
+		return SourceLocal, nil
+	}
+
+	setSource(SourceChangeStream)
+	go follow(ctx, movies, stream)
+	return SourceChangeStream, nil
+}
+
+func watch(ctx context.Context, movies *mongo.Collection, resumeToken bson.Raw) (*mongo.ChangeStream, error) {
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: bson.D{
+		{Key: "operationType", Value: bson.D{{Key: "$in", Value: bson.A{"insert", "update"}}}},
+	}}}}
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if resumeToken != nil {
+		opts.SetResumeAfter(resumeToken)
+	}
+	return movies.Watch(ctx, pipeline, opts)
+}
+
+// follow broadcasts the stream's changes until ctx is done. When the stream
+// fails it is reopened after the last change it delivered, so none is lost
+// while the connection is down.
+func follow(ctx context.Context, movies *mongo.Collection, stream *mongo.ChangeStream) {
+	delay := time.Second
+	for {
+		for stream.Next(ctx) {
+			delay = time.Second
+			var c change
+			if err := stream.Decode(&c); err != nil {
+				slog.Error("undecodable catalog change", "error", err)
+				continue
+			}
+			broadcast(c.events()...)
+		}
+		resumeToken := stream.ResumeToken()
+		err := stream.Err()
+		stream.Close(context.Background())
+		if ctx.Err() != nil {
+			return
+		}
+
+		for {
+			slog.Warn("catalog change stream failed, reopening", "error", err, "retry_in", delay)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			delay = min(delay*2, maxRetryDelay)
+			if stream, err = watch(ctx, movies, resumeToken); err == nil {
+				break
+			}
+		}
+	}
+}
+
+// change is the part of a change event that events are built from.
+type change struct {
+	OperationType     string         `bson:"operationType"`
+	ClusterTime       bson.Timestamp `bson:"clusterTime"`
+	FullDocument      *models.Movie  `bson:"fullDocument"`
+	UpdateDescription struct {
+		UpdatedFields bson.Raw `bson:"updatedFields"`
+	} `bson:"updateDescription"`
+}
+
+func (c *change) events() []models.CatalogEvent {
+	// An update is looked up after the fact; a movie deleted since has no
+	// document left to describe.
+	movie := c.FullDocument
+	if movie == nil {
+		return nil
+	}
+
+	var events []models.CatalogEvent
+	switch c.OperationType {
+	case "insert":
+		events = []models.CatalogEvent{{Type: MovieAdded, ImdbID: movie.ImdbID, Movie: movie}}
+	case "update":
+		elements, _ := c.UpdateDescription.UpdatedFields.Elements()
+		review, ranking := false, false
+		for _, element := range elements {
+			switch key := element.Key(); {
+			case key == "admin_review":
+				review = true
+			case key == "ranking" || strings.HasPrefix(key, "ranking."):
+				ranking = true
+			}
+		}
+		if review {
+			events = append(events, ReviewEvent(movie.ImdbID, movie.AdminReview))
+		}
+		if ranking {
+			events = append(events, RankingEvent(movie.ImdbID, movie.Ranking))
+		}
+	}
+
+	at := time.Unix(int64(c.ClusterTime.T), 0).UTC()
+	for i := range events {
+		events[i].At = at
+	}
+	return events
+}
@@ -0,0 +1,221 @@
+// Package experiment runs A/B tests of recommendation strategies on live
+// traffic. An experiment's variants each name a strategy and a weight; users
+// and household profiles are bucketed into a variant by a hash of the
+// experiment name and who they are, every response a variant serves is logged
+// as an exposure, and the results compare what each variant's users went on
+// to save and rate.
+package experiment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/recommend"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+var (
+	// ErrNotFound is returned for an experiment that does not exist.
+	ErrNotFound = errors.New("experiment not found")
+	// ErrAnotherActive is returned when activating an experiment while a
+	// different one is active.
+	ErrAnotherActive = errors.New("another experiment is active")
+)
+
+// ValidationError is returned by Save for an experiment that is not valid.
+type ValidationError struct {
+	Fields []apperrors.FieldError
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid experiment: %d field errors", len(e.Fields))
+}
+
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,49}$`)
+
+func collection(client *mongo.Client) *mongo.Collection {
+	return database.OpenCollection("experiments", client)
+}
+
+// Validate checks what the request's struct tags cannot: the name, that
+// variant names are unique and name known strategies, and that some variant
+// gets traffic.
+func Validate(name string, req models.ExperimentRequest) []apperrors.FieldError {
+	var fieldErrs []apperrors.FieldError
+	add := func(field, rule, message string) {
+		fieldErrs = append(fieldErrs, apperrors.FieldError{Field: field, Rule: rule, Message: message})
+	}
+
+	if !namePattern.MatchString(name) {
+		add("name", "pattern", "must be 1 to 50 lowercase letters, digits, '-' or '_'")
+	}
+	seen := map[string]bool{}
+	total := 0
+	for i, variant := range req.Variants {
+		if seen[variant.Name] {
+			add(fmt.Sprintf("variants[%d].name", i), "unique", "must be unique within the experiment")
+		}
+		seen[variant.Name] = true
+		if !slices.Contains(recommend.Names(), variant.Strategy) {
+			add(fmt.Sprintf("variants[%d].strategy", i), "oneof", fmt.Sprintf("must be one of %v", recommend.Names()))
+		}
+		total += variant.Weight
+	}
+	if total == 0 {
+		add("variants", "weight", "at least one variant must have a weight above 0")
+	}
+	return fieldErrs
+}
+
+// Save creates or replaces the named experiment and reports whether it was
+// created. Changing the variants or weights of a running experiment moves
+// some users to another variant.
+func Save(ctx context.Context, client *mongo.Client, name string, req models.ExperimentRequest) (*models.Experiment, bool, error) {
+	if fieldErrs := Validate(name, req); len(fieldErrs) > 0 {
+		return nil, false, &ValidationError{Fields: fieldErrs}
+	}
+
+	if req.Active {
+		active, err := Active(ctx, client)
+		if err != nil {
+			return nil, false, err
+		}
+		if active != nil && active.Name != name {
+			return nil, false, fmt.Errorf("%w: %s", ErrAnotherActive, active.Name)
+		}
+	}
+
+	// The unique index on active experiments settles concurrent
+	// activations; the check above only gives a clearer error first.
+	now := time.Now().UTC()
+	result, err := collection(client).UpdateOne(ctx,
+		bson.D{{Key: "name", Value: name}},
+		bson.D{
+			{Key: "$set", Value: bson.D{
+				{Key: "description", Value: req.Description},
+				{Key: "active", Value: req.Active},
+				{Key: "variants", Value: req.Variants},
+				{Key: "updated_at", Value: now},
+			}},
+			{Key: "$setOnInsert", Value: bson.D{{Key: "created_at", Value: now}}},
+		},
+		options.UpdateOne().SetUpsert(true))
+	if req.Active && mongo.IsDuplicateKeyError(err) {
+		return nil, false, fmt.Errorf("%w: %w", ErrAnotherActive, err)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	experiment, err := Get(ctx, client, name)
+	if err != nil {
+		return nil, false, err
+	}
+	return experiment, result.UpsertedCount > 0, nil
+}
+
+// Get returns the named experiment, or ErrNotFound.
+func Get(ctx context.Context, client *mongo.Client, name string) (*models.Experiment, error) {
+	var experiment models.Experiment
+	err := collection(client).FindOne(ctx, bson.D{{Key: "name", Value: name}}).Decode(&experiment)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &experiment, nil
+}
+
+// List returns every experiment by name.
+func List(ctx context.Context, client *mongo.Client) ([]models.Experiment, error) {
+	cursor, err := collection(client).Find(ctx, bson.D{}, options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	experiments := []models.Experiment{}
+	if err := cursor.All(ctx, &experiments); err != nil {
+		return nil, err
+	}
+	return experiments, nil
+}
+
+// Active returns the active experiment, or nil when there is none.
+func Active(ctx context.Context, client *mongo.Client) (*models.Experiment, error) {
+	var experiment models.Experiment
+	err := collection(client).FindOne(ctx, bson.D{{Key: "active", Value: true}}).Decode(&experiment)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &experiment, nil
+}
+
+// Assign returns the viewer's variant. It depends only on the experiment's
+// name, variants and weights and who the viewer is, so it needs no stored
+// state. The profiles of a household are bucketed apart.
+func Assign(experiment *models.Experiment, viewer recommend.Viewer) models.ExperimentVariant {
+	total := 0
+	for _, variant := range experiment.Variants {
+		total += variant.Weight
+	}
+	point := int(bucket(experiment.Name, viewerKey(viewer.UserID, viewer.ProfileID)) % uint64(total))
+	for _, variant := range experiment.Variants {
+		if point < variant.Weight {
+			return variant
+		}
+		point -= variant.Weight
+	}
+	// Unreachable for a saved experiment, whose weights add up above 0.
+	return experiment.Variants[0]
+}
+
+// bucket hashes the viewer into the experiment. Salting with the experiment
+// name keeps assignments in different experiments independent.
+func bucket(experimentName, viewer string) uint64 {
+	sum := sha256.Sum256([]byte(experimentName + "/" + viewer))
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
+// viewerKey identifies a viewer for bucketing and results. An account keeps
+// its user ID, so its assignments are the same as before profiles existed.
+func viewerKey(userID, profileID string) string {
+	if profileID == "" {
+		return userID
+	}
+	return userID + "/" + profileID
+}
+
+// sortVariants orders results like the experiment's variants.
+func sortVariants(experiment *models.Experiment, results []models.ExperimentVariantResult) {
+	position := map[string]int{}
+	for i, variant := range experiment.Variants {
+		position[variant.Name] = i
+	}
+	slices.SortStableFunc(results, func(a, b models.ExperimentVariantResult) int {
+		pa, okA := position[a.Variant]
+		pb, okB := position[b.Variant]
+		switch {
+		case okA && okB:
+			return pa - pb
+		case okA:
+			return -1
+		case okB:
+			return 1
+		}
+		return 0
+	})
+}
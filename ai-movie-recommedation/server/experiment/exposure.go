@@ -0,0 +1,255 @@
+package experiment
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/metrics"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/profile"
+	"github.com/princepal9120/ai-movie-recommedation/server/recommend"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// likedScore is the lowest rating that counts as a conversion.
+const likedScore = 4
+
+func exposures(client *mongo.Client) *mongo.Collection {
+	return database.OpenCollection("experiment_exposures", client)
+}
+
+// LogExposure records that the variant served movies to the viewer.
+func LogExposure(ctx context.Context, client *mongo.Client, experiment *models.Experiment, variant models.ExperimentVariant, viewer recommend.Viewer, movies []models.Movie) error {
+	ids := make([]string, len(movies))
+	for i, movie := range movies {
+		ids[i] = movie.ImdbID
+	}
+	metrics.ExperimentExposures.WithLabelValues(experiment.Name, variant.Name).Inc()
+
+	_, err := exposures(client).InsertOne(ctx, models.ExperimentExposure{
+		Experiment: experiment.Name,
+		Variant:    variant.Name,
+		UserID:     viewer.UserID,
+		ProfileID:  viewer.ProfileID,
+		Strategy:   variant.Strategy,
+		Movies:     ids,
+		At:         time.Now().UTC(),
+	})
+	return err
+}
+
+// viewerRef is an account or one of its profiles.
+type viewerRef struct {
+	userID, profileID string
+}
+
+// variantExposures sums one variant's exposures.
+type variantExposures struct {
+	Variant   string `bson:"_id"`
+	Strategy  string `bson:"strategy"`
+	Exposures int    `bson:"exposures"`
+	Users     int    `bson:"users"`
+}
+
+// shownMovie is a movie a variant recommended to a viewer, and when it first
+// did.
+type shownMovie struct {
+	ID struct {
+		Variant   string `bson:"variant"`
+		UserID    string `bson:"user_id"`
+		ProfileID string `bson:"profile_id"`
+		ImdbID    string `bson:"imdb_id"`
+	} `bson:"_id"`
+	At time.Time `bson:"at"`
+}
+
+// shownBatch is how many shown movies are matched against activity at once.
+const shownBatch = 500
+
+// Results compares the experiment's variants by their exposures and the
+// conversions that followed. Exposures are summed per variant in Mongo and
+// the shown movies are read in batches, so the cost of a request does not
+// grow with the number of viewers. Variants since removed from the
+// experiment are reported after the current ones.
+func Results(ctx context.Context, client *mongo.Client, experiment *models.Experiment) (*models.ExperimentResults, error) {
+	byVariant := map[string]*models.ExperimentVariantResult{}
+	for _, variant := range experiment.Variants {
+		byVariant[variant.Name] = &models.ExperimentVariantResult{Variant: variant.Name, Strategy: variant.Strategy, Weight: variant.Weight}
+	}
+
+	cursor, err := exposures(client).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "experiment", Value: experiment.Name}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "variant", Value: "$variant"}, {Key: "user_id", Value: "$user_id"}, {Key: "profile_id", Value: "$profile_id"}}},
+			{Key: "strategy", Value: bson.D{{Key: "$first", Value: "$strategy"}}},
+			{Key: "exposures", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$_id.variant"},
+			{Key: "strategy", Value: bson.D{{Key: "$first", Value: "$strategy"}}},
+			{Key: "exposures", Value: bson.D{{Key: "$sum", Value: "$exposures"}}},
+			{Key: "users", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var summed []variantExposures
+	if err := cursor.All(ctx, &summed); err != nil {
+		return nil, err
+	}
+	for _, sum := range summed {
+		result, ok := byVariant[sum.Variant]
+		if !ok {
+			result = &models.ExperimentVariantResult{Variant: sum.Variant, Strategy: sum.Strategy}
+			byVariant[sum.Variant] = result
+		}
+		result.Exposures = sum.Exposures
+		result.Users = sum.Users
+	}
+
+	if err := countShown(ctx, client, experiment.Name, byVariant); err != nil {
+		return nil, err
+	}
+
+	results := &models.ExperimentResults{Experiment: experiment.Name, Active: experiment.Active, Variants: []models.ExperimentVariantResult{}}
+	for _, result := range byVariant {
+		if result.MoviesShown > 0 {
+			result.ConversionRate = math.Round(float64(result.Conversions)/float64(result.MoviesShown)*1000) / 1000
+		}
+		results.Variants = append(results.Variants, *result)
+	}
+	sortVariants(experiment, results.Variants)
+	return results, nil
+}
+
+// countShown adds up the movies each variant showed and the conversions
+// among them, shownBatch movies at a time.
+func countShown(ctx context.Context, client *mongo.Client, name string, byVariant map[string]*models.ExperimentVariantResult) error {
+	cursor, err := exposures(client).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "experiment", Value: name}}}},
+		{{Key: "$unwind", Value: "$movies"}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "variant", Value: "$variant"}, {Key: "user_id", Value: "$user_id"}, {Key: "profile_id", Value: "$profile_id"}, {Key: "imdb_id", Value: "$movies"}}},
+			{Key: "at", Value: bson.D{{Key: "$min", Value: "$at"}}},
+		}}},
+	}, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	count := func(batch []shownMovie) error {
+		conversions, err := conversionsAfter(ctx, client, batch)
+		if err != nil {
+			return err
+		}
+		for _, movie := range batch {
+			if result, ok := byVariant[movie.ID.Variant]; ok {
+				result.MoviesShown++
+			}
+		}
+		for variant, n := range conversions {
+			if result, ok := byVariant[variant]; ok {
+				result.Conversions += n
+			}
+		}
+		return nil
+	}
+
+	batch := make([]shownMovie, 0, shownBatch)
+	for cursor.Next(ctx) {
+		var movie shownMovie
+		if err := cursor.Decode(&movie); err != nil {
+			return err
+		}
+		batch = append(batch, movie)
+		if len(batch) == shownBatch {
+			if err := count(batch); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+	return count(batch)
+}
+
+// conversionsAfter counts, per variant, the shown movies that the viewer
+// saved or rated at least likedScore after the variant first showed them. A
+// movie both saved and liked converts once. Only the activity of the profile
+// that was shown a movie counts.
+func conversionsAfter(ctx context.Context, client *mongo.Client, batch []shownMovie) (map[string]int, error) {
+	counts := map[string]int{}
+	if len(batch) == 0 {
+		return counts, nil
+	}
+
+	// Each viewer's activity is read for the movies they were shown only.
+	var viewers []viewerRef
+	movies := map[viewerRef]bson.A{}
+	for _, movie := range batch {
+		who := viewerRef{movie.ID.UserID, movie.ID.ProfileID}
+		if _, ok := movies[who]; !ok {
+			viewers = append(viewers, who)
+		}
+		movies[who] = append(movies[who], movie.ID.ImdbID)
+	}
+	anyOf := bson.A{}
+	for _, who := range viewers {
+		anyOf = append(anyOf, append(profile.Filter(who.userID, who.profileID), bson.E{Key: "imdb_id", Value: bson.D{{Key: "$in", Value: movies[who]}}}))
+	}
+	filter := bson.D{{Key: "$or", Value: anyOf}}
+
+	// acted holds when each viewer first saved or liked each movie.
+	type action struct {
+		viewer viewerRef
+		imdbID string
+	}
+	acted := map[action]time.Time{}
+	record := func(userID, profileID, imdbID string, at time.Time) {
+		key := action{viewerRef{userID, profileID}, imdbID}
+		if first, ok := acted[key]; !ok || at.Before(first) {
+			acted[key] = at
+		}
+	}
+
+	cursor, err := database.OpenCollection("watchlist", client).Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var entries []models.WatchlistEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		record(entry.UserID, entry.ProfileID, entry.ImdbID, entry.AddedAt)
+	}
+
+	cursor, err = database.OpenCollection("ratings", client).Find(ctx, append(filter, bson.E{Key: "score", Value: bson.D{{Key: "$gte", Value: likedScore}}}))
+	if err != nil {
+		return nil, err
+	}
+	var ratings []models.Rating
+	if err := cursor.All(ctx, &ratings); err != nil {
+		return nil, err
+	}
+	for _, rating := range ratings {
+		// A rating raised to a like counts from when it was raised.
+		record(rating.UserID, rating.ProfileID, rating.ImdbID, rating.UpdatedAt)
+	}
+
+	for _, movie := range batch {
+		who := viewerRef{movie.ID.UserID, movie.ID.ProfileID}
+		if actedAt, ok := acted[action{who, movie.ID.ImdbID}]; ok && !actedAt.Before(movie.At) {
+			counts[movie.ID.Variant]++
+		}
+	}
+	return counts, nil
+}
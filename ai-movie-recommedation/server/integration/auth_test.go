@@ -0,0 +1,163 @@
+package integration_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/routes"
+	"github.com/princepal9120/ai-movie-recommedation/server/utils"
+)
+
+func TestRegisterLoginRefreshLogout(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	ctx := context.Background()
+
+	session := h.login("ada@example.com", apiclient.RegisterRequestRoleUSER, apiclient.Genre{GenreId: 1, GenreName: "Comedy"})
+
+	movies, err := session.GetRecommendedMoviesWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if movies.StatusCode() != http.StatusOK {
+		t.Fatalf("recommendedmovies after login: status %d: %s", movies.StatusCode(), movies.Body)
+	}
+
+	refreshed, err := session.RefreshTokensWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refreshed.StatusCode() != http.StatusOK {
+		t.Fatalf("refresh: status %d: %s", refreshed.StatusCode(), refreshed.Body)
+	}
+
+	movies, err = session.GetRecommendedMoviesWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if movies.StatusCode() != http.StatusOK {
+		t.Fatalf("recommendedmovies after refresh: status %d: %s", movies.StatusCode(), movies.Body)
+	}
+
+	// The login response carries the user id needed to log out.
+	login, err := session.LoginUserWithResponse(ctx, apiclient.UserLogin{Email: "ada@example.com", Password: "password123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if login.JSON200 == nil || login.JSON200.UserId == nil {
+		t.Fatalf("login response missing user_id: %s", login.Body)
+	}
+
+	logout, err := session.LogoutUserWithResponse(ctx, apiclient.LogoutRequest{UserId: *login.JSON200.UserId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if logout.StatusCode() != http.StatusOK {
+		t.Fatalf("logout: status %d: %s", logout.StatusCode(), logout.Body)
+	}
+
+	movies, err = session.GetRecommendedMoviesWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if movies.StatusCode() != http.StatusUnauthorized {
+		t.Fatalf("recommendedmovies after logout: got status %d, want 401", movies.StatusCode())
+	}
+
+	refreshed, err = session.RefreshTokensWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refreshed.StatusCode() != http.StatusUnauthorized {
+		t.Fatalf("refresh after logout: got status %d, want 401", refreshed.StatusCode())
+	}
+}
+
+func TestRegisterRejectsDuplicateEmail(t *testing.T) {
+	h := newHarness(t)
+	h.login("grace@example.com", apiclient.RegisterRequestRoleUSER)
+
+	resp, err := h.api.RegisterUserWithResponse(context.Background(), apiclient.RegisterRequest{
+		Email:           "grace@example.com",
+		FirstName:       "Grace",
+		LastName:        "Hopper",
+		Password:        "password123",
+		Role:            apiclient.RegisterRequestRoleUSER,
+		FavouriteGenres: []apiclient.Genre{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusConflict {
+		t.Fatalf("got status %d, want 409: %s", resp.StatusCode(), resp.Body)
+	}
+	if code := problemCode(t, resp.Body); code != "user_already_exists" {
+		t.Fatalf("got code %q, want user_already_exists", code)
+	}
+}
+
+func TestLoginRejectsWrongPassword(t *testing.T) {
+	h := newHarness(t)
+	h.login("linus@example.com", apiclient.RegisterRequestRoleUSER)
+
+	resp, err := h.api.LoginUserWithResponse(context.Background(), apiclient.UserLogin{Email: "linus@example.com", Password: "not-the-password"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusUnauthorized {
+		t.Fatalf("got status %d, want 401: %s", resp.StatusCode(), resp.Body)
+	}
+}
+
+func TestProtectedRoutesRequireAuth(t *testing.T) {
+	h := newHarness(t)
+
+	resp, err := h.api.GetMovieWithResponse(context.Background(), "tt0000001", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusUnauthorized {
+		t.Fatalf("got status %d, want 401", resp.StatusCode())
+	}
+	if ct := resp.HTTPResponse.Header.Get("Content-Type"); ct != "application/problem+json" {
+		t.Fatalf("got content type %q, want application/problem+json", ct)
+	}
+}
+
+func TestPanicsRenderProblems(t *testing.T) {
+	// Routes added after NewRouter sit behind the auth middleware.
+	router := routes.NewRouter(client, []string{"http://localhost:8080"})
+	router.GET("/panics", func(c *gin.Context) { panic("boom") })
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	token, _, err := utils.GenerateAllTokens("user@example.com", "Test", "User", "USER", "u1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/panics", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.AddCookie(&http.Cookie{Name: "access_token", Value: token})
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusInternalServerError || resp.Header.Get("Content-Type") != "application/problem+json" {
+		t.Fatalf("got status %d with %q: %s, want a 500 problem", resp.StatusCode, resp.Header.Get("Content-Type"), body)
+	}
+	if code := problemCode(t, body); code != "internal_error" {
+		t.Fatalf("got code %q, want internal_error", code)
+	}
+}
@@ -0,0 +1,156 @@
+package integration_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/respcache"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestMoviesRevalidateWithETag(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	h.insert("movies", reviewedMovie("tt0000001", "", models.Ranking{RankingValue: 2, RankingName: "Good"}))
+	ctx := context.Background()
+
+	first, err := h.api.GetMoviesWithResponse(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	etag := first.HTTPResponse.Header.Get("ETag")
+	if first.StatusCode() != http.StatusOK || etag == "" {
+		t.Fatalf("movies: status %d, ETag %q", first.StatusCode(), etag)
+	}
+	if got := first.HTTPResponse.Header.Get("Cache-Control"); got != "public, max-age=30" {
+		t.Fatalf("got Cache-Control %q, want public, max-age=30", got)
+	}
+	if got := first.HTTPResponse.Header.Get("X-Cache"); got != "MISS" {
+		t.Fatalf("first request: got X-Cache %q, want MISS", got)
+	}
+
+	second, err := h.api.GetMoviesWithResponse(ctx, &apiclient.GetMoviesParams{IfNoneMatch: &etag})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.StatusCode() != http.StatusNotModified || len(second.Body) != 0 {
+		t.Fatalf("revalidation: status %d: %s, want an empty 304", second.StatusCode(), second.Body)
+	}
+	if got := second.HTTPResponse.Header.Get("X-Cache"); got != "HIT" {
+		t.Fatalf("revalidation: got X-Cache %q, want HIT", got)
+	}
+
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	added, err := admin.AddMovieWithResponse(ctx, movie("tt0245429", "Spirited Away", 999, "Not_Ranked", fantasy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added.StatusCode() != http.StatusCreated {
+		t.Fatalf("addmovie: status %d: %s", added.StatusCode(), added.Body)
+	}
+
+	third, err := h.api.GetMoviesWithResponse(ctx, &apiclient.GetMoviesParams{IfNoneMatch: &etag})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third.StatusCode() != http.StatusOK || third.JSON200 == nil || len(*third.JSON200) != 2 {
+		t.Fatalf("after addmovie: status %d: %s, want both movies", third.StatusCode(), third.Body)
+	}
+	if third.HTTPResponse.Header.Get("ETag") == etag {
+		t.Fatal("ETag did not change with the body")
+	}
+}
+
+func TestMovieCacheInvalidatedByReviewUpdate(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	h.insert("movies", reviewedMovie("tt0110912", "", models.Ranking{RankingValue: 999, RankingName: "Not_Ranked"}))
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	ctx := context.Background()
+
+	got, err := admin.GetMovieWithResponse(ctx, "tt0110912", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.JSON200 == nil {
+		t.Fatalf("movie: status %d: %s", got.StatusCode(), got.Body)
+	}
+	if cc := got.HTTPResponse.Header.Get("Cache-Control"); cc != "private, max-age=30" {
+		t.Fatalf("got Cache-Control %q, want private, max-age=30", cc)
+	}
+
+	// A write that bypasses the API is not seen until the cache is invalidated.
+	_, err = database.OpenCollection("movies", client).UpdateOne(ctx,
+		bson.D{{Key: "imdb_id", Value: "tt0110912"}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "title", Value: "Changed"}}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cached, err := admin.GetMovieWithResponse(ctx, "tt0110912", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached.JSON200 == nil || cached.JSON200.Title == "Changed" {
+		t.Fatalf("movie: status %d: %s, want the cached body", cached.StatusCode(), cached.Body)
+	}
+
+	resp, err := admin.UpdateAdminReviewWithResponse(ctx, "tt0110912", apiclient.AdminReviewRequest{AdminReview: "A good film."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusAccepted {
+		t.Fatalf("updatereview: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	fresh, err := admin.GetMovieWithResponse(ctx, "tt0110912", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fresh.JSON200 == nil || fresh.JSON200.AdminReview == nil || *fresh.JSON200.AdminReview != "A good film." || fresh.JSON200.Title != "Changed" {
+		t.Fatalf("movie after review update: status %d: %s", fresh.StatusCode(), fresh.Body)
+	}
+	waitForReview(t, admin, "tt0110912")
+}
+
+func TestGenresCacheDisabled(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	t.Cleanup(respcache.SetTTL(0))
+	ctx := context.Background()
+
+	first, err := h.api.GetGenresWithResponse(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	etag := first.HTTPResponse.Header.Get("ETag")
+	if first.JSON200 == nil || etag == "" {
+		t.Fatalf("genres: status %d: %s", first.StatusCode(), first.Body)
+	}
+	if cc := first.HTTPResponse.Header.Get("Cache-Control"); cc != "public, no-cache" {
+		t.Fatalf("got Cache-Control %q, want public, no-cache", cc)
+	}
+
+	// Nothing is stored, but an unchanged body still revalidates.
+	weak := "W/" + etag
+	second, err := h.api.GetGenresWithResponse(ctx, &apiclient.GetGenresParams{IfNoneMatch: &weak})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.StatusCode() != http.StatusNotModified || second.HTTPResponse.Header.Get("X-Cache") != "MISS" {
+		t.Fatalf("revalidation: status %d, X-Cache %q, want a 304 miss", second.StatusCode(), second.HTTPResponse.Header.Get("X-Cache"))
+	}
+
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	createGenre(t, admin, apiclient.GenreRequest{GenreName: "Horror"})
+	third, err := h.api.GetGenresWithResponse(ctx, &apiclient.GetGenresParams{IfNoneMatch: &etag})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third.JSON200 == nil || len(*third.JSON200) != 4 {
+		t.Fatalf("genres after create: status %d: %s, want 4 genres", third.StatusCode(), third.Body)
+	}
+}
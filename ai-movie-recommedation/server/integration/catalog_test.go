@@ -0,0 +1,215 @@
+package integration_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+)
+
+const importCSV = `imdb_id,title,poster_path,youtube_id,genre,admin_review,ranking_value,ranking_name
+tt0245429,Spirited Away,https://image.tmdb.org/t/p/w500/a.jpg,ByXuk9QqQkk,4:Fantasy|7:Action,Timeless.,1,Excellent
+tt0119698,Princess Mononoke,https://image.tmdb.org/t/p/w500/b.jpg,4OiMOHRDs14,4:Fantasy,,999,Not_Ranked
+tt0000000,X,not-a-url,,,,,
+tt0096283,My Neighbor Totoro,https://image.tmdb.org/t/p/w500/c.jpg,92a7Hj0ijLs,Family,,2,Good
+`
+
+// seedImportGenres inserts the genres importCSV refers to.
+func seedImportGenres(h *harness) {
+	h.insert("genres", models.Genre{GenreID: 4, GenreName: "Fantasy"}, models.Genre{GenreID: 7, GenreName: "Action"})
+}
+
+func TestImportCSVReportsRowErrors(t *testing.T) {
+	h := newHarness(t)
+	seedImportGenres(h)
+	ctx := context.Background()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	resp, err := admin.ImportMoviesWithBodyWithResponse(ctx, &apiclient.ImportMoviesParams{}, "text/csv", strings.NewReader(importCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		t.Fatalf("import: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	report := resp.JSON200
+	if report.Total != 4 || report.Inserted != 2 || report.Failed != 2 {
+		t.Fatalf("got report %s, want 2 inserted and 2 failed of 4", resp.Body)
+	}
+	if report.Errors[0].Row != 3 || report.Errors[1].Row != 4 {
+		t.Fatalf("got row errors %+v, want rows 3 and 4", report.Errors)
+	}
+
+	movie, err := admin.GetMovieWithResponse(ctx, "tt0245429", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if movie.JSON200 == nil || len(movie.JSON200.Genre) != 2 || movie.JSON200.Ranking.RankingName != "Excellent" {
+		t.Fatalf("imported movie: status %d: %s", movie.StatusCode(), movie.Body)
+	}
+}
+
+func TestImportJSONUpserts(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	ctx := context.Background()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	first := []apiclient.Movie{
+		movie("tt0000001", "First Title", 1, "Excellent", comedy),
+		movie("tt0000002", "Second", 2, "Good", drama),
+	}
+	resp, err := admin.ImportMoviesWithResponse(ctx, &apiclient.ImportMoviesParams{}, first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Inserted != 2 {
+		t.Fatalf("first import: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	second := []apiclient.Movie{
+		movie("tt0000001", "Renamed Title", 1, "Excellent", comedy),
+		movie("tt0000002", "Second", 2, "Good", drama),
+		movie("tt0000003", "Third", 3, "Okay", fantasy),
+	}
+	dryRun := true
+	resp, err = admin.ImportMoviesWithResponse(ctx, &apiclient.ImportMoviesParams{DryRun: &dryRun}, second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil || !resp.JSON200.DryRun || resp.JSON200.Inserted != 1 || resp.JSON200.Updated != 2 {
+		t.Fatalf("dry run: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	resp, err = admin.ImportMoviesWithResponse(ctx, &apiclient.ImportMoviesParams{}, second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := resp.JSON200
+	if report == nil || report.Inserted != 1 || report.Updated != 1 || report.Unchanged != 1 {
+		t.Fatalf("second import: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	list, err := h.api.GetMoviesWithResponse(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.JSON200 == nil || len(*list.JSON200) != 3 {
+		t.Fatalf("movies: %s", list.Body)
+	}
+}
+
+func TestImportKeepsOrRanksReviews(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	ctx := context.Background()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	imported := movie("tt0000001", "Reviewed", 2, "Good", comedy)
+	importOne := func(review string) *apiclient.ImportReport {
+		t.Helper()
+		imported.AdminReview = &review
+		resp, err := admin.ImportMoviesWithResponse(ctx, &apiclient.ImportMoviesParams{}, []apiclient.Movie{imported})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.JSON200 == nil || resp.JSON200.Failed != 0 {
+			t.Fatalf("import %q: status %d: %s", review, resp.StatusCode(), resp.Body)
+		}
+		return resp.JSON200
+	}
+
+	importOne("An excellent film.")
+	if report := importOne(""); report.Unchanged != 1 {
+		t.Fatalf("import without a review: got %+v, want the movie unchanged", report)
+	}
+	got, err := admin.GetMovieWithResponse(ctx, "tt0000001", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.JSON200 == nil || got.JSON200.AdminReview == nil || *got.JSON200.AdminReview != "An excellent film." {
+		t.Fatalf("after an empty review: status %d: %s, want the stored review kept", got.StatusCode(), got.Body)
+	}
+	status, err := admin.GetReviewStatusWithResponse(ctx, "tt0000001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.StatusCode() != http.StatusNotFound {
+		t.Fatalf("review status before a change: got status %d: %s, want 404", status.StatusCode(), status.Body)
+	}
+
+	importOne("A terrible film.")
+	if job := waitForReview(t, admin, "tt0000001"); job.Ranking == nil || job.Ranking.RankingName != "Terrible" {
+		t.Fatalf("after a changed review: got job %+v, want ranking Terrible", job)
+	}
+}
+
+func TestImportRejectsMalformedBody(t *testing.T) {
+	h := newHarness(t)
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	resp, err := admin.ImportMoviesWithBodyWithResponse(context.Background(), &apiclient.ImportMoviesParams{}, "application/json", strings.NewReader(`[{"imdb_id": `))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusBadRequest {
+		t.Fatalf("got status %d, want 400: %s", resp.StatusCode(), resp.Body)
+	}
+}
+
+func TestExportRoundTripsThroughImport(t *testing.T) {
+	h := newHarness(t)
+	seedImportGenres(h)
+	ctx := context.Background()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	resp, err := admin.ImportMoviesWithBodyWithResponse(ctx, &apiclient.ImportMoviesParams{}, "text/csv", strings.NewReader(importCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Inserted != 2 {
+		t.Fatalf("import: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	for _, format := range []apiclient.ExportMoviesParamsFormat{apiclient.ExportMoviesParamsFormatCsv, apiclient.ExportMoviesParamsFormatJson} {
+		exported, err := admin.ExportMoviesWithResponse(ctx, &apiclient.ExportMoviesParams{Format: &format})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exported.StatusCode() != http.StatusOK {
+			t.Fatalf("export %s: status %d: %s", format, exported.StatusCode(), exported.Body)
+		}
+		if !strings.Contains(string(exported.Body), "tt0119698") || strings.Contains(string(exported.Body), `"_id"`) {
+			t.Fatalf("export %s: got %s", format, exported.Body)
+		}
+
+		contentType := "text/csv"
+		if format == apiclient.ExportMoviesParamsFormatJson {
+			contentType = "application/json"
+		}
+		reimported, err := admin.ImportMoviesWithBodyWithResponse(ctx, &apiclient.ImportMoviesParams{}, contentType, strings.NewReader(string(exported.Body)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reimported.JSON200 == nil || reimported.JSON200.Unchanged != 2 || reimported.JSON200.Failed != 0 {
+			t.Fatalf("reimport %s: status %d: %s", format, reimported.StatusCode(), reimported.Body)
+		}
+	}
+}
+
+func TestCatalogEndpointsRequireAdmin(t *testing.T) {
+	h := newHarness(t)
+	session := h.login("user@example.com", apiclient.RegisterRequestRoleUSER)
+
+	resp, err := session.ExportMoviesWithResponse(context.Background(), &apiclient.ExportMoviesParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusForbidden {
+		t.Fatalf("got status %d, want 403", resp.StatusCode())
+	}
+}
@@ -0,0 +1,233 @@
+package integration_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/llm"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+)
+
+type sseEvent struct {
+	name string
+	data string
+}
+
+// readEvents splits a server-sent event stream into its events.
+func readEvents(t *testing.T, body []byte) []sseEvent {
+	t.Helper()
+	var events []sseEvent
+	var current sseEvent
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if current.name != "" {
+				events = append(events, current)
+			}
+			current = sseEvent{}
+		case strings.HasPrefix(line, "event:"):
+			current.name = strings.TrimPrefix(line, "event:")
+		case strings.HasPrefix(line, "data:"):
+			current.data += strings.TrimPrefix(line, "data:")
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return events
+}
+
+func summarizedMovie(imdbID string, rankingValue int, genre models.Genre, moods, warnings []string) models.Movie {
+	movie := reviewedMovie(imdbID, "", models.Ranking{RankingValue: rankingValue, RankingName: "Ranked"})
+	movie.Genre = []models.Genre{genre}
+	if moods != nil {
+		movie.Summary = &models.Summary{
+			Synopsis:        "A film.",
+			MoodTags:        moods,
+			ContentWarnings: warnings,
+			Prompt:          models.PromptRef{Name: "movie_summary", Version: 1},
+			GeneratedAt:     time.Now().UTC(),
+		}
+	}
+	return movie
+}
+
+func TestChatRecommendationsStreamsMatches(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	fantasyGenre := models.Genre{GenreID: 3, GenreName: "Fantasy"}
+	h.insert("movies",
+		summarizedMovie("tt0000001", 2, fantasyGenre, []string{"uplifting"}, []string{}),
+		summarizedMovie("tt0000002", 1, fantasyGenre, []string{"uplifting", "dark"}, []string{"violence"}),
+		summarizedMovie("tt0000003", 1, fantasyGenre, nil, nil),
+		summarizedMovie("tt0000004", 1, models.Genre{GenreID: 1, GenreName: "Comedy"}, []string{"uplifting"}, []string{}),
+		summarizedMovie("tt0000005", 1, fantasyGenre, []string{"uplifting"}, []string{}),
+	)
+	fake := llm.NewFake()
+	t.Cleanup(llm.Use(fake))
+	user := h.login("user@example.com", apiclient.RegisterRequestRoleUSER)
+
+	resp, err := user.ChatRecommendationsWithResponse(context.Background(), apiclient.ChatRequest{
+		Message: "Something light and fantastical for a family night",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("chat: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	if ct := resp.HTTPResponse.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/event-stream") {
+		t.Fatalf("got content type %q, want text/event-stream", ct)
+	}
+
+	events := readEvents(t, resp.Body)
+	var names []string
+	for _, event := range events {
+		names = append(names, event.name)
+	}
+	if want := []string{"filters", "message", "movie", "movie", "done"}; !slices.Equal(names, want) {
+		t.Fatalf("got events %q, want %q", names, want)
+	}
+
+	var filters apiclient.ChatFilters
+	if err := json.Unmarshal([]byte(events[0].data), &filters); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(filters.Genres, []string{"Fantasy"}) || !slices.Equal(filters.MoodTags, []string{"uplifting"}) || !slices.Equal(filters.ExcludeContentWarnings, []string{"violence"}) {
+		t.Fatalf("got filters %+v", filters)
+	}
+	if events[1].data != "Here is what I found for you." {
+		t.Fatalf("got message %q", events[1].data)
+	}
+
+	var got []string
+	for _, event := range events[2:4] {
+		var movie apiclient.Movie
+		if err := json.Unmarshal([]byte(event.data), &movie); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, movie.ImdbId)
+	}
+	if want := []string{"tt0000005", "tt0000001"}; !slices.Equal(got, want) {
+		t.Fatalf("got movies %q, want %q: best ranked first, without violence, unsummarized or other genres", got, want)
+	}
+	if events[4].data != `{"count":2}` {
+		t.Fatalf("got done %q", events[4].data)
+	}
+
+	prompts := fake.Prompts()
+	if len(prompts) != 1 || !strings.Contains(prompts[0], "Known genres: Comedy, Drama, Fantasy\nKnown mood tags: dark, uplifting\nKnown content warnings: violence\n") {
+		t.Fatalf("got prompts %q, want the catalog vocabulary", prompts)
+	}
+}
+
+func TestChatRecommendationsRespectsLimit(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	drama := models.Genre{GenreID: 2, GenreName: "Drama"}
+	h.insert("movies",
+		summarizedMovie("tt0000001", 1, drama, nil, nil),
+		summarizedMovie("tt0000002", 2, drama, nil, nil),
+		summarizedMovie("tt0000003", 3, drama, nil, nil),
+	)
+	user := h.login("user@example.com", apiclient.RegisterRequestRoleUSER)
+
+	limit := 2
+	resp, err := user.ChatRecommendationsWithResponse(context.Background(), apiclient.ChatRequest{Message: "A drama please", Limit: &limit})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := readEvents(t, resp.Body)
+	if len(events) == 0 || events[len(events)-1].data != `{"count":2}` {
+		t.Fatalf("got events %+v, want two movies", events)
+	}
+}
+
+func TestChatRecommendationsRespectProfileMaturity(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	drama := models.Genre{GenreID: 2, GenreName: "Drama"}
+	movies := []models.Movie{
+		summarizedMovie("tt0000001", 1, drama, nil, nil),
+		summarizedMovie("tt0000002", 2, drama, nil, nil),
+		summarizedMovie("tt0000003", 3, drama, nil, nil),
+		summarizedMovie("tt0000004", 4, drama, nil, nil),
+	}
+	movies[0].Maturity = models.MaturityAdult
+	movies[1].Maturity = models.MaturityKids
+	movies[3].Maturity = models.MaturityTeen
+	for _, movie := range movies {
+		h.insert("movies", movie)
+	}
+	household := h.login("family@example.com", apiclient.RegisterRequestRoleUSER)
+	kids := createProfile(t, household, "Kids", apiclient.ProfileRequestMaturityKids)
+	if kids.JSON201 == nil {
+		t.Fatalf("create kids profile: status %d: %s", kids.StatusCode(), kids.Body)
+	}
+	selectProfile(t, household, kids.JSON201.ProfileId)
+
+	resp, err := household.ChatRecommendationsWithResponse(context.Background(), apiclient.ChatRequest{Message: "A drama please"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("chat: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	var got []string
+	for _, event := range readEvents(t, resp.Body) {
+		if event.name != "movie" {
+			continue
+		}
+		var movie apiclient.Movie
+		if err := json.Unmarshal([]byte(event.data), &movie); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, movie.ImdbId)
+	}
+	if want := []string{"tt0000002"}; !slices.Equal(got, want) {
+		t.Fatalf("got movies %q, want only the kids drama %q", got, want)
+	}
+}
+
+func TestChatRecommendationsRejectsBadInput(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	t.Cleanup(llm.Use(&llm.Fake{Respond: func(string) string { return "I would suggest Spirited Away!" }}))
+	ctx := context.Background()
+
+	resp, err := h.api.ChatRecommendationsWithResponse(ctx, apiclient.ChatRequest{Message: "Anything good?"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusUnauthorized {
+		t.Fatalf("anonymous: got status %d, want 401", resp.StatusCode())
+	}
+
+	user := h.login("user@example.com", apiclient.RegisterRequestRoleUSER)
+
+	resp, err = user.ChatRecommendationsWithResponse(ctx, apiclient.ChatRequest{Message: ""})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusBadRequest || problemCode(t, resp.Body) != "validation_failed" {
+		t.Fatalf("empty message: got status %d: %s, want 400 validation_failed", resp.StatusCode(), resp.Body)
+	}
+
+	resp, err = user.ChatRecommendationsWithResponse(ctx, apiclient.ChatRequest{Message: "Anything good?"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusBadGateway || problemCode(t, resp.Body) != "llm_unavailable" {
+		t.Fatalf("unparseable answer: got status %d: %s, want 502 llm_unavailable", resp.StatusCode(), resp.Body)
+	}
+}
@@ -0,0 +1,209 @@
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/enrichment"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func useFixtureMetadata(t *testing.T) {
+	t.Helper()
+	t.Cleanup(enrichment.Use(enrichment.NewFixtureProvider()))
+}
+
+func TestAddMovieEnrichesMetadata(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	useFixtureMetadata(t)
+	ctx := context.Background()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	added, err := admin.AddMovieWithResponse(ctx, movie("tt0245429", "Spirited Away", 999, "Not_Ranked", fantasy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added.StatusCode() != http.StatusCreated {
+		t.Fatalf("addmovie: status %d: %s", added.StatusCode(), added.Body)
+	}
+
+	got, err := admin.GetMovieWithResponse(ctx, "tt0245429", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.JSON200 == nil || got.JSON200.Metadata == nil {
+		t.Fatalf("movie has no metadata: %s", got.Body)
+	}
+	metadata := got.JSON200.Metadata
+	if metadata.Source != "file" || metadata.Director == nil || *metadata.Director != "Hayao Miyazaki" || metadata.ReleaseYear == nil || *metadata.ReleaseYear != 2001 {
+		t.Fatalf("unexpected metadata: %s", got.Body)
+	}
+}
+
+func TestAddMovieSucceedsWithoutMetadata(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	useFixtureMetadata(t)
+	ctx := context.Background()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	added, err := admin.AddMovieWithResponse(ctx, movie("tt0000001", "Unknown Indie", 999, "Not_Ranked", drama))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added.StatusCode() != http.StatusCreated {
+		t.Fatalf("addmovie: status %d: %s", added.StatusCode(), added.Body)
+	}
+
+	got, err := admin.GetMovieWithResponse(ctx, "tt0000001", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.JSON200 == nil || got.JSON200.Metadata == nil || got.JSON200.Metadata.Director != nil {
+		t.Fatalf("want metadata with only source and enriched_at: %s", got.Body)
+	}
+}
+
+func TestEnrichMovieEndpoint(t *testing.T) {
+	h := newHarness(t)
+	useFixtureMetadata(t)
+	ctx := context.Background()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	h.insert("movies",
+		models.Movie{ImdbID: "tt0096283", Title: "My Neighbor Totoro"},
+		models.Movie{ImdbID: "tt0000001", Title: "Unknown Indie"},
+	)
+
+	resp, err := admin.EnrichMovieWithResponse(ctx, "tt0096283")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil || resp.JSON200.RuntimeMinutes == nil || *resp.JSON200.RuntimeMinutes != 86 {
+		t.Fatalf("enrich: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	for imdbID, code := range map[string]string{"tt0000001": "metadata_not_found", "tt9999999": "movie_not_found"} {
+		resp, err := admin.EnrichMovieWithResponse(ctx, imdbID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusNotFound {
+			t.Fatalf("enrich %s: got status %d, want 404", imdbID, resp.StatusCode())
+		}
+		if got := problemCode(t, resp.Body); got != code {
+			t.Fatalf("enrich %s: got code %q, want %q", imdbID, got, code)
+		}
+	}
+}
+
+func TestRefresherEnrichesMissingAndStaleMetadata(t *testing.T) {
+	h := newHarness(t)
+	ctx := context.Background()
+
+	fresh := time.Now().UTC().Truncate(time.Millisecond)
+	h.insert("movies",
+		models.Movie{ImdbID: "tt0245429", Title: "Spirited Away"},
+		models.Movie{ImdbID: "tt0119698", Title: "Princess Mononoke", Metadata: &models.Metadata{Source: "file", EnrichedAt: fresh.Add(-60 * 24 * time.Hour)}},
+		models.Movie{ImdbID: "tt0096283", Title: "My Neighbor Totoro", Metadata: &models.Metadata{Source: "file", Director: "Someone Else", EnrichedAt: fresh}},
+	)
+
+	movies := database.OpenCollection("movies", client)
+	refresher := &enrichment.Refresher{
+		Movies:    movies,
+		Provider:  enrichment.NewFixtureProvider(),
+		Interval:  time.Hour,
+		MaxAge:    30 * 24 * time.Hour,
+		BatchSize: 10,
+	}
+
+	refreshed, failed, err := refresher.RefreshOnce(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refreshed != 2 || failed != 0 {
+		t.Fatalf("got refreshed=%d failed=%d, want 2 and 0", refreshed, failed)
+	}
+
+	for imdbID, director := range map[string]string{"tt0245429": "Hayao Miyazaki", "tt0119698": "Hayao Miyazaki", "tt0096283": "Someone Else"} {
+		var got models.Movie
+		if err := movies.FindOne(ctx, bson.D{{Key: "imdb_id", Value: imdbID}}).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Metadata == nil || got.Metadata.Director != director {
+			t.Errorf("%s: got metadata %+v, want director %q", imdbID, got.Metadata, director)
+		}
+	}
+
+	refreshed, _, err = refresher.RefreshOnce(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refreshed != 0 {
+		t.Fatalf("second run refreshed %d movies, want 0", refreshed)
+	}
+}
+
+// flakyProvider fails every lookup of the movies in failing and defers the
+// rest to the fixture.
+type flakyProvider struct {
+	failing map[string]bool
+}
+
+func (p flakyProvider) Name() string { return "flaky" }
+
+func (p flakyProvider) Lookup(ctx context.Context, imdbID string) (*models.Metadata, error) {
+	if p.failing[imdbID] {
+		return nil, errors.New("upstream unavailable")
+	}
+	return enrichment.NewFixtureProvider().Lookup(ctx, imdbID)
+}
+
+func TestRefresherMovesPastFailingLookups(t *testing.T) {
+	h := newHarness(t)
+	ctx := context.Background()
+	h.insert("movies",
+		models.Movie{ImdbID: "tt0245429", Title: "Spirited Away"},
+		models.Movie{ImdbID: "tt0119698", Title: "Princess Mononoke"},
+	)
+
+	movies := database.OpenCollection("movies", client)
+	refresher := &enrichment.Refresher{
+		Movies:     movies,
+		Provider:   flakyProvider{failing: map[string]bool{"tt0245429": true}},
+		Interval:   time.Hour,
+		MaxAge:     30 * 24 * time.Hour,
+		BatchSize:  1,
+		RetryAfter: time.Hour,
+	}
+
+	// One movie per run: whichever comes first, the other gets its turn
+	// next, and a failed movie is not tried again before RetryAfter.
+	var refreshed, failed int
+	for run := 0; run < 3; run++ {
+		r, f, err := refresher.RefreshOnce(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		refreshed += r
+		failed += f
+	}
+	if refreshed != 1 || failed != 1 {
+		t.Fatalf("got refreshed=%d failed=%d over three runs, want 1 and 1", refreshed, failed)
+	}
+
+	var got models.Movie
+	if err := movies.FindOne(ctx, bson.D{{Key: "imdb_id", Value: "tt0245429"}}).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Metadata == nil || got.Metadata.AttemptedAt.IsZero() || !got.Metadata.EnrichedAt.IsZero() {
+		t.Fatalf("failing movie: got metadata %+v, want only the attempt recorded", got.Metadata)
+	}
+}
@@ -0,0 +1,152 @@
+package integration_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+)
+
+// subscribe opens the catalog event stream and returns its events as they
+// arrive. The stream is closed when the test ends.
+func subscribe(t *testing.T, h *harness) <-chan sseEvent {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	resp, err := h.api.StreamCatalogEvents(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("events: status %d, content type %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+
+	stream := make(chan sseEvent)
+	go func() {
+		defer resp.Body.Close()
+		defer close(stream)
+		var current sseEvent
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "" && current.name != "":
+				select {
+				case stream <- current:
+				case <-ctx.Done():
+					return
+				}
+				current = sseEvent{}
+			case strings.HasPrefix(line, "event:"):
+				current.name = strings.TrimPrefix(line, "event:")
+			case strings.HasPrefix(line, "data:"):
+				current.data += strings.TrimPrefix(line, "data:")
+			}
+		}
+	}()
+	return stream
+}
+
+// nextEvent waits for the next event on stream and checks its type and movie.
+func nextEvent(t *testing.T, stream <-chan sseEvent, eventType, imdbID string) apiclient.CatalogEvent {
+	t.Helper()
+	select {
+	case event, ok := <-stream:
+		if !ok {
+			t.Fatalf("stream closed, want %s for %s", eventType, imdbID)
+		}
+		var got apiclient.CatalogEvent
+		if err := json.Unmarshal([]byte(event.data), &got); err != nil {
+			t.Fatal(err)
+		}
+		if event.name != eventType || string(got.Type) != eventType || got.ImdbId != imdbID {
+			t.Fatalf("got %s %s, want %s for %s", event.name, event.data, eventType, imdbID)
+		}
+		return got
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no event, want %s for %s", eventType, imdbID)
+	}
+	return apiclient.CatalogEvent{}
+}
+
+func TestCatalogEventsForMovieAndReview(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	stream := subscribe(t, h)
+	ctx := context.Background()
+
+	added, err := admin.AddMovieWithResponse(ctx, movie("tt0110912", "Pulp Fiction", 999, "Not_Ranked", drama))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added.StatusCode() != http.StatusCreated {
+		t.Fatalf("addmovie: status %d: %s", added.StatusCode(), added.Body)
+	}
+	if got := nextEvent(t, stream, "movie-added", "tt0110912"); got.Movie == nil || got.Movie.Title != "Pulp Fiction" {
+		t.Fatalf("movie-added: got movie %+v", got.Movie)
+	}
+
+	resp, err := admin.UpdateAdminReviewWithResponse(ctx, "tt0110912", apiclient.AdminReviewRequest{AdminReview: "A good film."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusAccepted {
+		t.Fatalf("updatereview: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	if got := nextEvent(t, stream, "review-updated", "tt0110912"); got.AdminReview == nil || *got.AdminReview != "A good film." {
+		t.Fatalf("review-updated: got review %v", got.AdminReview)
+	}
+	if got := nextEvent(t, stream, "ranking-changed", "tt0110912"); got.Ranking == nil || got.Ranking.RankingName != "Good" {
+		t.Fatalf("ranking-changed: got ranking %+v", got.Ranking)
+	}
+}
+
+func TestCatalogEventsForImportAndScale(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	seedImportGenres(h)
+	existing := reviewedMovie("tt0119698", "Old.", models.Ranking{RankingValue: 999, RankingName: "Not_Ranked"})
+	existing.Genre = []models.Genre{{GenreID: 4, GenreName: "Fantasy"}}
+	h.insert("movies", existing)
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	stream := subscribe(t, h)
+	ctx := context.Background()
+
+	imported, err := admin.ImportMoviesWithBodyWithResponse(ctx, &apiclient.ImportMoviesParams{}, "text/csv", strings.NewReader(importCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported.JSON200 == nil {
+		t.Fatalf("import: status %d: %s", imported.StatusCode(), imported.Body)
+	}
+	// The new movie is added. The existing one keeps its review, as its row
+	// has none, and rows that failed validation produce nothing.
+	nextEvent(t, stream, "movie-added", "tt0245429")
+
+	scale, err := admin.ReplaceRankingsWithResponse(ctx, apiclient.RankingScaleRequest{Rankings: []apiclient.RankingTierRequest{
+		tier(1, "Excellent"),
+		tier(2, "Good"),
+		tier(3, "Okay"),
+		tier(4, "Bad"),
+		tier(5, "Terrible"),
+		unrankedTier(1000, "Unrated", "Not_Ranked"),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scale.JSON200 == nil {
+		t.Fatalf("replace rankings: status %d: %s", scale.StatusCode(), scale.Body)
+	}
+	// tt0245429 keeps Excellent, so only the unranked movie moves.
+	if got := nextEvent(t, stream, "ranking-changed", "tt0119698"); got.Ranking == nil || got.Ranking.RankingName != "Unrated" {
+		t.Fatalf("ranking-changed: got ranking %+v", got.Ranking)
+	}
+}
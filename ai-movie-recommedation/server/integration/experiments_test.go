@@ -0,0 +1,225 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func variant(name string, strategy apiclient.ExperimentVariantStrategy, weight int) apiclient.ExperimentVariant {
+	return apiclient.ExperimentVariant{Name: name, Strategy: strategy, Weight: weight}
+}
+
+func saveExperiment(t *testing.T, admin *apiclient.ClientWithResponses, name string, active bool, variants ...apiclient.ExperimentVariant) *apiclient.SaveExperimentResponse {
+	t.Helper()
+	resp, err := admin.SaveExperimentWithResponse(context.Background(), name, apiclient.ExperimentRequest{Active: &active, Variants: variants})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+// recommendedWithExperiment returns the recommended movie IDs and the
+// X-Experiment header.
+func recommendedWithExperiment(t *testing.T, session *apiclient.ClientWithResponses) ([]string, string) {
+	t.Helper()
+	resp, err := session.GetRecommendedMoviesWithResponse(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil {
+		t.Fatalf("recommendedmovies: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	var ids []string
+	for _, m := range *resp.JSON200 {
+		ids = append(ids, m.ImdbId)
+	}
+	return ids, resp.HTTPResponse.Header.Get("X-Experiment")
+}
+
+func TestExperimentServesVariantsAndReportsResults(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	ctx := context.Background()
+	h.addMovies(admin,
+		movie("tt0000001", "Excellent Comedy", 1, "Excellent", comedy),
+		movie("tt0000002", "Good Comedy", 2, "Good", comedy),
+		movie("tt0000003", "Excellent Drama", 1, "Excellent", drama),
+	)
+	viewer := h.login("viewer@example.com", apiclient.RegisterRequestRoleUSER, comedy)
+
+	if got, header := recommendedWithExperiment(t, viewer); fmt.Sprint(got) != "[tt0000001 tt0000002]" || header != "" {
+		t.Fatalf("without an experiment: got %v with header %q", got, header)
+	}
+
+	// All traffic to control first, then all to treatment.
+	if resp := saveExperiment(t, admin, "newest-vs-genres", true, variant("control", "top_picks", 100), variant("treatment", "newest", 0)); resp.StatusCode() != http.StatusCreated {
+		t.Fatalf("create experiment: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	if got, header := recommendedWithExperiment(t, viewer); fmt.Sprint(got) != "[tt0000001 tt0000002]" || header != "newest-vs-genres/control" {
+		t.Fatalf("control: got %v with header %q", got, header)
+	}
+
+	if resp := saveExperiment(t, admin, "newest-vs-genres", true, variant("control", "top_picks", 0), variant("treatment", "newest", 100)); resp.StatusCode() != http.StatusOK {
+		t.Fatalf("replace experiment: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	got, header := recommendedWithExperiment(t, viewer)
+	if fmt.Sprint(got) != "[tt0000003 tt0000002 tt0000001]" || header != "newest-vs-genres/treatment" {
+		t.Fatalf("treatment: got %v with header %q", got, header)
+	}
+	if resp, err := viewer.AddToWatchlistWithResponse(ctx, "tt0000003"); err != nil || resp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("watchlist add: %v %v", resp, err)
+	}
+
+	results, err := admin.GetExperimentResultsWithResponse(ctx, "newest-vs-genres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results.JSON200 == nil || len(results.JSON200.Variants) != 2 {
+		t.Fatalf("results: status %d: %s", results.StatusCode(), results.Body)
+	}
+	control, treatment := results.JSON200.Variants[0], results.JSON200.Variants[1]
+	if control.Variant != "control" || control.Users != 1 || control.Exposures != 1 || control.MoviesShown != 2 || control.Conversions != 0 {
+		t.Fatalf("got control %+v, want one user shown two movies without conversions", control)
+	}
+	if treatment.Variant != "treatment" || treatment.Users != 1 || treatment.MoviesShown != 3 || treatment.Conversions != 1 || treatment.ConversionRate != 0.333 {
+		t.Fatalf("got treatment %+v, want one user converting one of three movies", treatment)
+	}
+
+	// With a real split a user keeps their variant.
+	saveExperiment(t, admin, "newest-vs-genres", true, variant("control", "top_picks", 50), variant("treatment", "newest", 50))
+	_, first := recommendedWithExperiment(t, viewer)
+	for range 3 {
+		if _, again := recommendedWithExperiment(t, viewer); again != first {
+			t.Fatalf("got variant %q, then %q", first, again)
+		}
+	}
+}
+
+func TestExperimentValidation(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	user := h.login("user@example.com", apiclient.RegisterRequestRoleUSER)
+	ctx := context.Background()
+
+	valid := []apiclient.ExperimentVariant{variant("control", "top_picks", 50), variant("treatment", "popular", 50)}
+	if resp := saveExperiment(t, user, "first", true, valid...); resp.StatusCode() != http.StatusForbidden {
+		t.Fatalf("save as user: got status %d, want 403", resp.StatusCode())
+	}
+
+	for _, tc := range []struct {
+		name     string
+		variants []apiclient.ExperimentVariant
+		field    string
+	}{
+		{"first", []apiclient.ExperimentVariant{variant("control", "top_picks", 50), variant("treatment", "collaborative", 50)}, "variants[1].strategy"},
+		{"first", []apiclient.ExperimentVariant{variant("control", "top_picks", 50), variant("control", "popular", 50)}, "variants[1].name"},
+		{"first", []apiclient.ExperimentVariant{variant("control", "top_picks", 0), variant("treatment", "popular", 0)}, "variants"},
+		{"First", valid, "name"},
+		{"first", valid[:1], "variants"},
+	} {
+		resp := saveExperiment(t, admin, tc.name, true, tc.variants...)
+		if resp.StatusCode() != http.StatusBadRequest || problemField(t, resp.Body) != tc.field {
+			t.Errorf("%s %+v: got status %d: %s, want 400 on %s", tc.name, tc.variants, resp.StatusCode(), resp.Body, tc.field)
+		}
+	}
+
+	if resp := saveExperiment(t, admin, "first", true, valid...); resp.StatusCode() != http.StatusCreated {
+		t.Fatalf("create: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	conflict := saveExperiment(t, admin, "second", true, valid...)
+	if conflict.StatusCode() != http.StatusConflict || problemCode(t, conflict.Body) != "experiment_active" {
+		t.Fatalf("second active: got status %d: %s, want 409 experiment_active", conflict.StatusCode(), conflict.Body)
+	}
+	if resp := saveExperiment(t, admin, "second", false, valid...); resp.StatusCode() != http.StatusCreated {
+		t.Fatalf("create inactive: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	list, err := admin.ListExperimentsWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.JSON200 == nil || len(*list.JSON200) != 2 || !(*list.JSON200)[0].Active || (*list.JSON200)[1].Active {
+		t.Fatalf("list: status %d: %s, want first active and second not", list.StatusCode(), list.Body)
+	}
+
+	missing, err := admin.GetExperimentResultsWithResponse(ctx, "third")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if missing.StatusCode() != http.StatusNotFound || problemCode(t, missing.Body) != "experiment_not_found" {
+		t.Fatalf("results of unknown: got status %d: %s, want 404", missing.StatusCode(), missing.Body)
+	}
+}
+
+func TestExperimentResultsKeepProfilesApart(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	ctx := context.Background()
+	h.addMovies(admin, movie("tt0000001", "Excellent Comedy", 1, "Excellent", comedy))
+	saveExperiment(t, admin, "newest-only", true, variant("treatment", "newest", 100), variant("control", "top_picks", 0))
+
+	household := h.login("family@example.com", apiclient.RegisterRequestRoleUSER)
+	var profiles []string
+	for _, name := range []string{"Sam", "Alex"} {
+		resp := createProfile(t, household, name, apiclient.ProfileRequestMaturityAdult)
+		if resp.JSON201 == nil {
+			t.Fatalf("create profile %s: status %d: %s", name, resp.StatusCode(), resp.Body)
+		}
+		profiles = append(profiles, resp.JSON201.ProfileId)
+	}
+
+	// Sam is shown the movie, Alex saves it without having been shown it.
+	selectProfile(t, household, profiles[0])
+	if got, header := recommendedWithExperiment(t, household); fmt.Sprint(got) != "[tt0000001]" || header != "newest-only/treatment" {
+		t.Fatalf("sam: got %v with header %q", got, header)
+	}
+	selectProfile(t, household, profiles[1])
+	if resp, err := household.AddToWatchlistWithResponse(ctx, "tt0000001"); err != nil || resp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("alex watchlist add: %v %v", resp, err)
+	}
+
+	results, err := admin.GetExperimentResultsWithResponse(ctx, "newest-only")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results.JSON200 == nil || len(results.JSON200.Variants) != 2 {
+		t.Fatalf("results: status %d: %s", results.StatusCode(), results.Body)
+	}
+	if treatment := results.JSON200.Variants[0]; treatment.Users != 1 || treatment.MoviesShown != 1 || treatment.Conversions != 0 {
+		t.Fatalf("got treatment %+v, want Alex's save not to convert Sam's exposure", treatment)
+	}
+
+	// Alex is shown it after saving it, and Sam saves it after being shown
+	// it: both profiles count as users and only Sam's save converts.
+	var saved models.WatchlistEntry
+	if err := database.OpenCollection("watchlist", client).FindOne(ctx, bson.D{{Key: "profile_id", Value: profiles[1]}}).Decode(&saved); err != nil {
+		t.Fatal(err)
+	}
+	var shown models.ExperimentExposure
+	if err := database.OpenCollection("experiment_exposures", client).FindOne(ctx, bson.D{{Key: "profile_id", Value: profiles[0]}}).Decode(&shown); err != nil {
+		t.Fatal(err)
+	}
+	alexShown := shown
+	alexShown.ProfileID, alexShown.At = profiles[1], saved.AddedAt.Add(time.Minute)
+	h.insert("experiment_exposures", alexShown)
+	h.insert("watchlist", models.WatchlistEntry{UserID: saved.UserID, ProfileID: profiles[0], ImdbID: "tt0000001", AddedAt: shown.At.Add(time.Minute)})
+
+	results, err = admin.GetExperimentResultsWithResponse(ctx, "newest-only")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if treatment := results.JSON200.Variants[0]; treatment.Users != 2 || treatment.MoviesShown != 2 || treatment.Conversions != 1 {
+		t.Fatalf("got treatment %+v, want two profiles with Sam's save converting", treatment)
+	}
+}
@@ -0,0 +1,101 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+)
+
+// feedRows fetches the session's feed and returns the movie IDs of each row,
+// with the row IDs in order.
+func feedRows(t *testing.T, session *apiclient.ClientWithResponses) ([]string, map[string][]string) {
+	t.Helper()
+	resp, err := session.GetFeedWithResponse(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil {
+		t.Fatalf("feed: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	var order []string
+	rows := map[string][]string{}
+	for _, row := range resp.JSON200.Rows {
+		order = append(order, row.Id)
+		for _, m := range row.Movies {
+			rows[row.Id] = append(rows[row.Id], m.ImdbId)
+		}
+	}
+	return order, rows
+}
+
+func seedFeedMovies(t *testing.T, h *harness) {
+	t.Helper()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	h.addMovies(admin,
+		movie("tt0000001", "Excellent Comedy", 1, "Excellent", comedy),
+		movie("tt0000002", "Good Fantasy", 2, "Good", fantasy),
+		movie("tt0000003", "Okay Comedy", 3, "Okay", comedy),
+		movie("tt0000004", "Bad Fantasy", 4, "Bad", fantasy),
+		movie("tt0000005", "Excellent Drama", 1, "Excellent", drama),
+		movie("tt0000006", "Good Drama", 2, "Good", drama),
+	)
+}
+
+func TestFeedRowsAreDeduplicated(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	seedFeedMovies(t, h)
+	t.Setenv("FEED_ROW_LIMITS", "top_picks=2,genre=2,trending=2,new=2")
+	session := h.login("viewer@example.com", apiclient.RegisterRequestRoleUSER, comedy, fantasy)
+
+	// tt0000001 trends too, but is already a top pick.
+	for _, imdbID := range []string{"tt0000001", "tt0000005"} {
+		if resp, err := session.GetMovieWithResponse(context.Background(), imdbID, nil); err != nil || resp.StatusCode() != http.StatusOK {
+			t.Fatalf("movie %s: %v %v", imdbID, resp, err)
+		}
+	}
+
+	order, rows := feedRows(t, session)
+	want := map[string][]string{
+		"top_picks":     {"tt0000001", "tt0000002"},
+		"genre:Comedy":  {"tt0000003"},
+		"genre:Fantasy": {"tt0000004"},
+		"trending":      {"tt0000005"},
+		"new":           {"tt0000006"},
+	}
+	wantOrder := []string{"top_picks", "genre:Comedy", "genre:Fantasy", "trending", "new"}
+	if fmt.Sprint(order) != fmt.Sprint(wantOrder) || fmt.Sprint(rows) != fmt.Sprint(want) {
+		t.Fatalf("got rows %v %v, want %v %v", order, rows, wantOrder, want)
+	}
+}
+
+func TestFeedRowLimits(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	seedFeedMovies(t, h)
+
+	// Without favourite genres the top picks are the best ranked movies, and
+	// only the rows shared by everyone follow.
+	newcomer := h.login("newcomer@example.com", apiclient.RegisterRequestRoleUSER)
+	order, rows := feedRows(t, newcomer)
+	if fmt.Sprint(order) != "[top_picks new]" || len(rows["top_picks"]) != 5 || fmt.Sprint(rows["new"]) != "[tt0000004]" {
+		t.Fatalf("got rows %v %v, want five popular top picks and tt0000004 in new", order, rows)
+	}
+
+	t.Setenv("FEED_ROW_LIMITS", "top_picks=0,genre=1,genre_rows=1,new=0")
+	fan := h.login("fan@example.com", apiclient.RegisterRequestRoleUSER, fantasy, comedy)
+	order, rows = feedRows(t, fan)
+	if fmt.Sprint(order) != "[genre:Fantasy]" || fmt.Sprint(rows["genre:Fantasy"]) != "[tt0000002]" {
+		t.Fatalf("got rows %v %v, want only genre:Fantasy with tt0000002", order, rows)
+	}
+
+	// An invalid setting falls back to the defaults rather than failing.
+	t.Setenv("FEED_ROW_LIMITS", "top_picks=many")
+	order, _ = feedRows(t, fan)
+	if fmt.Sprint(order) != "[top_picks new]" {
+		t.Fatalf("got rows %v, want top_picks and new", order)
+	}
+}
@@ -0,0 +1,281 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func createGenre(t *testing.T, admin *apiclient.ClientWithResponses, req apiclient.GenreRequest) apiclient.Genre {
+	t.Helper()
+	resp, err := admin.CreateGenreWithResponse(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON201 == nil {
+		t.Fatalf("create genre %s: status %d: %s", req.GenreName, resp.StatusCode(), resp.Body)
+	}
+	if location := resp.HTTPResponse.Header.Get("Location"); location != "/genres" {
+		t.Fatalf("create genre %s: got Location %q, want /genres", req.GenreName, location)
+	}
+	return *resp.JSON201
+}
+
+func TestCreateGenresAndSubGenres(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	ctx := context.Background()
+
+	user := h.login("user@example.com", apiclient.RegisterRequestRoleUSER)
+	forbidden, err := user.CreateGenreWithResponse(ctx, apiclient.GenreRequest{GenreName: "Noir"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forbidden.StatusCode() != http.StatusForbidden {
+		t.Fatalf("create as user: got status %d, want 403", forbidden.StatusCode())
+	}
+
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	dramaID := 2
+	noir := createGenre(t, admin, apiclient.GenreRequest{GenreName: "Noir", ParentId: &dramaID})
+	if noir.GenreId != 4 || noir.ParentId == nil || *noir.ParentId != dramaID {
+		t.Fatalf("got %+v, want genre 4 under Drama", noir)
+	}
+	neoNoir := createGenre(t, admin, apiclient.GenreRequest{GenreName: "Neo-noir", ParentId: &noir.GenreId})
+
+	genreID := 1
+	duplicate, err := admin.CreateGenreWithResponse(ctx, apiclient.GenreRequest{GenreId: &genreID, GenreName: "Comedy again"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if duplicate.StatusCode() != http.StatusConflict || problemCode(t, duplicate.Body) != "genre_already_exists" {
+		t.Fatalf("duplicate id: got status %d: %s, want 409 genre_already_exists", duplicate.StatusCode(), duplicate.Body)
+	}
+
+	unknownParent := 99
+	orphan, err := admin.CreateGenreWithResponse(ctx, apiclient.GenreRequest{GenreName: "Orphan", ParentId: &unknownParent})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if orphan.StatusCode() != http.StatusBadRequest || problemCode(t, orphan.Body) != "invalid_genre_parent" {
+		t.Fatalf("unknown parent: got status %d: %s, want 400 invalid_genre_parent", orphan.StatusCode(), orphan.Body)
+	}
+
+	cycle, err := admin.UpdateGenreWithResponse(ctx, dramaID, apiclient.GenreUpdateRequest{GenreName: "Drama", ParentId: &neoNoir.GenreId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cycle.StatusCode() != http.StatusBadRequest || problemCode(t, cycle.Body) != "invalid_genre_parent" {
+		t.Fatalf("cycle: got status %d: %s, want 400 invalid_genre_parent", cycle.StatusCode(), cycle.Body)
+	}
+
+	moved, err := admin.UpdateGenreWithResponse(ctx, neoNoir.GenreId, apiclient.GenreUpdateRequest{GenreName: "Neo-noir"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moved.JSON200 == nil || moved.JSON200.Genre.ParentId != nil || moved.JSON200.Job != nil {
+		t.Fatalf("move to top level: status %d: %s, want no parent and no cascade job", moved.StatusCode(), moved.Body)
+	}
+
+	missing, err := admin.UpdateGenreWithResponse(ctx, 99, apiclient.GenreUpdateRequest{GenreName: "Missing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if missing.StatusCode() != http.StatusNotFound || problemCode(t, missing.Body) != "genre_not_found" {
+		t.Fatalf("unknown genre: got status %d: %s, want 404 genre_not_found", missing.StatusCode(), missing.Body)
+	}
+
+	genres, err := h.api.GetGenresWithResponse(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if genres.JSON200 == nil || len(*genres.JSON200) != 5 {
+		t.Fatalf("genres: %s", genres.Body)
+	}
+}
+
+func TestGenreRenameCascadesToMoviesUsersAndProfiles(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	h.insert("movies",
+		summarizedMovie("tt0000001", 1, models.Genre{GenreID: 2, GenreName: "Drama"}, nil, nil),
+		summarizedMovie("tt0000002", 2, models.Genre{GenreID: 1, GenreName: "Comedy"}, nil, nil),
+	)
+	fanSession := h.login("fan@example.com", apiclient.RegisterRequestRoleUSER, drama)
+	member := createProfile(t, fanSession, "Member", apiclient.ProfileRequestMaturityAdult, drama)
+	if member.JSON201 == nil {
+		t.Fatalf("create profile: status %d: %s", member.StatusCode(), member.Body)
+	}
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	ctx := context.Background()
+
+	resp, err := admin.UpdateGenreWithResponse(ctx, 2, apiclient.GenreUpdateRequest{GenreName: "Dramatic"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Genre.GenreName != "Dramatic" || resp.JSON200.Job == nil {
+		t.Fatalf("rename: status %d: %s, want the renamed genre and a cascade job", resp.StatusCode(), resp.Body)
+	}
+
+	job := waitForJob(t, admin, resp.JSON200.Job.JobId)
+	if job.Status != apiclient.JobStatusSucceeded || job.Type != apiclient.JobTypeGenreCascade || job.Updated == nil || *job.Updated != 3 {
+		t.Fatalf("cascade job: got %+v, want a succeeded genre_cascade job that updated one movie, one user and one profile", job)
+	}
+
+	movie, err := admin.GetMovieWithResponse(ctx, "tt0000001", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if movie.JSON200 == nil || movie.JSON200.Genre[0].GenreName != "Dramatic" {
+		t.Fatalf("movie after rename: %s", movie.Body)
+	}
+
+	var fan models.User
+	err = database.OpenCollection("users", client).FindOne(ctx, bson.D{{Key: "email", Value: "fan@example.com"}}).Decode(&fan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fan.FavouriteGenres) != 1 || fan.FavouriteGenres[0].GenreName != "Dramatic" {
+		t.Fatalf("user favourite genres after rename: %+v", fan.FavouriteGenres)
+	}
+
+	// The profile still gets its top picks under the new name.
+	selectProfile(t, fanSession, member.JSON201.ProfileId)
+	if got := recommended(t, fanSession); fmt.Sprint(got) != "[tt0000001]" {
+		t.Fatalf("profile recommendations after rename: got %v, want the drama", got)
+	}
+}
+
+func TestDeleteGenreInUse(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	h.insert("movies", summarizedMovie("tt0000001", 1, models.Genre{GenreID: 3, GenreName: "Fantasy"}, nil, nil))
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	ctx := context.Background()
+
+	inUse, err := admin.DeleteGenreWithResponse(ctx, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inUse.StatusCode() != http.StatusConflict || problemCode(t, inUse.Body) != "genre_in_use" || !strings.Contains(string(inUse.Body), "1 movies") {
+		t.Fatalf("delete in use: got status %d: %s, want 409 genre_in_use naming one movie", inUse.StatusCode(), inUse.Body)
+	}
+
+	household := h.login("family@example.com", apiclient.RegisterRequestRoleUSER)
+	if resp := createProfile(t, household, "Member", apiclient.ProfileRequestMaturityAdult, drama); resp.JSON201 == nil {
+		t.Fatalf("create profile: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	byProfile, err := admin.DeleteGenreWithResponse(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if byProfile.StatusCode() != http.StatusConflict || !strings.Contains(string(byProfile.Body), "1 profiles") {
+		t.Fatalf("delete a profile's genre: got status %d: %s, want 409 naming one profile", byProfile.StatusCode(), byProfile.Body)
+	}
+
+	deleted, err := admin.DeleteGenreWithResponse(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.StatusCode() != http.StatusNoContent {
+		t.Fatalf("delete unused: got status %d: %s, want 204", deleted.StatusCode(), deleted.Body)
+	}
+
+	again, err := admin.DeleteGenreWithResponse(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.StatusCode() != http.StatusNotFound || problemCode(t, again.Body) != "genre_not_found" {
+		t.Fatalf("delete twice: got status %d: %s, want 404 genre_not_found", again.StatusCode(), again.Body)
+	}
+}
+
+func TestUnknownGenreReferencesAreRejected(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	ctx := context.Background()
+	unknown := apiclient.Genre{GenreId: 42, GenreName: "Made Up"}
+
+	register, err := h.api.RegisterUserWithResponse(ctx, apiclient.RegisterRequest{
+		FirstName:       "Grace",
+		LastName:        "Hopper",
+		Email:           "grace@example.com",
+		Password:        "password123",
+		Role:            apiclient.RegisterRequestRoleUSER,
+		FavouriteGenres: []apiclient.Genre{comedy, unknown},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if field := problemField(t, register.Body); register.StatusCode() != http.StatusBadRequest || field != "favourite_genres[1].genre_id" {
+		t.Fatalf("register: got status %d: %s, want 400 on favourite_genres[1].genre_id", register.StatusCode(), register.Body)
+	}
+
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	added, err := admin.AddMovieWithResponse(ctx, movie("tt0000001", "Mystery", 999, "Not_Ranked", unknown))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if field := problemField(t, added.Body); added.StatusCode() != http.StatusBadRequest || field != "genre[0].genre_id" {
+		t.Fatalf("addmovie: got status %d: %s, want 400 on genre[0].genre_id", added.StatusCode(), added.Body)
+	}
+
+	// The name comes from the genres collection, not the request.
+	stale := apiclient.Genre{GenreId: 1, GenreName: "Komedy"}
+	added, err = admin.AddMovieWithResponse(ctx, movie("tt0000002", "Laughs", 999, "Not_Ranked", stale))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added.StatusCode() != http.StatusCreated {
+		t.Fatalf("addmovie: status %d: %s", added.StatusCode(), added.Body)
+	}
+	got, err := admin.GetMovieWithResponse(ctx, "tt0000002", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.JSON200 == nil || got.JSON200.Genre[0].GenreName != "Comedy" {
+		t.Fatalf("movie: %s, want the genre named Comedy", got.Body)
+	}
+}
+
+func TestRecommendationsIncludeSubGenres(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	dramaID := 2
+	h.insert("genres", models.Genre{GenreID: 4, GenreName: "Noir", ParentID: &dramaID})
+	h.insert("movies",
+		summarizedMovie("tt0000001", 1, models.Genre{GenreID: 4, GenreName: "Noir"}, nil, nil),
+		summarizedMovie("tt0000002", 2, models.Genre{GenreID: 1, GenreName: "Comedy"}, nil, nil),
+	)
+	user := h.login("fan@example.com", apiclient.RegisterRequestRoleUSER, drama)
+
+	resp, err := user.GetRecommendedMoviesWithResponse(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) != 1 || (*resp.JSON200)[0].ImdbId != "tt0000001" {
+		t.Fatalf("recommendations: status %d: %s, want the Noir movie for a Drama fan", resp.StatusCode(), resp.Body)
+	}
+}
+
+// problemField returns the field of the first per-field error in a problem
+// response.
+func problemField(t *testing.T, body []byte) string {
+	t.Helper()
+	var problem apiclient.Problem
+	if err := json.Unmarshal(body, &problem); err != nil {
+		t.Fatalf("decoding problem %s: %v", body, err)
+	}
+	if problem.Errors == nil || len(*problem.Errors) == 0 {
+		return ""
+	}
+	return (*problem.Errors)[0].Field
+}
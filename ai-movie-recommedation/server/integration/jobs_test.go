@@ -0,0 +1,291 @@
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/jobs"
+	"github.com/princepal9120/ai-movie-recommedation/server/llm"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/tmc/langchaingo/llms"
+)
+
+// flakyModel fails its first failures calls and then answers like the fake.
+type flakyModel struct {
+	*llm.Fake
+
+	mu       sync.Mutex
+	failures int
+}
+
+func (m *flakyModel) GenerateContent(ctx context.Context, messages []llms.MessageContent, options ...llms.CallOption) (*llms.ContentResponse, error) {
+	m.mu.Lock()
+	fail := m.failures > 0
+	if fail {
+		m.failures--
+	}
+	m.mu.Unlock()
+
+	if fail {
+		return nil, errors.New("upstream timeout")
+	}
+	return m.Fake.GenerateContent(ctx, messages, options...)
+}
+
+func (m *flakyModel) Call(ctx context.Context, prompt string, options ...llms.CallOption) (string, error) {
+	return llms.GenerateFromSinglePrompt(ctx, m, prompt, options...)
+}
+
+// gatedModel holds its first call until release is closed.
+type gatedModel struct {
+	*llm.Fake
+
+	once    sync.Once
+	started chan struct{}
+	release chan struct{}
+}
+
+func (m *gatedModel) GenerateContent(ctx context.Context, messages []llms.MessageContent, options ...llms.CallOption) (*llms.ContentResponse, error) {
+	first := false
+	m.once.Do(func() { first = true })
+	if first {
+		close(m.started)
+		<-m.release
+	}
+	return m.Fake.GenerateContent(ctx, messages, options...)
+}
+
+func (m *gatedModel) Call(ctx context.Context, prompt string, options ...llms.CallOption) (string, error) {
+	return llms.GenerateFromSinglePrompt(ctx, m, prompt, options...)
+}
+
+func waitForJob(t *testing.T, session *apiclient.ClientWithResponses, jobID string) apiclient.Job {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		resp, err := session.GetJobWithResponse(context.Background(), jobID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.JSON200 == nil {
+			t.Fatalf("job %s: status %d: %s", jobID, resp.StatusCode(), resp.Body)
+		}
+		switch resp.JSON200.Status {
+		case apiclient.JobStatusSucceeded, apiclient.JobStatusFailed, apiclient.JobStatusSuperseded:
+			return *resp.JSON200
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job %s: still %s after 5s", jobID, resp.JSON200.Status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func reviewedMovie(imdbID, review string, ranking models.Ranking) models.Movie {
+	return models.Movie{
+		ImdbID:      imdbID,
+		Title:       "Movie " + imdbID,
+		PosterPath:  "https://image.tmdb.org/t/p/w500/" + imdbID + ".jpg",
+		YouTubeID:   "yt-" + imdbID,
+		Genre:       []models.Genre{{GenreID: 2, GenreName: "Drama"}},
+		AdminReview: review,
+		Ranking:     ranking,
+	}
+}
+
+func TestReviewRankingRetriesLLMFailures(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	h.insert("movies", reviewedMovie("tt0110912", "", models.Ranking{RankingValue: 999, RankingName: "Not_Ranked"}))
+	t.Cleanup(llm.Use(&flakyModel{Fake: llm.NewFake(), failures: 2}))
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	resp, err := admin.UpdateAdminReviewWithResponse(context.Background(), "tt0110912", apiclient.AdminReviewRequest{AdminReview: "A good film."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusAccepted {
+		t.Fatalf("updatereview: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	job := waitForReview(t, admin, "tt0110912")
+	if job.Status != apiclient.JobStatusSucceeded || job.Attempts != 3 {
+		t.Fatalf("got status %s after %d attempts, want succeeded after 3", job.Status, job.Attempts)
+	}
+	if job.Ranking == nil || job.Ranking.RankingName != "Good" {
+		t.Fatalf("got ranking %+v, want Good", job.Ranking)
+	}
+}
+
+func TestReviewRankingGivesUpAfterMaxAttempts(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	h.insert("movies", reviewedMovie("tt0110912", "", models.Ranking{RankingValue: 999, RankingName: "Not_Ranked"}))
+	t.Cleanup(llm.Use(&flakyModel{Fake: llm.NewFake(), failures: 1000}))
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	if _, err := admin.UpdateAdminReviewWithResponse(context.Background(), "tt0110912", apiclient.AdminReviewRequest{AdminReview: "A good film."}); err != nil {
+		t.Fatal(err)
+	}
+
+	job := waitForReview(t, admin, "tt0110912")
+	if job.Status != apiclient.JobStatusFailed || job.Attempts != jobs.MaxAttempts {
+		t.Fatalf("got status %s after %d attempts, want failed after %d", job.Status, job.Attempts, jobs.MaxAttempts)
+	}
+	if job.LastError == nil || *job.LastError != "upstream timeout" {
+		t.Fatalf("got last_error %v, want upstream timeout", job.LastError)
+	}
+
+	got, err := admin.GetMovieWithResponse(context.Background(), "tt0110912", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.JSON200 == nil || got.JSON200.Ranking.RankingName != "Not_Ranked" {
+		t.Fatalf("movie: got %s, want ranking left as Not_Ranked", got.Body)
+	}
+}
+
+func TestNewerReviewSupersedesRunningJob(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	h.insert("movies", reviewedMovie("tt0110912", "", models.Ranking{RankingValue: 999, RankingName: "Not_Ranked"}))
+	model := &gatedModel{Fake: llm.NewFake(), started: make(chan struct{}), release: make(chan struct{})}
+	t.Cleanup(llm.Use(model))
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	ctx := context.Background()
+
+	first, err := admin.UpdateAdminReviewWithResponse(ctx, "tt0110912", apiclient.AdminReviewRequest{AdminReview: "An excellent film."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.JSON202 == nil {
+		t.Fatalf("first review: status %d: %s", first.StatusCode(), first.Body)
+	}
+	select {
+	case <-model.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("first review job never started")
+	}
+
+	second, err := admin.UpdateAdminReviewWithResponse(ctx, "tt0110912", apiclient.AdminReviewRequest{AdminReview: "A terrible film."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.JSON202 == nil {
+		t.Fatalf("second review: status %d: %s", second.StatusCode(), second.Body)
+	}
+	close(model.release)
+
+	latest := waitForReview(t, admin, "tt0110912")
+	if latest.JobId != second.JSON202.JobId || latest.Status != apiclient.JobStatusSucceeded {
+		t.Fatalf("latest job: got %+v, want %s succeeded", latest, second.JSON202.JobId)
+	}
+	if stale := waitForJob(t, admin, first.JSON202.JobId); stale.Status != apiclient.JobStatusSuperseded {
+		t.Fatalf("first job: got status %s, want superseded", stale.Status)
+	}
+
+	got, err := admin.GetMovieWithResponse(ctx, "tt0110912", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (apiclient.Ranking{RankingValue: 5, RankingName: "Terrible"}); got.JSON200 == nil || got.JSON200.Ranking != want {
+		t.Fatalf("movie: got %s, want ranking %+v", got.Body, want)
+	}
+}
+
+func TestRerankMovies(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	stale := models.Ranking{RankingValue: 3, RankingName: "Okay"}
+	h.insert("movies",
+		reviewedMovie("tt0110912", "An excellent film.", stale),
+		reviewedMovie("tt0068646", "A terrible film.", stale),
+		reviewedMovie("tt0245429", "", models.Ranking{RankingValue: 999, RankingName: "Not_Ranked"}),
+	)
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	ctx := context.Background()
+
+	resp, err := admin.RerankMoviesWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusAccepted || resp.JSON202 == nil {
+		t.Fatalf("rerank: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	job := waitForJob(t, admin, resp.JSON202.JobId)
+	if job.Status != apiclient.JobStatusSucceeded || job.Enqueued == nil || *job.Enqueued != 2 {
+		t.Fatalf("rerank job: got %+v, want succeeded with 2 enqueued", job)
+	}
+
+	for imdbID, want := range map[string]string{"tt0110912": "Excellent", "tt0068646": "Terrible"} {
+		if job := waitForReview(t, admin, imdbID); job.Ranking == nil || job.Ranking.RankingName != want {
+			t.Errorf("%s: got job %+v, want ranking %s", imdbID, job, want)
+		}
+	}
+
+	status, err := admin.GetReviewStatusWithResponse(ctx, "tt0245429")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.StatusCode() != http.StatusNotFound || problemCode(t, status.Body) != "job_not_found" {
+		t.Fatalf("unreviewed movie: status %d: %s", status.StatusCode(), status.Body)
+	}
+}
+
+func TestRankingsChangeQueuesRerank(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		job, err := jobs.CheckRankings(ctx, client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job != nil {
+			t.Fatalf("check %d: queued %+v for unchanged rankings", i+1, job)
+		}
+	}
+
+	h.insert("rankings", models.Ranking{RankingValue: 6, RankingName: "Unwatchable"})
+
+	job, err := jobs.CheckRankings(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job == nil || job.Type != jobs.TypeRerankAll {
+		t.Fatalf("got %+v, want a rerank_all job", job)
+	}
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	if done := waitForJob(t, admin, job.ID.Hex()); done.Status != apiclient.JobStatusSucceeded {
+		t.Fatalf("rerank job: got status %s, want succeeded", done.Status)
+	}
+
+	again, err := jobs.CheckRankings(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != nil {
+		t.Fatalf("second check after the change queued %+v", again)
+	}
+}
+
+func TestReviewStatusRequiresAdmin(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	session := h.login("user@example.com", apiclient.RegisterRequestRoleUSER)
+
+	resp, err := session.GetReviewStatusWithResponse(context.Background(), "tt0110912")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusForbidden {
+		t.Fatalf("got status %d, want 403", resp.StatusCode())
+	}
+}
@@ -0,0 +1,108 @@
+package integration_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/llm"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+)
+
+func usage(t *testing.T, admin *apiclient.ClientWithResponses) apiclient.LLMUsage {
+	t.Helper()
+	resp, err := admin.GetLLMUsageWithResponse(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil {
+		t.Fatalf("llm usage: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	return *resp.JSON200
+}
+
+func TestIdenticalReviewsHitCache(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	unranked := models.Ranking{RankingValue: 999, RankingName: "Not_Ranked"}
+	h.insert("movies", reviewedMovie("tt0110912", "", unranked), reviewedMovie("tt0068646", "", unranked))
+	fake := llm.NewFake()
+	t.Cleanup(llm.Use(fake))
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	for _, imdbID := range []string{"tt0110912", "tt0068646"} {
+		resp, err := admin.UpdateAdminReviewWithResponse(context.Background(), imdbID, apiclient.AdminReviewRequest{AdminReview: "An excellent film."})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusAccepted {
+			t.Fatalf("updatereview %s: status %d: %s", imdbID, resp.StatusCode(), resp.Body)
+		}
+		if job := waitForReview(t, admin, imdbID); job.Ranking == nil || job.Ranking.RankingName != "Excellent" {
+			t.Fatalf("%s: got job %+v, want ranking Excellent", imdbID, job)
+		}
+	}
+
+	if prompts := fake.Prompts(); len(prompts) != 1 {
+		t.Fatalf("model was called %d times, want 1", len(prompts))
+	}
+	if got := usage(t, admin); got.Calls != 1 || got.CacheHits != 1 {
+		t.Fatalf("usage: got %d calls and %d cache hits, want 1 and 1", got.Calls, got.CacheHits)
+	}
+}
+
+func TestBudgetExceededDefersRanking(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	unranked := models.Ranking{RankingValue: 999, RankingName: "Not_Ranked"}
+	h.insert("movies", reviewedMovie("tt0110912", "", unranked), reviewedMovie("tt0068646", "", unranked))
+	t.Setenv("LLM_DAILY_TOKEN_BUDGET", "1")
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	ctx := context.Background()
+
+	if _, err := admin.UpdateAdminReviewWithResponse(ctx, "tt0110912", apiclient.AdminReviewRequest{AdminReview: "A good film."}); err != nil {
+		t.Fatal(err)
+	}
+	if job := waitForReview(t, admin, "tt0110912"); job.Status != apiclient.JobStatusSucceeded {
+		t.Fatalf("first review: got %+v, want succeeded", job)
+	}
+
+	if _, err := admin.UpdateAdminReviewWithResponse(ctx, "tt0068646", apiclient.AdminReviewRequest{AdminReview: "A bad film."}); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	var job apiclient.Job
+	for {
+		resp, err := admin.GetReviewStatusWithResponse(ctx, "tt0068646")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.JSON200 == nil {
+			t.Fatalf("review-status: status %d: %s", resp.StatusCode(), resp.Body)
+		}
+		job = *resp.JSON200
+		if job.LastError != nil || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if job.LastError == nil || !strings.Contains(*job.LastError, "token budget of 1 exhausted") {
+		t.Fatalf("got last_error %v, want token budget error", job.LastError)
+	}
+	if job.Status != apiclient.JobStatusPending || job.Attempts != 0 {
+		t.Fatalf("got status %s after %d attempts, want pending with the attempt given back", job.Status, job.Attempts)
+	}
+
+	got := usage(t, admin)
+	if !job.RunAfter.Equal(got.ResetsAt) {
+		t.Fatalf("job runs after %s, want when the budget resets at %s", job.RunAfter, got.ResetsAt)
+	}
+	if got.Calls != 1 || got.TokenBudget != 1 {
+		t.Fatalf("usage: got %+v, want 1 call against a budget of 1 token", got)
+	}
+}
@@ -0,0 +1,241 @@
+// Package integration_test drives the real gin router end to end against the
+// in-process Mongo stand-in and the fake LLM.
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/jobs"
+	"github.com/princepal9120/ai-movie-recommedation/server/llm"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/mongotest"
+	"github.com/princepal9120/ai-movie-recommedation/server/respcache"
+	"github.com/princepal9120/ai-movie-recommedation/server/routes"
+	"github.com/princepal9120/ai-movie-recommedation/server/utils"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+var (
+	mongoServer *mongotest.Server
+	client      *mongo.Client
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	gin.SetMode(gin.TestMode)
+
+	var err error
+	mongoServer, err = mongotest.NewServer()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to start mongotest server:", err)
+		return 1
+	}
+	defer mongoServer.Close()
+
+	os.Setenv("MONGODB_URI", mongoServer.URI())
+	os.Setenv("DATABASE_NAME", "magicstream_test")
+	// Tests that count LLM calls expect review updates to queue only the
+	// ranking job; summary tests turn this back on.
+	os.Setenv("AUTO_SUMMARIZE", "off")
+	utils.SECRET_KEY = "integration-secret"
+	utils.SECRET_REFRESH_KEY = "integration-refresh-secret"
+
+	client = database.Connect()
+	if client == nil {
+		fmt.Fprintln(os.Stderr, "failed to create MongoDB client")
+		return 1
+	}
+	defer client.Disconnect(context.Background())
+
+	restore := llm.Use(llm.NewFake())
+	defer restore()
+
+	// The worker runs for the whole suite, fast enough that tests can poll
+	// job status. Rankings checks are left to the tests that need them.
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	worker := &jobs.Worker{
+		Client:        client,
+		PollInterval:  10 * time.Millisecond,
+		Lease:         time.Minute,
+		RetryDelay:    10 * time.Millisecond,
+		MaxRetryDelay: 50 * time.Millisecond,
+	}
+	go worker.Run(ctx)
+
+	return m.Run()
+}
+
+// harness is one running server plus a cookie-carrying client, the way a
+// browser session would talk to it.
+type harness struct {
+	t      *testing.T
+	server *httptest.Server
+	api    *apiclient.ClientWithResponses
+}
+
+func newHarness(t *testing.T) *harness {
+	t.Helper()
+	mongoServer.Reset()
+	respcache.Clear()
+	if err := database.EnsureIndexes(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewTLSServer(routes.NewRouter(client, []string{"http://localhost:8080"}))
+	t.Cleanup(server.Close)
+
+	return &harness{t: t, server: server, api: newSession(t, server)}
+}
+
+// newSession returns an API client with its own cookie jar.
+func newSession(t *testing.T, server *httptest.Server) *apiclient.ClientWithResponses {
+	t.Helper()
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// server.Client is shared, so each session gets a copy with its own jar.
+	httpClient := *server.Client()
+	httpClient.Jar = jar
+
+	api, err := apiclient.NewClientWithResponses(server.URL, apiclient.WithHTTPClient(&httpClient))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return api
+}
+
+func (h *harness) insert(collection string, docs ...any) {
+	h.t.Helper()
+	_, err := database.OpenCollection(collection, client).InsertMany(context.Background(), docs)
+	if err != nil {
+		h.t.Fatalf("seeding %s: %v", collection, err)
+	}
+}
+
+func (h *harness) seedCatalog() {
+	h.t.Helper()
+	h.insert("genres",
+		models.Genre{GenreID: 1, GenreName: "Comedy"},
+		models.Genre{GenreID: 2, GenreName: "Drama"},
+		models.Genre{GenreID: 3, GenreName: "Fantasy"},
+	)
+	h.insert("rankings",
+		models.Ranking{RankingValue: 999, RankingName: "Not_Ranked", Unranked: true},
+		models.Ranking{RankingValue: 1, RankingName: "Excellent"},
+		models.Ranking{RankingValue: 2, RankingName: "Good"},
+		models.Ranking{RankingValue: 3, RankingName: "Okay"},
+		models.Ranking{RankingValue: 4, RankingName: "Bad"},
+		models.Ranking{RankingValue: 5, RankingName: "Terrible"},
+	)
+}
+
+// addMovies adds movies through the API as admin.
+func (h *harness) addMovies(admin *apiclient.ClientWithResponses, movies ...apiclient.Movie) {
+	h.t.Helper()
+	for _, m := range movies {
+		resp, err := admin.AddMovieWithResponse(context.Background(), m)
+		if err != nil {
+			h.t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusCreated {
+			h.t.Fatalf("addmovie %s: status %d: %s", m.ImdbId, resp.StatusCode(), resp.Body)
+		}
+	}
+}
+
+// login registers a user with the given role and favourite genres and logs in,
+// returning a session whose cookie jar holds the auth cookies.
+func (h *harness) login(email string, role apiclient.RegisterRequestRole, genres ...apiclient.Genre) *apiclient.ClientWithResponses {
+	h.t.Helper()
+	if genres == nil {
+		genres = []apiclient.Genre{}
+	}
+	session := newSession(h.t, h.server)
+	ctx := context.Background()
+
+	registered, err := session.RegisterUserWithResponse(ctx, apiclient.RegisterRequest{
+		Email:           openapi_types.Email(email),
+		FirstName:       "Test",
+		LastName:        "User",
+		Password:        "password123",
+		Role:            role,
+		FavouriteGenres: genres,
+	})
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	if registered.StatusCode() != http.StatusCreated {
+		h.t.Fatalf("register %s: status %d: %s", email, registered.StatusCode(), registered.Body)
+	}
+
+	loggedIn, err := session.LoginUserWithResponse(ctx, apiclient.UserLogin{Email: openapi_types.Email(email), Password: "password123"})
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	if loggedIn.StatusCode() != http.StatusOK {
+		h.t.Fatalf("login %s: status %d: %s", email, loggedIn.StatusCode(), loggedIn.Body)
+	}
+	return session
+}
+
+// problemCode decodes an RFC 7807 body and returns its stable error code.
+func problemCode(t *testing.T, body []byte) string {
+	t.Helper()
+	var problem apiclient.Problem
+	if err := json.Unmarshal(body, &problem); err != nil {
+		t.Fatalf("decoding problem %s: %v", body, err)
+	}
+	return problem.Code
+}
+
+func movie(imdbID, title string, rankingValue int, rankingName string, genres ...apiclient.Genre) apiclient.Movie {
+	return apiclient.Movie{
+		ImdbId:     imdbID,
+		Title:      title,
+		PosterPath: "https://image.tmdb.org/t/p/w500/" + imdbID + ".jpg",
+		YoutubeId:  "yt-" + imdbID,
+		Genre:      genres,
+		Ranking:    apiclient.Ranking{RankingValue: rankingValue, RankingName: rankingName},
+	}
+}
+
+// waitForReview polls the movie's review status until its latest job has
+// finished, and returns that job.
+func waitForReview(t *testing.T, session *apiclient.ClientWithResponses, imdbID string) apiclient.Job {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		resp, err := session.GetReviewStatusWithResponse(context.Background(), imdbID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.JSON200 == nil {
+			t.Fatalf("review-status %s: status %d: %s", imdbID, resp.StatusCode(), resp.Body)
+		}
+		switch resp.JSON200.Status {
+		case apiclient.JobStatusSucceeded, apiclient.JobStatusFailed, apiclient.JobStatusSuperseded:
+			return *resp.JSON200
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("review-status %s: still %s after 5s", imdbID, resp.JSON200.Status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
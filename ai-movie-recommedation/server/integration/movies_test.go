@@ -0,0 +1,236 @@
+package integration_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+)
+
+var (
+	comedy  = apiclient.Genre{GenreId: 1, GenreName: "Comedy"}
+	drama   = apiclient.Genre{GenreId: 2, GenreName: "Drama"}
+	fantasy = apiclient.Genre{GenreId: 3, GenreName: "Fantasy"}
+)
+
+func TestAddGetAndListMovies(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	ctx := context.Background()
+	session := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	added, err := session.AddMovieWithResponse(ctx, movie("tt0245429", "Spirited Away", 999, "Not_Ranked", fantasy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added.StatusCode() != http.StatusCreated {
+		t.Fatalf("addmovie: status %d: %s", added.StatusCode(), added.Body)
+	}
+
+	got, err := session.GetMovieWithResponse(ctx, "tt0245429", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.StatusCode() != http.StatusOK || got.JSON200 == nil {
+		t.Fatalf("movie: status %d: %s", got.StatusCode(), got.Body)
+	}
+	if got.JSON200.Title != "Spirited Away" || len(got.JSON200.Genre) != 1 || got.JSON200.Genre[0] != fantasy {
+		t.Fatalf("movie: got %+v", got.JSON200)
+	}
+
+	// /movies is public, so an anonymous client sees the new movie too.
+	list, err := h.api.GetMoviesWithResponse(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.StatusCode() != http.StatusOK || list.JSON200 == nil {
+		t.Fatalf("movies: status %d: %s", list.StatusCode(), list.Body)
+	}
+	if len(*list.JSON200) != 1 || (*list.JSON200)[0].ImdbId != "tt0245429" {
+		t.Fatalf("movies: got %+v", *list.JSON200)
+	}
+}
+
+func TestAddMovieRejectsDuplicateImdbID(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	ctx := context.Background()
+	session := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	first, err := session.AddMovieWithResponse(ctx, movie("tt0245429", "Spirited Away", 999, "Not_Ranked", fantasy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.StatusCode() != http.StatusCreated {
+		t.Fatalf("first addmovie: status %d: %s", first.StatusCode(), first.Body)
+	}
+
+	second, err := session.AddMovieWithResponse(ctx, movie("tt0245429", "Spirited Away again", 999, "Not_Ranked", fantasy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.StatusCode() != http.StatusConflict {
+		t.Fatalf("second addmovie: got status %d, want 409: %s", second.StatusCode(), second.Body)
+	}
+	if code := problemCode(t, second.Body); code != "movie_already_exists" {
+		t.Fatalf("got code %q, want movie_already_exists", code)
+	}
+}
+
+func TestGetMoviesEmpty(t *testing.T) {
+	h := newHarness(t)
+
+	list, err := h.api.GetMoviesWithResponse(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(list.Body) != "[]" {
+		t.Fatalf("got body %s, want []", list.Body)
+	}
+}
+
+func TestGetMovieNotFound(t *testing.T) {
+	h := newHarness(t)
+	session := h.login("user@example.com", apiclient.RegisterRequestRoleUSER)
+
+	resp, err := session.GetMovieWithResponse(context.Background(), "tt9999999", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusNotFound {
+		t.Fatalf("got status %d, want 404", resp.StatusCode())
+	}
+	if code := problemCode(t, resp.Body); code != "movie_not_found" {
+		t.Fatalf("got code %q, want movie_not_found", code)
+	}
+}
+
+func TestAddMovieValidation(t *testing.T) {
+	h := newHarness(t)
+	session := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	invalid := movie("tt0000001", "X", 999, "Not_Ranked", comedy)
+	invalid.PosterPath = "not-a-url"
+
+	resp, err := session.AddMovieWithResponse(context.Background(), invalid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusBadRequest || resp.ApplicationproblemJSON400 == nil {
+		t.Fatalf("got status %d, want 400: %s", resp.StatusCode(), resp.Body)
+	}
+
+	problem := resp.ApplicationproblemJSON400
+	if problem.Code != "validation_failed" {
+		t.Fatalf("got code %q, want validation_failed", problem.Code)
+	}
+	fields := map[string]bool{}
+	if problem.Errors != nil {
+		for _, fieldErr := range *problem.Errors {
+			fields[fieldErr.Field] = true
+		}
+	}
+	if !fields["title"] || !fields["poster_path"] {
+		t.Fatalf("got field errors %s, want title and poster_path", resp.Body)
+	}
+}
+
+func TestAdminReviewUpdate(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	ctx := context.Background()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	added, err := admin.AddMovieWithResponse(ctx, movie("tt0110912", "Pulp Fiction", 999, "Not_Ranked", drama))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added.StatusCode() != http.StatusCreated {
+		t.Fatalf("addmovie: status %d: %s", added.StatusCode(), added.Body)
+	}
+
+	review := "An excellent, endlessly quotable film."
+	resp, err := admin.UpdateAdminReviewWithResponse(ctx, "tt0110912", apiclient.AdminReviewRequest{AdminReview: review})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusAccepted || resp.JSON202 == nil {
+		t.Fatalf("updatereview: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	if resp.JSON202.Type != apiclient.JobTypeReviewRanking {
+		t.Fatalf("updatereview: got job %s", resp.Body)
+	}
+
+	job := waitForReview(t, admin, "tt0110912")
+	if job.Status != apiclient.JobStatusSucceeded || job.JobId != resp.JSON202.JobId {
+		t.Fatalf("review job: got %+v, want job %s succeeded", job, resp.JSON202.JobId)
+	}
+	if job.Ranking == nil || job.Ranking.RankingName != "Excellent" {
+		t.Fatalf("review job ranking: got %+v, want Excellent", job.Ranking)
+	}
+
+	got, err := admin.GetMovieWithResponse(ctx, "tt0110912", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.JSON200 == nil {
+		t.Fatalf("movie: status %d: %s", got.StatusCode(), got.Body)
+	}
+	if got.JSON200.AdminReview == nil || *got.JSON200.AdminReview != review {
+		t.Fatalf("movie admin_review: got %+v", got.JSON200.AdminReview)
+	}
+	if want := (apiclient.Ranking{RankingValue: 1, RankingName: "Excellent"}); got.JSON200.Ranking != want {
+		t.Fatalf("movie ranking: got %+v, want %+v", got.JSON200.Ranking, want)
+	}
+	if want := (apiclient.PromptRef{Name: "review_ranking", Version: 1}); got.JSON200.RankingPrompt == nil || *got.JSON200.RankingPrompt != want {
+		t.Fatalf("movie ranking_prompt: got %+v, want %+v", got.JSON200.RankingPrompt, want)
+	}
+}
+
+func TestAdminReviewUpdateRequiresAdmin(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	session := h.login("user@example.com", apiclient.RegisterRequestRoleUSER)
+
+	resp, err := session.UpdateAdminReviewWithResponse(context.Background(), "tt0110912", apiclient.AdminReviewRequest{AdminReview: "Good."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusForbidden {
+		t.Fatalf("got status %d, want 403", resp.StatusCode())
+	}
+	if code := problemCode(t, resp.Body); code != "admin_required" {
+		t.Fatalf("got code %q, want admin_required", code)
+	}
+}
+
+func TestAdminReviewUpdateUnknownMovie(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	resp, err := admin.UpdateAdminReviewWithResponse(context.Background(), "tt9999999", apiclient.AdminReviewRequest{AdminReview: "Good."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusNotFound {
+		t.Fatalf("got status %d, want 404: %s", resp.StatusCode(), resp.Body)
+	}
+}
+
+func TestGetGenres(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+
+	resp, err := h.api.GetGenresWithResponse(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		t.Fatalf("genres: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	if len(*resp.JSON200) != 3 {
+		t.Fatalf("genres: got %+v", *resp.JSON200)
+	}
+}
@@ -0,0 +1,154 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+)
+
+func onboardingMovies(t *testing.T, session *apiclient.ClientWithResponses, limit int) *apiclient.OnboardingSample {
+	t.Helper()
+	resp, err := session.GetOnboardingMoviesWithResponse(context.Background(), &apiclient.GetOnboardingMoviesParams{Limit: &limit})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil {
+		t.Fatalf("onboarding movies: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200
+}
+
+func rate(t *testing.T, session *apiclient.ClientWithResponses, scores map[string]int) {
+	t.Helper()
+	for imdbID, score := range scores {
+		resp, err := session.RateMovieWithResponse(context.Background(), imdbID, apiclient.RatingRequest{Score: score})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			t.Fatalf("rate %s: status %d: %s", imdbID, resp.StatusCode(), resp.Body)
+		}
+	}
+}
+
+func recommended(t *testing.T, session *apiclient.ClientWithResponses) []string {
+	t.Helper()
+	resp, err := session.GetRecommendedMoviesWithResponse(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil {
+		t.Fatalf("recommendedmovies: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	var ids []string
+	for _, m := range *resp.JSON200 {
+		ids = append(ids, m.ImdbId)
+	}
+	return ids
+}
+
+func seedOnboardingMovies(t *testing.T, h *harness) {
+	t.Helper()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	h.addMovies(admin,
+		movie("tt0000001", "Excellent Comedy", 1, "Excellent", comedy),
+		movie("tt0000002", "Good Comedy", 2, "Good", comedy),
+		movie("tt0000003", "Excellent Drama", 1, "Excellent", drama),
+		movie("tt0000004", "Good Drama", 2, "Good", drama),
+		movie("tt0000005", "Excellent Fantasy", 1, "Excellent", fantasy),
+		movie("tt0000006", "Okay Fantasy", 3, "Okay", fantasy),
+	)
+}
+
+func TestOnboardingDerivesFavouriteGenres(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	seedOnboardingMovies(t, h)
+	newcomer := h.login("newcomer@example.com", apiclient.RegisterRequestRoleUSER)
+	ctx := context.Background()
+
+	// Until the user has favourite genres, what is trending leads their
+	// recommendations, then the best ranked movies.
+	if resp, err := newcomer.GetMovieWithResponse(ctx, "tt0000006", nil); err != nil || resp.StatusCode() != http.StatusOK {
+		t.Fatalf("movie: %v %v", resp, err)
+	}
+	if got := recommended(t, newcomer); len(got) != 5 || got[0] != "tt0000006" {
+		t.Fatalf("got recommendations %v, want tt0000006 then four best ranked", got)
+	}
+
+	// One movie from each genre in turn, best ranked first.
+	sample := onboardingMovies(t, newcomer, 4)
+	var got []string
+	for _, m := range sample.Movies {
+		got = append(got, m.ImdbId)
+	}
+	if fmt.Sprint(got) != "[tt0000001 tt0000003 tt0000005 tt0000002]" || sample.Rated != 0 || sample.Required != 3 {
+		t.Fatalf("got sample %v, %d of %d rated", got, sample.Rated, sample.Required)
+	}
+
+	rate(t, newcomer, map[string]int{"tt0000001": 5, "tt0000003": 2})
+	sample = onboardingMovies(t, newcomer, 3)
+	got = nil
+	for _, m := range sample.Movies {
+		got = append(got, m.ImdbId)
+	}
+	if fmt.Sprint(got) != "[tt0000002 tt0000004 tt0000005]" || sample.Rated != 2 {
+		t.Fatalf("got sample %v with %d rated, want rated movies left out", got, sample.Rated)
+	}
+
+	early, err := newcomer.CompleteOnboardingWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if early.StatusCode() != http.StatusConflict || problemCode(t, early.Body) != "not_enough_ratings" {
+		t.Fatalf("complete with 2 ratings: got status %d: %s, want 409 not_enough_ratings", early.StatusCode(), early.Body)
+	}
+
+	rate(t, newcomer, map[string]int{"tt0000005": 4})
+	done, err := newcomer.CompleteOnboardingWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if done.JSON200 == nil {
+		t.Fatalf("complete: status %d: %s", done.StatusCode(), done.Body)
+	}
+	var genres []string
+	for _, g := range done.JSON200.FavouriteGenres {
+		genres = append(genres, g.GenreName)
+	}
+	if fmt.Sprint(genres) != "[Comedy Fantasy]" {
+		t.Fatalf("got favourite genres %v, want Comedy then Fantasy", genres)
+	}
+
+	got = recommended(t, newcomer)
+	if len(got) != 4 {
+		t.Fatalf("got recommendations %v, want the four comedies and fantasies", got)
+	}
+	for _, imdbID := range got {
+		if imdbID == "tt0000003" || imdbID == "tt0000004" {
+			t.Fatalf("got recommendations %v, want no drama", got)
+		}
+	}
+}
+
+func TestOnboardingNeedsALikedMovie(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	seedOnboardingMovies(t, h)
+	critic := h.login("critic@example.com", apiclient.RegisterRequestRoleUSER)
+
+	rate(t, critic, map[string]int{"tt0000001": 1, "tt0000003": 3, "tt0000005": 2})
+	resp, err := critic.CompleteOnboardingWithResponse(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusConflict || problemCode(t, resp.Body) != "not_enough_ratings" {
+		t.Fatalf("complete without a liked movie: got status %d: %s, want 409 not_enough_ratings", resp.StatusCode(), resp.Body)
+	}
+	if got := recommended(t, critic); len(got) != 5 {
+		t.Fatalf("got recommendations %v, want five popular movies", got)
+	}
+}
@@ -0,0 +1,242 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+)
+
+func rated(m apiclient.Movie, maturity apiclient.MovieMaturity) apiclient.Movie {
+	m.Maturity = &maturity
+	return m
+}
+
+func createProfile(t *testing.T, session *apiclient.ClientWithResponses, name string, maturity apiclient.ProfileRequestMaturity, genres ...apiclient.Genre) *apiclient.CreateProfileResponse {
+	t.Helper()
+	resp, err := session.CreateProfileWithResponse(context.Background(), apiclient.ProfileRequest{Name: name, Maturity: maturity, FavouriteGenres: &genres})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+func selectProfile(t *testing.T, session *apiclient.ClientWithResponses, profileID string) {
+	t.Helper()
+	resp, err := session.SelectProfileWithResponse(context.Background(), profileID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil || resp.JSON200.ProfileId != profileID {
+		t.Fatalf("select profile %s: status %d: %s", profileID, resp.StatusCode(), resp.Body)
+	}
+}
+
+func watchlist(t *testing.T, session *apiclient.ClientWithResponses) []string {
+	t.Helper()
+	resp, err := session.GetWatchlistWithResponse(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil {
+		t.Fatalf("watchlist: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	var ids []string
+	for _, m := range *resp.JSON200 {
+		ids = append(ids, m.ImdbId)
+	}
+	return ids
+}
+
+func TestProfilesKeepTheirOwnTasteAndActivity(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	ctx := context.Background()
+	h.addMovies(admin,
+		rated(movie("tt0000001", "Kids Comedy", 2, "Good", comedy), apiclient.MovieMaturityKids),
+		movie("tt0000002", "Unrated Comedy", 1, "Excellent", comedy),
+		rated(movie("tt0000003", "Adult Drama", 1, "Excellent", drama), apiclient.MovieMaturityAdult),
+		rated(movie("tt0000004", "Teen Comedy", 1, "Excellent", comedy), apiclient.MovieMaturityTeen),
+	)
+
+	household := h.login("family@example.com", apiclient.RegisterRequestRoleUSER, drama)
+	kids := createProfile(t, household, "Kids", apiclient.ProfileRequestMaturityKids, comedy)
+	if kids.JSON201 == nil {
+		t.Fatalf("create kids profile: status %d: %s", kids.StatusCode(), kids.Body)
+	}
+	teen := createProfile(t, household, "Teen", apiclient.ProfileRequestMaturityTeen, comedy)
+	if teen.JSON201 == nil {
+		t.Fatalf("create teen profile: status %d: %s", teen.StatusCode(), teen.Body)
+	}
+
+	if got := recommended(t, household); fmt.Sprint(got) != "[tt0000003]" {
+		t.Fatalf("account: got %v, want the drama", got)
+	}
+	if resp, err := household.AddToWatchlistWithResponse(ctx, "tt0000003"); err != nil || resp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("account watchlist add: %v %v", resp, err)
+	}
+
+	selectProfile(t, household, teen.JSON201.ProfileId)
+	if got := recommended(t, household); fmt.Sprint(got) != "[tt0000004 tt0000001]" {
+		t.Fatalf("teen: got %v, want the teen and kids comedies", got)
+	}
+
+	selectProfile(t, household, kids.JSON201.ProfileId)
+	if got := recommended(t, household); fmt.Sprint(got) != "[tt0000001]" {
+		t.Fatalf("kids: got %v, want only the kids comedy", got)
+	}
+	if order, rows := feedRows(t, household); fmt.Sprint(order) != "[top_picks]" || fmt.Sprint(rows["top_picks"]) != "[tt0000001]" {
+		t.Fatalf("kids feed: got rows %v: %v", order, rows)
+	}
+	if got := watchlist(t, household); len(got) != 0 {
+		t.Fatalf("kids watchlist: got %v, want it empty", got)
+	}
+	if resp, err := household.AddToWatchlistWithResponse(ctx, "tt0000001"); err != nil || resp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("kids watchlist add: %v %v", resp, err)
+	}
+	rate(t, household, map[string]int{"tt0000001": 5})
+
+	// A kids profile stays put until someone logs in again.
+	if resp := createProfile(t, household, "Sneaky", apiclient.ProfileRequestMaturityAdult); resp.StatusCode() != http.StatusForbidden || problemCode(t, resp.Body) != "kids_profile" {
+		t.Fatalf("create from kids profile: got status %d: %s, want 403 kids_profile", resp.StatusCode(), resp.Body)
+	}
+	if resp, err := household.SelectProfileWithResponse(ctx, teen.JSON201.ProfileId); err != nil || resp.StatusCode() != http.StatusForbidden {
+		t.Fatalf("switch from kids profile: %v %v, want 403", resp, err)
+	}
+
+	loggedIn, err := household.LoginUserWithResponse(ctx, apiclient.UserLogin{Email: openapi_types.Email("family@example.com"), Password: "password123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loggedIn.JSON200 == nil || loggedIn.JSON200.Profiles == nil || len(*loggedIn.JSON200.Profiles) != 2 || (*loggedIn.JSON200.Profiles)[0].Name != "Kids" {
+		t.Fatalf("login: status %d: %s, want both profiles listed", loggedIn.StatusCode(), loggedIn.Body)
+	}
+	if got := watchlist(t, household); fmt.Sprint(got) != "[tt0000003]" {
+		t.Fatalf("account watchlist: got %v, want only the account's own", got)
+	}
+
+	// Deleting a profile takes its activity with it.
+	if resp, err := household.DeleteProfileWithResponse(ctx, kids.JSON201.ProfileId); err != nil || resp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("delete kids profile: %v %v", resp, err)
+	}
+	stats, err := admin.GetMovieStatsWithResponse(ctx, "tt0000001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.JSON200 == nil || stats.JSON200.RatingCount != 0 || stats.JSON200.Watchlisted != 0 {
+		t.Fatalf("stats after delete: status %d: %s, want no ratings or watchlist entries", stats.StatusCode(), stats.Body)
+	}
+}
+
+func TestProfileManagement(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	owner := h.login("owner@example.com", apiclient.RegisterRequestRoleUSER)
+	other := h.login("other@example.com", apiclient.RegisterRequestRoleUSER)
+	ctx := context.Background()
+
+	invalid := createProfile(t, owner, "Anyone", "everyone")
+	if invalid.StatusCode() != http.StatusBadRequest || problemField(t, invalid.Body) != "maturity" {
+		t.Fatalf("unknown maturity: got status %d: %s, want 400 on maturity", invalid.StatusCode(), invalid.Body)
+	}
+	unknownGenre := createProfile(t, owner, "Anyone", apiclient.ProfileRequestMaturityAdult, apiclient.Genre{GenreId: 99, GenreName: "Western"})
+	if unknownGenre.StatusCode() != http.StatusBadRequest {
+		t.Fatalf("unknown genre: got status %d: %s, want 400", unknownGenre.StatusCode(), unknownGenre.Body)
+	}
+
+	first := createProfile(t, owner, "Alex", apiclient.ProfileRequestMaturityAdult, drama)
+	if first.JSON201 == nil {
+		t.Fatalf("create: status %d: %s", first.StatusCode(), first.Body)
+	}
+	if duplicate := createProfile(t, owner, "Alex", apiclient.ProfileRequestMaturityTeen); duplicate.StatusCode() != http.StatusConflict || problemCode(t, duplicate.Body) != "profile_already_exists" {
+		t.Fatalf("duplicate name: got status %d: %s, want 409", duplicate.StatusCode(), duplicate.Body)
+	}
+	if resp := createProfile(t, other, "Alex", apiclient.ProfileRequestMaturityAdult); resp.StatusCode() != http.StatusCreated {
+		t.Fatalf("same name on another account: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	for i := 2; i <= 5; i++ {
+		if resp := createProfile(t, owner, fmt.Sprintf("Member %d", i), apiclient.ProfileRequestMaturityAdult); resp.StatusCode() != http.StatusCreated {
+			t.Fatalf("create profile %d: status %d: %s", i, resp.StatusCode(), resp.Body)
+		}
+	}
+	if full := createProfile(t, owner, "Member 6", apiclient.ProfileRequestMaturityAdult); full.StatusCode() != http.StatusConflict || problemCode(t, full.Body) != "profile_limit" {
+		t.Fatalf("sixth profile: got status %d: %s, want 409 profile_limit", full.StatusCode(), full.Body)
+	}
+
+	updated, err := owner.UpdateProfileWithResponse(ctx, first.JSON201.ProfileId, apiclient.ProfileRequest{Name: "Alex", Maturity: apiclient.ProfileRequestMaturityTeen, FavouriteGenres: &[]apiclient.Genre{comedy, fantasy}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.JSON200 == nil || updated.JSON200.Maturity != apiclient.ProfileMaturityTeen || len(updated.JSON200.FavouriteGenres) != 2 {
+		t.Fatalf("update: status %d: %s", updated.StatusCode(), updated.Body)
+	}
+
+	// Profiles of another account cannot be touched.
+	if resp, err := other.SelectProfileWithResponse(ctx, first.JSON201.ProfileId); err != nil || resp.StatusCode() != http.StatusNotFound {
+		t.Fatalf("select another account's profile: %v %v, want 404", resp, err)
+	}
+	if resp, err := other.DeleteProfileWithResponse(ctx, first.JSON201.ProfileId); err != nil || resp.StatusCode() != http.StatusNotFound {
+		t.Fatalf("delete another account's profile: %v %v, want 404", resp, err)
+	}
+
+	// Tokens for a deleted profile no longer get recommendations.
+	selectProfile(t, owner, first.JSON201.ProfileId)
+	if resp, err := owner.DeleteProfileWithResponse(ctx, first.JSON201.ProfileId); err != nil || resp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("delete own profile: %v %v", resp, err)
+	}
+	gone, err := owner.GetRecommendedMoviesWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gone.StatusCode() != http.StatusNotFound || problemCode(t, gone.Body) != "profile_not_found" {
+		t.Fatalf("recommendations for a deleted profile: got status %d: %s, want 404", gone.StatusCode(), gone.Body)
+	}
+
+	list, err := owner.ListProfilesWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.JSON200 == nil || len(*list.JSON200) != 4 {
+		t.Fatalf("list: status %d: %s, want 4 profiles", list.StatusCode(), list.Body)
+	}
+}
+
+func TestStartupDropsPreProfileActivityIndexes(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	ctx := context.Background()
+
+	// A database that was never migrated still has one rating and watchlist
+	// entry per account and movie.
+	for _, index := range database.Superseded {
+		if _, err := database.OpenCollection(index.Collection, client).Indexes().CreateOne(ctx, index.Model()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := database.EnsureIndexes(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	h.addMovies(admin, movie("tt0000001", "Drama", 1, "Excellent", drama))
+
+	household := h.login("family@example.com", apiclient.RegisterRequestRoleUSER, drama)
+	member := createProfile(t, household, "Member", apiclient.ProfileRequestMaturityAdult, drama)
+	if member.JSON201 == nil {
+		t.Fatalf("create profile: status %d: %s", member.StatusCode(), member.Body)
+	}
+	for _, profileID := range []string{"", member.JSON201.ProfileId} {
+		if profileID != "" {
+			selectProfile(t, household, profileID)
+		}
+		rate(t, household, map[string]int{"tt0000001": 4})
+		if resp, err := household.AddToWatchlistWithResponse(ctx, "tt0000001"); err != nil || resp.StatusCode() != http.StatusNoContent {
+			t.Fatalf("watchlist add as %q: %v %v", profileID, resp, err)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package integration_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/llm"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+)
+
+func TestNewPromptVersionIsUsedAndRecorded(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	unranked := models.Ranking{RankingValue: 999, RankingName: "Not_Ranked"}
+	h.insert("movies", reviewedMovie("tt0110912", "", unranked))
+	fake := llm.NewFake()
+	t.Cleanup(llm.Use(fake))
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	ctx := context.Background()
+
+	created, err := admin.CreatePromptTemplateWithResponse(ctx, "review_ranking", apiclient.PromptTemplateRequest{
+		Body: "Pick one of {{join .Rankings \" / \"}} for this review.\n{{.Review}}",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.JSON201 == nil {
+		t.Fatalf("create prompt: status %d: %s", created.StatusCode(), created.Body)
+	}
+	if created.JSON201.Version != 2 || created.JSON201.Source != apiclient.PromptTemplateSourceDatabase {
+		t.Fatalf("create prompt: got %+v, want database version 2", created.JSON201)
+	}
+
+	listed, err := admin.ListPromptTemplatesWithResponse(ctx, "review_ranking")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if listed.JSON200 == nil {
+		t.Fatalf("list prompts: status %d: %s", listed.StatusCode(), listed.Body)
+	}
+	if versions := *listed.JSON200; len(versions) != 2 || versions[0].Version != 2 || versions[1].Source != apiclient.PromptTemplateSourceBuiltin {
+		t.Fatalf("list prompts: got %s, want version 2 then the built-in version 1", listed.Body)
+	}
+
+	if _, err := admin.UpdateAdminReviewWithResponse(ctx, "tt0110912", apiclient.AdminReviewRequest{AdminReview: "A good film."}); err != nil {
+		t.Fatal(err)
+	}
+	job := waitForReview(t, admin, "tt0110912")
+	if want := (apiclient.PromptRef{Name: "review_ranking", Version: 2}); job.Prompt == nil || *job.Prompt != want {
+		t.Fatalf("review job prompt: got %+v, want %+v", job.Prompt, want)
+	}
+
+	got, err := admin.GetMovieWithResponse(ctx, "tt0110912", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.JSON200 == nil || got.JSON200.RankingPrompt == nil || got.JSON200.RankingPrompt.Version != 2 {
+		t.Fatalf("movie: got %s, want ranking_prompt version 2", got.Body)
+	}
+
+	prompts := fake.Prompts()
+	if want := "Pick one of Excellent / Good / Okay / Bad / Terrible for this review.\nA good film."; len(prompts) != 1 || prompts[0] != want {
+		t.Fatalf("model prompts: got %q, want %q", prompts, want)
+	}
+}
+
+func TestCreatePromptRejectsInvalidTemplates(t *testing.T) {
+	h := newHarness(t)
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	cases := map[string]string{
+		"syntax error":  "Classify {{.Review",
+		"unknown field": "Classify {{.Movie}}: {{.Review}}",
+		"drops review":  "Classify as one of {{join .Rankings \", \"}}.",
+	}
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp, err := admin.CreatePromptTemplateWithResponse(context.Background(), "review_ranking", apiclient.PromptTemplateRequest{Body: body})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode() != http.StatusBadRequest || problemCode(t, resp.Body) != "invalid_template" {
+				t.Fatalf("got status %d: %s, want 400 invalid_template", resp.StatusCode(), resp.Body)
+			}
+		})
+	}
+
+	resp, err := admin.CreatePromptTemplateWithResponse(context.Background(), "movie_poem", apiclient.PromptTemplateRequest{Body: "{{.Review}}"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusNotFound || problemCode(t, resp.Body) != "prompt_not_found" {
+		t.Fatalf("unknown prompt: got status %d: %s, want 404 prompt_not_found", resp.StatusCode(), resp.Body)
+	}
+}
+
+func TestPreviewPromptAgainstSampleReviews(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	t.Cleanup(llm.Use(&llm.Fake{Respond: func(prompt string) string {
+		if strings.HasSuffix(prompt, "Meh.") {
+			return "Mediocre"
+		}
+		return llm.ClassifyByKeyword(prompt)
+	}}))
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	draft := "Rankings: {{join .Rankings \",\"}}\n{{.Review}}"
+	resp, err := admin.PreviewPromptTemplateWithResponse(context.Background(), "review_ranking", apiclient.PromptPreviewRequest{
+		Body:    &draft,
+		Reviews: []string{"A terrible film.", "Meh."},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil {
+		t.Fatalf("preview: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	preview := resp.JSON200
+	if preview.Prompt.Version != 0 || len(preview.Results) != 2 {
+		t.Fatalf("preview: got %s, want two results for an unsaved draft", resp.Body)
+	}
+
+	terrible := preview.Results[0]
+	if terrible.Ranking == nil || terrible.Ranking.RankingName != "Terrible" || terrible.Prompt != "Rankings: Excellent,Good,Okay,Bad,Terrible\nA terrible film." {
+		t.Fatalf("first result: got %+v", terrible)
+	}
+	meh := preview.Results[1]
+	if meh.Ranking != nil || meh.Answer != "Mediocre" || meh.Error == nil {
+		t.Fatalf("second result: got %+v, want an unknown ranking error", meh)
+	}
+
+	// Nothing was saved and no movie was touched: version 1 is still in use.
+	listed, err := admin.ListPromptTemplatesWithResponse(context.Background(), "review_ranking")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if listed.JSON200 == nil || len(*listed.JSON200) != 1 {
+		t.Fatalf("list prompts: got %s, want only the built-in version", listed.Body)
+	}
+}
+
+func TestPreviewUnknownVersion(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	version := 7
+	resp, err := admin.PreviewPromptTemplateWithResponse(context.Background(), "review_ranking", apiclient.PromptPreviewRequest{
+		Version: &version,
+		Reviews: []string{"A good film."},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusNotFound || problemCode(t, resp.Body) != "prompt_not_found" {
+		t.Fatalf("got status %d: %s, want 404 prompt_not_found", resp.StatusCode(), resp.Body)
+	}
+}
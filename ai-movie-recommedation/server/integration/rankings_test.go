@@ -0,0 +1,168 @@
+package integration_test
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+)
+
+func tier(value int, name string, replaces ...string) apiclient.RankingTierRequest {
+	req := apiclient.RankingTierRequest{RankingValue: value, RankingName: name}
+	if replaces != nil {
+		req.Replaces = &replaces
+	}
+	return req
+}
+
+func unrankedTier(value int, name string, replaces ...string) apiclient.RankingTierRequest {
+	req := tier(value, name, replaces...)
+	unranked := true
+	req.Unranked = &unranked
+	return req
+}
+
+func movieRanking(t *testing.T, session *apiclient.ClientWithResponses, imdbID string) apiclient.Ranking {
+	t.Helper()
+	resp, err := session.GetMovieWithResponse(context.Background(), imdbID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil {
+		t.Fatalf("movie %s: status %d: %s", imdbID, resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200.Ranking
+}
+
+func TestReplaceRankingsRemapsMovies(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	h.insert("movies",
+		reviewedMovie("tt0000001", "", models.Ranking{RankingValue: 2, RankingName: "Good"}),
+		reviewedMovie("tt0000002", "", models.Ranking{RankingValue: 3, RankingName: "Okay"}),
+		reviewedMovie("tt0000003", "", models.Ranking{RankingValue: 4, RankingName: "Bad"}),
+		reviewedMovie("tt0000004", "", models.Ranking{RankingValue: 999, RankingName: "Not_Ranked", Unranked: true}),
+	)
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	ctx := context.Background()
+
+	resp, err := admin.ReplaceRankingsWithResponse(ctx, apiclient.RankingScaleRequest{Rankings: []apiclient.RankingTierRequest{
+		tier(1, "Excellent"),
+		tier(2, "Great", "Good"),
+		tier(3, "Okay"),
+		tier(10, "Terrible"),
+		unrankedTier(100, "Unrated", "Not_Ranked"),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil {
+		t.Fatalf("replace rankings: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	// Good became Great, Bad was dropped and Not_Ranked became Unrated.
+	if resp.JSON200.Remapped != 3 {
+		t.Fatalf("got %d movies remapped, want 3", resp.JSON200.Remapped)
+	}
+	if job := resp.JSON200.Job; job == nil || job.Type != apiclient.JobTypeRerankAll {
+		t.Fatalf("got job %+v, want a rerank_all job since the LLM's choices changed", job)
+	}
+
+	for imdbID, want := range map[string]apiclient.Ranking{
+		"tt0000001": {RankingValue: 2, RankingName: "Great"},
+		"tt0000002": {RankingValue: 3, RankingName: "Okay"},
+		"tt0000003": {RankingValue: 100, RankingName: "Unrated"},
+		"tt0000004": {RankingValue: 100, RankingName: "Unrated"},
+	} {
+		got := movieRanking(t, admin, imdbID)
+		if got.RankingValue != want.RankingValue || got.RankingName != want.RankingName {
+			t.Errorf("%s: got ranking %+v, want %+v", imdbID, got, want)
+		}
+		if unranked := got.Unranked != nil && *got.Unranked; unranked != (want.RankingName == "Unrated") {
+			t.Errorf("%s: got unranked %v", imdbID, unranked)
+		}
+	}
+
+	scale, err := admin.GetRankingsWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, ranking := range *scale.JSON200 {
+		names = append(names, ranking.RankingName)
+	}
+	if want := []string{"Excellent", "Great", "Okay", "Terrible", "Unrated"}; !slices.Equal(names, want) {
+		t.Fatalf("got scale %q, want %q", names, want)
+	}
+}
+
+func TestReplaceRankingsWithNewValuesOnly(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	h.insert("movies", reviewedMovie("tt0000001", "", models.Ranking{RankingValue: 5, RankingName: "Terrible"}))
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	resp, err := admin.ReplaceRankingsWithResponse(context.Background(), apiclient.RankingScaleRequest{Rankings: []apiclient.RankingTierRequest{
+		tier(10, "Excellent"),
+		tier(20, "Good"),
+		tier(30, "Okay"),
+		tier(40, "Bad"),
+		tier(50, "Terrible"),
+		unrankedTier(999, "Not_Ranked"),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Remapped != 1 || resp.JSON200.Job != nil {
+		t.Fatalf("replace rankings: status %d: %s, want one movie remapped and no re-rank", resp.StatusCode(), resp.Body)
+	}
+	if got := movieRanking(t, admin, "tt0000001"); got.RankingValue != 50 {
+		t.Fatalf("got ranking %+v, want Terrible at 50", got)
+	}
+}
+
+func TestReplaceRankingsValidation(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	user := h.login("user@example.com", apiclient.RegisterRequestRoleUSER)
+	ctx := context.Background()
+
+	forbidden, err := user.GetRankingsWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forbidden.StatusCode() != http.StatusForbidden {
+		t.Fatalf("rankings as user: got status %d, want 403", forbidden.StatusCode())
+	}
+
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	for name, tc := range map[string]struct {
+		tiers []apiclient.RankingTierRequest
+		field string
+	}{
+		"values out of order": {[]apiclient.RankingTierRequest{tier(2, "Good"), tier(1, "Excellent"), unrankedTier(999, "Not_Ranked")}, "rankings[1].ranking_value"},
+		"duplicate name":      {[]apiclient.RankingTierRequest{tier(1, "Good"), tier(2, "Good"), unrankedTier(999, "Not_Ranked")}, "rankings[1].ranking_name"},
+		"unranked not last":   {[]apiclient.RankingTierRequest{unrankedTier(1, "Not_Ranked"), tier(2, "Good")}, "rankings[0].unranked"},
+		"no unranked tier":    {[]apiclient.RankingTierRequest{tier(1, "Good"), tier(2, "Bad")}, "rankings"},
+		"unknown replaced":    {[]apiclient.RankingTierRequest{tier(1, "Great", "Superb"), unrankedTier(999, "Not_Ranked")}, "rankings[0].replaces[0]"},
+		"kept tier replaced":  {[]apiclient.RankingTierRequest{tier(1, "Excellent"), tier(2, "Great", "Excellent"), unrankedTier(999, "Not_Ranked")}, "rankings[1].replaces[0]"},
+	} {
+		resp, err := admin.ReplaceRankingsWithResponse(ctx, apiclient.RankingScaleRequest{Rankings: tc.tiers})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusBadRequest || problemCode(t, resp.Body) != "validation_failed" || problemField(t, resp.Body) != tc.field {
+			t.Errorf("%s: got status %d: %s, want 400 on %s", name, resp.StatusCode(), resp.Body, tc.field)
+		}
+	}
+
+	scale, err := admin.GetRankingsWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scale.JSON200 == nil || len(*scale.JSON200) != 6 {
+		t.Fatalf("scale after rejected changes: %s, want the 6 seeded tiers", scale.Body)
+	}
+}
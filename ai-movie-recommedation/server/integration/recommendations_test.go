@@ -0,0 +1,88 @@
+package integration_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+)
+
+func TestRecommendedMoviesMatchFavouriteGenres(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	ctx := context.Background()
+
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	h.addMovies(admin,
+		movie("tt0000001", "Okay Comedy", 3, "Okay", comedy),
+		movie("tt0000002", "Excellent Comedy", 1, "Excellent", comedy),
+		movie("tt0000003", "Good Fantasy", 2, "Good", fantasy),
+		movie("tt0000004", "Excellent Drama", 1, "Excellent", drama),
+	)
+
+	session := h.login("viewer@example.com", apiclient.RegisterRequestRoleUSER, comedy, fantasy)
+
+	resp, err := session.GetRecommendedMoviesWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		t.Fatalf("recommendedmovies: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	var got []string
+	for _, m := range *resp.JSON200 {
+		got = append(got, m.ImdbId)
+	}
+	want := []string{"tt0000002", "tt0000003", "tt0000001"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v (best ranking first)", got, want)
+		}
+	}
+}
+
+func TestRecommendedMoviesRespectsLimit(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	t.Setenv("FEED_ROW_LIMITS", "top_picks=2")
+	ctx := context.Background()
+
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	for _, m := range []apiclient.Movie{
+		movie("tt0000001", "Comedy One", 3, "Okay", comedy),
+		movie("tt0000002", "Comedy Two", 2, "Good", comedy),
+		movie("tt0000003", "Comedy Three", 1, "Excellent", comedy),
+	} {
+		if _, err := admin.AddMovieWithResponse(ctx, m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	session := h.login("viewer@example.com", apiclient.RegisterRequestRoleUSER, comedy)
+	resp, err := session.GetRecommendedMoviesWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) != 2 {
+		t.Fatalf("recommendedmovies: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+}
+
+func TestRecommendedMoviesWithoutFavourites(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	session := h.login("viewer@example.com", apiclient.RegisterRequestRoleUSER)
+
+	resp, err := session.GetRecommendedMoviesWithResponse(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK || string(resp.Body) != "[]" {
+		t.Fatalf("got status %d body %s, want 200 []", resp.StatusCode(), resp.Body)
+	}
+}
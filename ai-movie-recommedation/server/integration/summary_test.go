@@ -0,0 +1,146 @@
+package integration_test
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/llm"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+)
+
+func summarize(t *testing.T, admin *apiclient.ClientWithResponses, imdbID string) apiclient.Job {
+	t.Helper()
+	resp, err := admin.SummarizeMovieWithResponse(context.Background(), imdbID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON202 == nil {
+		t.Fatalf("summarize %s: status %d: %s", imdbID, resp.StatusCode(), resp.Body)
+	}
+	return waitForJob(t, admin, resp.JSON202.JobId)
+}
+
+func TestReviewUpdateQueuesSummary(t *testing.T) {
+	t.Setenv("AUTO_SUMMARIZE", "on")
+	h := newHarness(t)
+	h.seedCatalog()
+	pulpFiction := reviewedMovie("tt0110912", "", models.Ranking{RankingValue: 999, RankingName: "Not_Ranked"})
+	pulpFiction.Title = "Pulp Fiction"
+	pulpFiction.Metadata = &models.Metadata{ReleaseYear: 1994, Director: "Quentin Tarantino", Source: "fixture", EnrichedAt: time.Now().UTC()}
+	h.insert("movies", pulpFiction)
+	fake := llm.NewFake()
+	t.Cleanup(llm.Use(fake))
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	ctx := context.Background()
+
+	review := "A tense, violent and hilarious film."
+	if _, err := admin.UpdateAdminReviewWithResponse(ctx, "tt0110912", apiclient.AdminReviewRequest{AdminReview: review}); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	var got *apiclient.Movie
+	for {
+		resp, err := admin.GetMovieWithResponse(ctx, "tt0110912", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.JSON200 == nil {
+			t.Fatalf("movie: status %d: %s", resp.StatusCode(), resp.Body)
+		}
+		got = resp.JSON200
+		if got.Summary != nil || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if got.Summary == nil {
+		t.Fatal("movie summary was not written within 5s")
+	}
+	summary := got.Summary
+	if summary.Synopsis != "A film called Pulp Fiction." {
+		t.Fatalf("synopsis: got %q", summary.Synopsis)
+	}
+	if !slices.Equal(summary.MoodTags, []string{"funny", "tense"}) || !slices.Equal(summary.ContentWarnings, []string{"violence"}) {
+		t.Fatalf("got mood tags %q and content warnings %q", summary.MoodTags, summary.ContentWarnings)
+	}
+	if want := (apiclient.PromptRef{Name: "movie_summary", Version: 1}); summary.Prompt == nil || *summary.Prompt != want {
+		t.Fatalf("summary prompt: got %+v, want %+v", summary.Prompt, want)
+	}
+
+	var summaryPrompt string
+	for _, prompt := range fake.Prompts() {
+		if strings.Contains(prompt, `"synopsis"`) {
+			summaryPrompt = prompt
+		}
+	}
+	for _, want := range []string{"Title: Pulp Fiction", "Genres: Drama", "Year: 1994", "Director: Quentin Tarantino", "Review: " + review} {
+		if !strings.Contains(summaryPrompt, want) {
+			t.Errorf("summary prompt %q does not contain %q", summaryPrompt, want)
+		}
+	}
+}
+
+func TestSummarizeMovieFromMetadata(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	overviewOnly := reviewedMovie("tt0110912", "", models.Ranking{RankingValue: 999, RankingName: "Not_Ranked"})
+	overviewOnly.Metadata = &models.Metadata{Overview: "Two hitmen talk about burgers.", Source: "fixture", EnrichedAt: time.Now().UTC()}
+	h.insert("movies", overviewOnly, reviewedMovie("tt0068646", "", models.Ranking{RankingValue: 999, RankingName: "Not_Ranked"}))
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	job := summarize(t, admin, "tt0110912")
+	if job.Status != apiclient.JobStatusSucceeded || job.Type != apiclient.JobTypeMovieSummary {
+		t.Fatalf("summary job: got %+v, want a succeeded movie_summary job", job)
+	}
+	got, err := admin.GetMovieWithResponse(context.Background(), "tt0110912", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.JSON200 == nil || got.JSON200.Summary == nil || got.JSON200.Summary.ContentWarnings == nil {
+		t.Fatalf("movie: got %s, want a summary with an empty list of content warnings", got.Body)
+	}
+
+	job = summarize(t, admin, "tt0068646")
+	if job.Status != apiclient.JobStatusFailed || job.Attempts != 1 || job.LastError == nil || !strings.Contains(*job.LastError, "no review or overview") {
+		t.Fatalf("summary of a movie with nothing to summarize: got %+v, want failed on the first attempt", job)
+	}
+
+	resp, err := admin.SummarizeMovieWithResponse(context.Background(), "tt9999999")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusNotFound || problemCode(t, resp.Body) != "movie_not_found" {
+		t.Fatalf("unknown movie: got status %d: %s, want 404 movie_not_found", resp.StatusCode(), resp.Body)
+	}
+}
+
+func TestInvalidSummaryAnswerIsRetried(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	h.insert("movies", reviewedMovie("tt0110912", "A good film.", models.Ranking{RankingValue: 2, RankingName: "Good"}))
+	var calls atomic.Int32
+	t.Cleanup(llm.Use(&llm.Fake{Respond: func(prompt string) string {
+		if calls.Add(1) == 1 {
+			return "Sure! This film is a fun ride."
+		}
+		return "```json\n" + llm.SummarizeByKeyword(prompt) + "\n```"
+	}}))
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+
+	// The cache must not hand the invalid first answer back to the retry.
+	job := summarize(t, admin, "tt0110912")
+	if job.Status != apiclient.JobStatusSucceeded || job.Attempts != 2 {
+		t.Fatalf("got status %s after %d attempts, want succeeded after 2", job.Status, job.Attempts)
+	}
+	if job.Prompt == nil || job.Prompt.Name != "movie_summary" {
+		t.Fatalf("summary job prompt: got %+v", job.Prompt)
+	}
+}
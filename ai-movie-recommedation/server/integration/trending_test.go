@@ -0,0 +1,167 @@
+package integration_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apiclient"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+)
+
+func trending(t *testing.T, h *harness, params *apiclient.GetTrendingMoviesParams) []apiclient.TrendingMovie {
+	t.Helper()
+	resp, err := h.api.GetTrendingMoviesWithResponse(context.Background(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil {
+		t.Fatalf("trending: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+	return *resp.JSON200
+}
+
+func TestTrendingRanksByActivity(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	h.insert("movies",
+		reviewedMovie("tt0000001", "", models.Ranking{RankingValue: 1, RankingName: "Excellent"}),
+		reviewedMovie("tt0000002", "", models.Ranking{RankingValue: 2, RankingName: "Good"}),
+		reviewedMovie("tt0000003", "", models.Ranking{RankingValue: 3, RankingName: "Okay"}),
+	)
+	user := h.login("user@example.com", apiclient.RegisterRequestRoleUSER)
+	ctx := context.Background()
+
+	// Two views, the second one served from the response cache.
+	for range 2 {
+		if resp, err := user.GetMovieWithResponse(ctx, "tt0000001", nil); err != nil || resp.StatusCode() != http.StatusOK {
+			t.Fatalf("movie: %v %v", resp, err)
+		}
+	}
+	// One rating and one watchlist add; repeating either does not count again.
+	for _, score := range []int{4, 5} {
+		resp, err := user.RateMovieWithResponse(ctx, "tt0000002", apiclient.RatingRequest{Score: score})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.JSON200 == nil || resp.JSON200.Score != score {
+			t.Fatalf("rate: status %d: %s", resp.StatusCode(), resp.Body)
+		}
+	}
+	for range 2 {
+		resp, err := user.AddToWatchlistWithResponse(ctx, "tt0000002")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != http.StatusNoContent {
+			t.Fatalf("watchlist add: status %d: %s", resp.StatusCode(), resp.Body)
+		}
+	}
+
+	got := trending(t, h, nil)
+	if len(got) != 2 || got[0].Movie.ImdbId != "tt0000002" || got[1].Movie.ImdbId != "tt0000001" {
+		t.Fatalf("got trending %+v, want tt0000002 then tt0000001", got)
+	}
+	// 3 for the rating and 5 for the watchlist add against 2 views, less at
+	// most half an hour of decay.
+	if got[0].Score <= 7 || got[0].Score > 8 || got[1].Score <= 1.8 || got[1].Score > 2 {
+		t.Fatalf("got scores %v and %v, want about 8 and 2", got[0].Score, got[1].Score)
+	}
+
+	limit := 1
+	day := apiclient.GetTrendingMoviesParamsWindowDay
+	if got := trending(t, h, &apiclient.GetTrendingMoviesParams{Window: &day, Limit: &limit}); len(got) != 1 || got[0].Movie.ImdbId != "tt0000002" {
+		t.Fatalf("got day trending %+v, want only tt0000002", got)
+	}
+
+	bad := apiclient.GetTrendingMoviesParamsWindow("year")
+	resp, err := h.api.GetTrendingMoviesWithResponse(ctx, &apiclient.GetTrendingMoviesParams{Window: &bad})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusBadRequest || problemField(t, resp.Body) != "window" {
+		t.Fatalf("window=year: got status %d: %s, want 400 on window", resp.StatusCode(), resp.Body)
+	}
+}
+
+func TestWatchlistAndMovieStats(t *testing.T) {
+	h := newHarness(t)
+	h.seedCatalog()
+	h.insert("movies",
+		reviewedMovie("tt0000001", "", models.Ranking{RankingValue: 1, RankingName: "Excellent"}),
+		reviewedMovie("tt0000002", "", models.Ranking{RankingValue: 2, RankingName: "Good"}),
+	)
+	alice := h.login("alice@example.com", apiclient.RegisterRequestRoleUSER)
+	bob := h.login("bob@example.com", apiclient.RegisterRequestRoleUSER)
+	ctx := context.Background()
+
+	for _, imdbID := range []string{"tt0000001", "tt0000002"} {
+		if resp, err := alice.AddToWatchlistWithResponse(ctx, imdbID); err != nil || resp.StatusCode() != http.StatusNoContent {
+			t.Fatalf("watchlist add %s: %v %v", imdbID, resp, err)
+		}
+	}
+	if resp, err := bob.AddToWatchlistWithResponse(ctx, "tt0000001"); err != nil || resp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("watchlist add: %v %v", resp, err)
+	}
+	if resp, err := alice.RemoveFromWatchlistWithResponse(ctx, "tt0000002"); err != nil || resp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("watchlist remove: %v %v", resp, err)
+	}
+	list, err := alice.GetWatchlistWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.JSON200 == nil || len(*list.JSON200) != 1 || (*list.JSON200)[0].ImdbId != "tt0000001" {
+		t.Fatalf("watchlist: status %d: %s, want only tt0000001", list.StatusCode(), list.Body)
+	}
+
+	for session, score := range map[*apiclient.ClientWithResponses]int{alice: 5, bob: 2} {
+		if resp, err := session.RateMovieWithResponse(ctx, "tt0000001", apiclient.RatingRequest{Score: score}); err != nil || resp.StatusCode() != http.StatusOK {
+			t.Fatalf("rate: %v %v", resp, err)
+		}
+	}
+	invalid, err := alice.RateMovieWithResponse(ctx, "tt0000001", apiclient.RatingRequest{Score: 6})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invalid.StatusCode() != http.StatusBadRequest || problemField(t, invalid.Body) != "score" {
+		t.Fatalf("score 6: got status %d: %s, want 400 on score", invalid.StatusCode(), invalid.Body)
+	}
+	missing, err := alice.RateMovieWithResponse(ctx, "tt9999999", apiclient.RatingRequest{Score: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if missing.StatusCode() != http.StatusNotFound {
+		t.Fatalf("unknown movie: got status %d, want 404", missing.StatusCode())
+	}
+
+	admin := h.login("admin@example.com", apiclient.RegisterRequestRoleADMIN)
+	if resp, err := admin.GetMovieWithResponse(ctx, "tt0000001", nil); err != nil || resp.StatusCode() != http.StatusOK {
+		t.Fatalf("movie: %v %v", resp, err)
+	}
+	stats, err := admin.GetMovieStatsWithResponse(ctx, "tt0000001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.JSON200 == nil {
+		t.Fatalf("stats: status %d: %s", stats.StatusCode(), stats.Body)
+	}
+	got := stats.JSON200
+	// Scores decay at a different rate in each window, so only the counts
+	// match.
+	for _, window := range []apiclient.ActivityStats{got.Day, got.Week, got.Month} {
+		if window.Views != 1 || window.Ratings != 2 || window.WatchlistAdds != 2 {
+			t.Fatalf("got activity %+v, want 1 view, 2 ratings and 2 watchlist adds in every window", got)
+		}
+	}
+	if got.RatingCount != 2 || got.AverageRating != 3.5 || got.Watchlisted != 2 {
+		t.Fatalf("got %d ratings averaging %v on %d watchlists, want 2 averaging 3.5 on 2", got.RatingCount, got.AverageRating, got.Watchlisted)
+	}
+
+	forbidden, err := alice.GetMovieStatsWithResponse(ctx, "tt0000001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forbidden.StatusCode() != http.StatusForbidden {
+		t.Fatalf("stats as user: got status %d, want 403", forbidden.StatusCode())
+	}
+}
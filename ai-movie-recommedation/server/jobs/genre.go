@@ -0,0 +1,89 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/respcache"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// cascadeGenre copies the genre's current name into every movie, user and
+// profile that embeds it. Running it twice is harmless, so a retry after a partial run
+// simply finishes the work.
+func (w *Worker) cascadeGenre(ctx context.Context, job *models.Job) (bson.D, error) {
+	var genre models.Genre
+	err := database.OpenCollection("genres", w.Client).FindOne(ctx, bson.D{{Key: "genre_id", Value: job.GenreID}}).Decode(&genre)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, permanent(fmt.Errorf("genre %d no longer exists", job.GenreID))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	movies, err := renameEmbedded(ctx, database.OpenCollection("movies", w.Client), "genre", genre)
+	if err != nil {
+		return nil, err
+	}
+	if movies > 0 {
+		respcache.Invalidate(respcache.ScopeMovies)
+	}
+	users, err := renameEmbedded(ctx, database.OpenCollection("users", w.Client), "favourite_genres", genre)
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := renameEmbedded(ctx, database.OpenCollection("profiles", w.Client), "favourite_genres", genre)
+	if err != nil {
+		return nil, err
+	}
+	return bson.D{{Key: "updated", Value: movies + users + profiles}}, nil
+}
+
+// renameEmbedded rewrites the genre arrays in field that hold a stale copy of
+// genre and returns how many documents changed.
+func renameEmbedded(ctx context.Context, coll *mongo.Collection, field string, genre models.Genre) (int, error) {
+	filter := bson.D{{Key: field + ".genre_id", Value: genre.GenreID}}
+	cursor, err := coll.Find(ctx, filter, options.Find().SetProjection(bson.D{{Key: field, Value: 1}}))
+	if err != nil {
+		return 0, err
+	}
+	var docs []bson.Raw
+	if err := cursor.All(ctx, &docs); err != nil {
+		return 0, err
+	}
+
+	var writes []mongo.WriteModel
+	for _, doc := range docs {
+		var genres []models.Genre
+		if err := doc.Lookup(field).Unmarshal(&genres); err != nil {
+			return 0, err
+		}
+		stale := false
+		for i := range genres {
+			if genres[i].GenreID == genre.GenreID && genres[i].GenreName != genre.GenreName {
+				genres[i].GenreName = genre.GenreName
+				stale = true
+			}
+		}
+		if !stale {
+			continue
+		}
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "_id", Value: doc.Lookup("_id")}}).
+			SetUpdate(bson.D{{Key: "$set", Value: bson.D{{Key: field, Value: genres}}}}))
+	}
+	if len(writes) == 0 {
+		return 0, nil
+	}
+
+	result, err := coll.BulkWrite(ctx, writes)
+	if err != nil {
+		return 0, err
+	}
+	return int(result.MatchedCount), nil
+}
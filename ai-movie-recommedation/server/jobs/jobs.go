@@ -0,0 +1,168 @@
+// Package jobs is a small job queue persisted in the jobs collection. HTTP
+// handlers enqueue work and return immediately; a Worker claims due jobs,
+// runs them and retries failures with exponential backoff.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	// TypeReviewRanking classifies one movie's admin review and stores the
+	// resulting ranking on the movie.
+	TypeReviewRanking = "review_ranking"
+	// TypeRerankAll queues a review ranking job for every reviewed movie.
+	TypeRerankAll = "rerank_all"
+	// TypeMovieSummary writes a movie's synopsis, mood tags and content
+	// warnings and stores them on the movie.
+	TypeMovieSummary = "movie_summary"
+	// TypeGenreCascade copies a renamed genre's name into the movies,
+	// users and profiles that embed it.
+	TypeGenreCascade = "genre_cascade"
+)
+
+const (
+	StatusPending    = "pending"
+	StatusRunning    = "running"
+	StatusSucceeded  = "succeeded"
+	StatusFailed     = "failed"
+	StatusSuperseded = "superseded"
+)
+
+// MaxAttempts is how many times a job runs before it is marked failed.
+const MaxAttempts = 5
+
+func collection(client *mongo.Client) *mongo.Collection {
+	return database.OpenCollection("jobs", client)
+}
+
+func newJob(jobType string) *models.Job {
+	now := time.Now().UTC()
+	return &models.Job{
+		Type:        jobType,
+		Status:      StatusPending,
+		MaxAttempts: MaxAttempts,
+		RunAfter:    now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+func insert(ctx context.Context, client *mongo.Client, job *models.Job) (*models.Job, error) {
+	result, err := collection(client).InsertOne(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	job.ID = result.InsertedID.(bson.ObjectID)
+	return job, nil
+}
+
+// supersedePending marks the pending jobs of jobType for one subject, such as
+// a movie's imdb_id, superseded.
+func supersedePending(ctx context.Context, client *mongo.Client, jobType string, subject bson.E) error {
+	now := time.Now().UTC()
+	_, err := collection(client).UpdateMany(ctx,
+		bson.D{
+			{Key: "type", Value: jobType},
+			subject,
+			{Key: "status", Value: StatusPending},
+		},
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "status", Value: StatusSuperseded},
+			{Key: "updated_at", Value: now},
+			{Key: "finished_at", Value: now},
+		}}})
+	return err
+}
+
+// EnqueueReviewRanking queues classification of adminReview for a movie.
+// Pending jobs for the same movie are superseded, since only the latest
+// review matters.
+func EnqueueReviewRanking(ctx context.Context, client *mongo.Client, imdbID, adminReview string) (*models.Job, error) {
+	if err := supersedePending(ctx, client, TypeReviewRanking, bson.E{Key: "imdb_id", Value: imdbID}); err != nil {
+		return nil, err
+	}
+
+	job := newJob(TypeReviewRanking)
+	job.ImdbID = imdbID
+	job.AdminReview = adminReview
+	return insert(ctx, client, job)
+}
+
+// EnqueueMovieSummary queues a summary of the movie as it is when the job
+// runs. Pending summary jobs for the same movie are superseded.
+func EnqueueMovieSummary(ctx context.Context, client *mongo.Client, imdbID string) (*models.Job, error) {
+	if err := supersedePending(ctx, client, TypeMovieSummary, bson.E{Key: "imdb_id", Value: imdbID}); err != nil {
+		return nil, err
+	}
+
+	job := newJob(TypeMovieSummary)
+	job.ImdbID = imdbID
+	return insert(ctx, client, job)
+}
+
+// EnqueueGenreCascade queues an update of the copies of a genre embedded in
+// movies, users and profiles. The job reads the genre's name when it runs, so
+// pending cascades for the same genre are superseded.
+func EnqueueGenreCascade(ctx context.Context, client *mongo.Client, genreID int) (*models.Job, error) {
+	if err := supersedePending(ctx, client, TypeGenreCascade, bson.E{Key: "genre_id", Value: genreID}); err != nil {
+		return nil, err
+	}
+
+	job := newJob(TypeGenreCascade)
+	job.GenreID = genreID
+	return insert(ctx, client, job)
+}
+
+// EnqueueRerankAll queues a rerank_all job, or returns the one that is
+// already pending.
+func EnqueueRerankAll(ctx context.Context, client *mongo.Client, reason string) (*models.Job, error) {
+	var pending models.Job
+	err := collection(client).FindOne(ctx, bson.D{
+		{Key: "type", Value: TypeRerankAll},
+		{Key: "status", Value: StatusPending},
+	}).Decode(&pending)
+	if err == nil {
+		return &pending, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
+	job := newJob(TypeRerankAll)
+	job.Reason = reason
+	return insert(ctx, client, job)
+}
+
+// Get returns the job with the given id, or mongo.ErrNoDocuments.
+func Get(ctx context.Context, client *mongo.Client, id bson.ObjectID) (*models.Job, error) {
+	var job models.Job
+	if err := collection(client).FindOne(ctx, bson.D{{Key: "_id", Value: id}}).Decode(&job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// LatestForMovie returns the most recently queued review ranking job for a
+// movie, or mongo.ErrNoDocuments.
+func LatestForMovie(ctx context.Context, client *mongo.Client, imdbID string) (*models.Job, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}})
+
+	var job models.Job
+	err := collection(client).FindOne(ctx, bson.D{
+		{Key: "type", Value: TypeReviewRanking},
+		{Key: "imdb_id", Value: imdbID},
+	}, opts).Decode(&job)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
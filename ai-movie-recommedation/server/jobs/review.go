@@ -0,0 +1,170 @@
+package jobs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/events"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/respcache"
+	"github.com/princepal9120/ai-movie-recommedation/server/review"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// rankReview classifies the movie's review and stores the ranking along with
+// the prompt version that produced it. The job is superseded when the review
+// changed after it was queued: the job queued for the newer review ranks that
+// one.
+func (w *Worker) rankReview(ctx context.Context, job *models.Job) (bson.D, error) {
+	var movieCollection *mongo.Collection = database.OpenCollection("movies", w.Client)
+
+	var movie models.Movie
+	err := movieCollection.FindOne(ctx, bson.D{{Key: "imdb_id", Value: job.ImdbID}}).Decode(&movie)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, permanent(fmt.Errorf("movie %s no longer exists", job.ImdbID))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if movie.AdminReview != job.AdminReview {
+		return nil, errSuperseded
+	}
+
+	classified, err := review.Classify(ctx, w.Client, job.AdminReview)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := movieCollection.UpdateOne(ctx,
+		bson.D{{Key: "imdb_id", Value: job.ImdbID}, {Key: "admin_review", Value: job.AdminReview}},
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "ranking", Value: classified.Ranking},
+			{Key: "ranking_prompt", Value: classified.Prompt},
+		}}})
+	if err != nil {
+		return nil, err
+	}
+	if result.MatchedCount == 0 {
+		return nil, errSuperseded
+	}
+	respcache.Invalidate(respcache.ScopeMovies)
+	if movie.Ranking != classified.Ranking {
+		events.Publish(events.RankingEvent(job.ImdbID, classified.Ranking))
+	}
+	return bson.D{{Key: "ranking", Value: classified.Ranking}, {Key: "prompt", Value: classified.Prompt}}, nil
+}
+
+// rerankAll queues a review ranking job for every movie with a review.
+func (w *Worker) rerankAll(ctx context.Context, _ *models.Job) (bson.D, error) {
+	var movieCollection *mongo.Collection = database.OpenCollection("movies", w.Client)
+
+	filter := bson.D{{Key: "admin_review", Value: bson.D{{Key: "$nin", Value: bson.A{nil, ""}}}}}
+	opts := options.Find().SetProjection(bson.D{{Key: "imdb_id", Value: 1}, {Key: "admin_review", Value: 1}})
+
+	cursor, err := movieCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var reviewed []models.Movie
+	if err := cursor.All(ctx, &reviewed); err != nil {
+		return nil, err
+	}
+
+	for _, movie := range reviewed {
+		if _, err := EnqueueReviewRanking(ctx, w.Client, movie.ImdbID, movie.AdminReview); err != nil {
+			return nil, err
+		}
+	}
+	return bson.D{{Key: "enqueued", Value: len(reviewed)}}, nil
+}
+
+const rankingsStateID = "rankings"
+
+// CheckRankings compares the rankings collection with the fingerprint stored
+// by the previous check and queues a rerank_all job when they differ. The
+// first check only stores the fingerprint. When several servers check at
+// once, only the one that swaps the stored fingerprint queues the job.
+func CheckRankings(ctx context.Context, client *mongo.Client) (*models.Job, error) {
+	rankings, err := review.Rankings(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := rankingsFingerprint(rankings)
+	now := time.Now().UTC()
+
+	state := database.OpenCollection("job_state", client)
+
+	var stored struct {
+		Fingerprint string `bson:"fingerprint"`
+	}
+	err = state.FindOne(ctx, bson.D{{Key: "_id", Value: rankingsStateID}}).Decode(&stored)
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		_, err = state.InsertOne(ctx, bson.D{
+			{Key: "_id", Value: rankingsStateID},
+			{Key: "fingerprint", Value: fingerprint},
+			{Key: "updated_at", Value: now},
+		})
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, nil
+		}
+		return nil, err
+	case err != nil:
+		return nil, err
+	case stored.Fingerprint == fingerprint:
+		return nil, nil
+	}
+
+	result, err := state.UpdateOne(ctx,
+		bson.D{{Key: "_id", Value: rankingsStateID}, {Key: "fingerprint", Value: stored.Fingerprint}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "fingerprint", Value: fingerprint}, {Key: "updated_at", Value: now}}}})
+	if err != nil {
+		return nil, err
+	}
+	if result.ModifiedCount == 0 {
+		return nil, nil
+	}
+	return EnqueueRerankAll(ctx, client, "rankings changed")
+}
+
+// RecordRankings stores the fingerprint of the current rankings, so that a
+// change already handled, such as a new scale saved through the API, does not
+// also trigger CheckRankings.
+func RecordRankings(ctx context.Context, client *mongo.Client) error {
+	rankings, err := review.Rankings(ctx, client)
+	if err != nil {
+		return err
+	}
+	_, err = database.OpenCollection("job_state", client).UpdateOne(ctx,
+		bson.D{{Key: "_id", Value: rankingsStateID}},
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "fingerprint", Value: rankingsFingerprint(rankings)},
+			{Key: "updated_at", Value: time.Now().UTC()},
+		}}},
+		options.UpdateOne().SetUpsert(true))
+	return err
+}
+
+func rankingsFingerprint(rankings []models.Ranking) string {
+	sorted := append([]models.Ranking(nil), rankings...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].RankingValue != sorted[j].RankingValue {
+			return sorted[i].RankingValue < sorted[j].RankingValue
+		}
+		return sorted[i].RankingName < sorted[j].RankingName
+	})
+
+	hash := sha256.New()
+	for _, ranking := range sorted {
+		fmt.Fprintf(hash, "%d\t%s\n", ranking.RankingValue, ranking.RankingName)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,50 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/respcache"
+	"github.com/princepal9120/ai-movie-recommedation/server/summary"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// summarizeMovie writes a summary of the movie's current review and metadata.
+// The job is superseded when the review changed while the LLM was answering:
+// the summary job queued with the newer review covers it.
+func (w *Worker) summarizeMovie(ctx context.Context, job *models.Job) (bson.D, error) {
+	var movieCollection *mongo.Collection = database.OpenCollection("movies", w.Client)
+
+	var movie models.Movie
+	err := movieCollection.FindOne(ctx, bson.D{{Key: "imdb_id", Value: job.ImdbID}}).Decode(&movie)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, permanent(fmt.Errorf("movie %s no longer exists", job.ImdbID))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	generated, err := summary.Generate(ctx, w.Client, &movie)
+	if errors.Is(err, summary.ErrNothingToSummarize) {
+		return nil, permanent(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := movieCollection.UpdateOne(ctx,
+		bson.D{{Key: "imdb_id", Value: job.ImdbID}, {Key: "admin_review", Value: movie.AdminReview}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "summary", Value: generated}}}})
+	if err != nil {
+		return nil, err
+	}
+	if result.MatchedCount == 0 {
+		return nil, errSuperseded
+	}
+	respcache.Invalidate(respcache.ScopeMovies)
+	return bson.D{{Key: "prompt", Value: generated.Prompt}}, nil
+}
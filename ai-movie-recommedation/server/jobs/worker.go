@@ -0,0 +1,246 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/llm"
+	"github.com/princepal9120/ai-movie-recommedation/server/metrics"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// errSuperseded ends a job without retrying it because newer work replaced it.
+var errSuperseded = errors.New("superseded by newer work")
+
+// permanentError marks a failure that retrying cannot fix.
+type permanentError struct{ err error }
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+func permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// Worker claims due jobs one at a time. Several workers, in one process or
+// many, can share the queue: a claim is an atomic findAndModify that leases
+// the job for Lease, and a job whose lease expired is claimed again.
+type Worker struct {
+	Client        *mongo.Client
+	PollInterval  time.Duration
+	Lease         time.Duration
+	RetryDelay    time.Duration
+	MaxRetryDelay time.Duration
+	// RankingsCheckInterval is how often CheckRankings runs; 0 disables it.
+	RankingsCheckInterval time.Duration
+}
+
+// NewWorker configures a Worker from the environment: JOB_POLL_INTERVAL
+// (default 2s), JOB_RETRY_DELAY (default 30s, doubled after every failed
+// attempt up to 10m) and RANKINGS_CHECK_INTERVAL (default 1m, 0 disables).
+func NewWorker(client *mongo.Client) (*Worker, error) {
+	pollInterval, err := durationEnv("JOB_POLL_INTERVAL", 2*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	if pollInterval <= 0 {
+		return nil, errors.New("JOB_POLL_INTERVAL must be positive")
+	}
+	retryDelay, err := durationEnv("JOB_RETRY_DELAY", 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	rankingsCheck, err := durationEnv("RANKINGS_CHECK_INTERVAL", time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Worker{
+		Client:                client,
+		PollInterval:          pollInterval,
+		Lease:                 5 * time.Minute,
+		RetryDelay:            retryDelay,
+		MaxRetryDelay:         10 * time.Minute,
+		RankingsCheckInterval: rankingsCheck,
+	}, nil
+}
+
+func durationEnv(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return d, nil
+}
+
+// Run drains due jobs every PollInterval, and checks the rankings every
+// RankingsCheckInterval, until ctx is done.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.PollInterval)
+	defer ticker.Stop()
+
+	var lastRankingsCheck time.Time
+	for {
+		if w.RankingsCheckInterval > 0 && time.Since(lastRankingsCheck) >= w.RankingsCheckInterval {
+			lastRankingsCheck = time.Now()
+			job, err := CheckRankings(ctx, w.Client)
+			if err != nil {
+				slog.Error("rankings check failed", "error", err)
+			} else if job != nil {
+				slog.Info("rankings changed, re-ranking reviewed movies", "job_id", job.ID.Hex())
+			}
+		}
+
+		for ctx.Err() == nil {
+			ran, err := w.RunOnce(ctx)
+			if err != nil {
+				slog.Error("job queue poll failed", "error", err)
+				break
+			}
+			if !ran {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce claims and runs one due job. It reports false when none was due.
+func (w *Worker) RunOnce(ctx context.Context) (bool, error) {
+	job, err := w.claim(ctx)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, w.Lease)
+	result, runErr := w.execute(runCtx, job)
+	cancel()
+
+	// Record the outcome even when shutdown cancelled the run; otherwise the
+	// job stays leased until Lease expires.
+	return true, w.finish(context.WithoutCancel(ctx), job, result, runErr)
+}
+
+func (w *Worker) claim(ctx context.Context) (*models.Job, error) {
+	now := time.Now().UTC()
+	filter := bson.D{{Key: "$or", Value: bson.A{
+		bson.D{
+			{Key: "status", Value: StatusPending},
+			{Key: "run_after", Value: bson.D{{Key: "$lte", Value: now}}},
+		},
+		bson.D{
+			{Key: "status", Value: StatusRunning},
+			{Key: "locked_until", Value: bson.D{{Key: "$lt", Value: now}}},
+		},
+	}}}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "status", Value: StatusRunning},
+			{Key: "locked_until", Value: now.Add(w.Lease)},
+			{Key: "updated_at", Value: now},
+		}},
+		{Key: "$inc", Value: bson.D{{Key: "attempts", Value: 1}}},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "run_after", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var job models.Job
+	if err := collection(w.Client).FindOneAndUpdate(ctx, filter, update, opts).Decode(&job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (w *Worker) execute(ctx context.Context, job *models.Job) (bson.D, error) {
+	switch job.Type {
+	case TypeReviewRanking:
+		return w.rankReview(ctx, job)
+	case TypeRerankAll:
+		return w.rerankAll(ctx, job)
+	case TypeMovieSummary:
+		return w.summarizeMovie(ctx, job)
+	case TypeGenreCascade:
+		return w.cascadeGenre(ctx, job)
+	default:
+		return nil, permanent(fmt.Errorf("unknown job type %q", job.Type))
+	}
+}
+
+// finish records the outcome of a run. The update only applies while this
+// worker still holds the lease, so a job reclaimed after a slow run is not
+// overwritten.
+func (w *Worker) finish(ctx context.Context, job *models.Job, result bson.D, runErr error) error {
+	now := time.Now().UTC()
+	set := bson.D{{Key: "updated_at", Value: now}}
+	update := bson.D{{Key: "$unset", Value: bson.D{{Key: "locked_until", Value: ""}}}}
+	logger := slog.With("job_id", job.ID.Hex(), "type", job.Type, "attempt", job.Attempts)
+
+	var status, outcome string
+	var budgetErr *llm.BudgetError
+	switch {
+	case runErr == nil:
+		status, outcome = StatusSucceeded, StatusSucceeded
+		set = append(set, bson.E{Key: "finished_at", Value: now})
+		set = append(set, result...)
+		logger.Info("job finished", "status", status)
+	case errors.Is(runErr, errSuperseded):
+		status, outcome = StatusSuperseded, StatusSuperseded
+		set = append(set, bson.E{Key: "finished_at", Value: now})
+		logger.Info("job finished", "status", status)
+	case errors.As(runErr, &budgetErr):
+		// Waiting for the budget to reset is not the job's fault, so the
+		// attempt is given back.
+		status, outcome = StatusPending, "deferred"
+		set = append(set, bson.E{Key: "last_error", Value: runErr.Error()}, bson.E{Key: "run_after", Value: budgetErr.ResetAt})
+		update = append(update, bson.E{Key: "$inc", Value: bson.D{{Key: "attempts", Value: -1}}})
+		logger.Warn("LLM budget exhausted, job deferred", "run_after", budgetErr.ResetAt)
+	case errors.As(runErr, new(permanentError)) || job.Attempts >= job.MaxAttempts:
+		status, outcome = StatusFailed, StatusFailed
+		set = append(set, bson.E{Key: "last_error", Value: runErr.Error()}, bson.E{Key: "finished_at", Value: now})
+		logger.Error("job failed", "error", runErr)
+	default:
+		status, outcome = StatusPending, "retried"
+		set = append(set, bson.E{Key: "last_error", Value: runErr.Error()}, bson.E{Key: "run_after", Value: now.Add(w.backoff(job.Attempts))})
+		logger.Warn("job failed, will retry", "error", runErr)
+	}
+	set = append(set, bson.E{Key: "status", Value: status})
+	update = append(update, bson.E{Key: "$set", Value: set})
+	metrics.JobRuns.WithLabelValues(job.Type, outcome).Inc()
+
+	filter := bson.D{{Key: "_id", Value: job.ID}, {Key: "status", Value: StatusRunning}}
+	if job.LockedUntil != nil {
+		filter = append(filter, bson.E{Key: "locked_until", Value: *job.LockedUntil})
+	}
+	_, err := collection(w.Client).UpdateOne(ctx, filter, update)
+	return err
+}
+
+// backoff is RetryDelay doubled for every attempt after the first, capped at
+// MaxRetryDelay.
+func (w *Worker) backoff(attempts int) time.Duration {
+	delay := w.RetryDelay
+	for i := 1; i < attempts && delay < w.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, w.MaxRetryDelay)
+}
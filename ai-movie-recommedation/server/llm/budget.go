@@ -0,0 +1,151 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ErrBudgetExceeded is wrapped by every *BudgetError.
+var ErrBudgetExceeded = errors.New("daily LLM budget exceeded")
+
+// BudgetError reports which daily limit was reached and when it resets.
+type BudgetError struct {
+	Limit   string
+	Used    string
+	ResetAt time.Time
+}
+
+func (e *BudgetError) Error() string {
+	return fmt.Sprintf("daily LLM %s exhausted (used %s); resets at %s", e.Limit, e.Used, e.ResetAt.Format(time.RFC3339))
+}
+
+func (e *BudgetError) Unwrap() error {
+	return ErrBudgetExceeded
+}
+
+// Budget caps LLM consumption per UTC day. Usage is kept in the llm_usage
+// collection, so the limit holds across restarts and server instances. The
+// check happens before each call, so the call that crosses a limit still
+// completes; the ones after it are refused until midnight UTC.
+type Budget struct {
+	Usage *mongo.Collection
+	// DailyTokens caps prompt plus completion tokens; 0 is unlimited.
+	DailyTokens int64
+	// DailyCostUSD caps the estimated spend; 0 is unlimited.
+	DailyCostUSD        float64
+	PromptCostPer1K     float64
+	CompletionCostPer1K float64
+}
+
+// BudgetFromEnv reads LLM_DAILY_TOKEN_BUDGET, LLM_DAILY_COST_BUDGET and the
+// per-1K-token prices used to estimate cost, LLM_PROMPT_COST_PER_1K and
+// LLM_COMPLETION_COST_PER_1K. Unset values are 0.
+func BudgetFromEnv(usage *mongo.Collection) (*Budget, error) {
+	budget := &Budget{Usage: usage}
+
+	if value := os.Getenv("LLM_DAILY_TOKEN_BUDGET"); value != "" {
+		tokens, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || tokens < 0 {
+			return nil, fmt.Errorf("invalid LLM_DAILY_TOKEN_BUDGET %q", value)
+		}
+		budget.DailyTokens = tokens
+	}
+	for key, target := range map[string]*float64{
+		"LLM_DAILY_COST_BUDGET":      &budget.DailyCostUSD,
+		"LLM_PROMPT_COST_PER_1K":     &budget.PromptCostPer1K,
+		"LLM_COMPLETION_COST_PER_1K": &budget.CompletionCostPer1K,
+	} {
+		value := os.Getenv(key)
+		if value == "" {
+			continue
+		}
+		amount, err := strconv.ParseFloat(value, 64)
+		if err != nil || amount < 0 {
+			return nil, fmt.Errorf("invalid %s %q", key, value)
+		}
+		*target = amount
+	}
+	return budget, nil
+}
+
+func day(now time.Time) (string, time.Time) {
+	now = now.UTC()
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return start.Format(time.DateOnly), start.AddDate(0, 0, 1)
+}
+
+// Today returns today's usage together with the configured limits.
+func (b *Budget) Today(ctx context.Context) (*models.LLMUsage, error) {
+	today, resetsAt := day(time.Now())
+
+	usage := models.LLMUsage{Day: today}
+	err := b.Usage.FindOne(ctx, bson.D{{Key: "_id", Value: today}}).Decode(&usage)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	usage.TokenBudget = b.DailyTokens
+	usage.CostBudgetUSD = b.DailyCostUSD
+	usage.ResetsAt = resetsAt
+	return &usage, nil
+}
+
+// Check returns a *BudgetError when today's usage has reached a limit.
+func (b *Budget) Check(ctx context.Context) error {
+	if b.DailyTokens == 0 && b.DailyCostUSD == 0 {
+		return nil
+	}
+	usage, err := b.Today(ctx)
+	if err != nil {
+		return err
+	}
+
+	if tokens := usage.PromptTokens + usage.CompletionTokens; b.DailyTokens > 0 && tokens >= b.DailyTokens {
+		return &BudgetError{
+			Limit:   fmt.Sprintf("token budget of %d", b.DailyTokens),
+			Used:    strconv.FormatInt(tokens, 10),
+			ResetAt: usage.ResetsAt,
+		}
+	}
+	if b.DailyCostUSD > 0 && usage.CostUSD >= b.DailyCostUSD {
+		return &BudgetError{
+			Limit:   fmt.Sprintf("cost budget of $%.2f", b.DailyCostUSD),
+			Used:    fmt.Sprintf("$%.4f", usage.CostUSD),
+			ResetAt: usage.ResetsAt,
+		}
+	}
+	return nil
+}
+
+// Record adds one model call to today's usage.
+func (b *Budget) Record(ctx context.Context, promptTokens, completionTokens int) error {
+	cost := float64(promptTokens)/1000*b.PromptCostPer1K + float64(completionTokens)/1000*b.CompletionCostPer1K
+	return b.add(ctx, bson.D{
+		{Key: "calls", Value: 1},
+		{Key: "prompt_tokens", Value: promptTokens},
+		{Key: "completion_tokens", Value: completionTokens},
+		{Key: "cost_usd", Value: cost},
+	})
+}
+
+// RecordCacheHit counts a call answered from the cache, which costs nothing.
+func (b *Budget) RecordCacheHit(ctx context.Context) error {
+	return b.add(ctx, bson.D{{Key: "cache_hits", Value: 1}})
+}
+
+func (b *Budget) add(ctx context.Context, inc bson.D) error {
+	today, _ := day(time.Now())
+	_, err := b.Usage.UpdateOne(ctx,
+		bson.D{{Key: "_id", Value: today}},
+		bson.D{{Key: "$inc", Value: inc}},
+		options.UpdateOne().SetUpsert(true))
+	return err
+}
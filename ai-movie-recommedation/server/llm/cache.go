@@ -0,0 +1,87 @@
+package llm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Cache stores model answers in the llm_cache collection, keyed by a hash of
+// everything that determines the answer: the operation, the model settings
+// and the full prompt. A prompt built from changed inputs, such as a new set
+// of rankings, therefore never hits an old entry.
+type Cache struct {
+	Entries *mongo.Collection
+}
+
+type cacheEntry struct {
+	Key              string    `bson:"_id"`
+	Operation        string    `bson:"operation"`
+	Model            string    `bson:"model"`
+	Response         string    `bson:"response"`
+	PromptTokens     int       `bson:"prompt_tokens"`
+	CompletionTokens int       `bson:"completion_tokens"`
+	Hits             int64     `bson:"hits"`
+	CreatedAt        time.Time `bson:"created_at"`
+}
+
+// CacheKey is the hex SHA-256 of the operation, model settings and prompt.
+func CacheKey(operation string, config Config, prompt string) string {
+	hash := sha256.New()
+	for _, part := range []string{operation, config.String(), prompt} {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Get returns the cached completion for key and counts the hit. ok is false
+// on a miss.
+func (c *Cache) Get(ctx context.Context, key string) (completion *Completion, ok bool, err error) {
+	var entry cacheEntry
+	err = c.Entries.FindOneAndUpdate(ctx,
+		bson.D{{Key: "_id", Value: key}},
+		bson.D{
+			{Key: "$inc", Value: bson.D{{Key: "hits", Value: 1}}},
+			{Key: "$set", Value: bson.D{{Key: "last_hit_at", Value: time.Now().UTC()}}},
+		}).Decode(&entry)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return &Completion{
+		Text:             entry.Response,
+		PromptTokens:     entry.PromptTokens,
+		CompletionTokens: entry.CompletionTokens,
+		Cached:           true,
+	}, true, nil
+}
+
+// Put stores completion under key, replacing any earlier entry.
+func (c *Cache) Put(ctx context.Context, key, operation string, config Config, completion *Completion) error {
+	entry := cacheEntry{
+		Key:              key,
+		Operation:        operation,
+		Model:            config.String(),
+		Response:         completion.Text,
+		PromptTokens:     completion.PromptTokens,
+		CompletionTokens: completion.CompletionTokens,
+		CreatedAt:        time.Now().UTC(),
+	}
+	_, err := c.Entries.ReplaceOne(ctx, bson.D{{Key: "_id", Value: key}}, entry, options.Replace().SetUpsert(true))
+	return err
+}
+
+// Delete removes the entry for key, if any.
+func (c *Cache) Delete(ctx context.Context, key string) error {
+	_, err := c.Entries.DeleteOne(ctx, bson.D{{Key: "_id", Value: key}})
+	return err
+}
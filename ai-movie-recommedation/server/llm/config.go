@@ -0,0 +1,53 @@
+package llm
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/tmc/langchaingo/llms"
+)
+
+// DefaultModel is the OpenAI model used when LLM_MODEL is not set.
+const DefaultModel = "gpt-3.5-turbo"
+
+// Config holds the per-call model settings.
+type Config struct {
+	Model string
+	// Temperature is nil to leave the provider default in place.
+	Temperature *float64
+}
+
+// ConfigFromEnv reads LLM_MODEL and LLM_TEMPERATURE.
+func ConfigFromEnv() (Config, error) {
+	config := Config{Model: os.Getenv("LLM_MODEL")}
+	if config.Model == "" {
+		config.Model = DefaultModel
+	}
+
+	if value := os.Getenv("LLM_TEMPERATURE"); value != "" {
+		temperature, err := strconv.ParseFloat(value, 64)
+		if err != nil || temperature < 0 || temperature > 2 {
+			return Config{}, fmt.Errorf("invalid LLM_TEMPERATURE %q: must be a number between 0 and 2", value)
+		}
+		config.Temperature = &temperature
+	}
+	return config, nil
+}
+
+// CallOptions applies the config to a GenerateContent call.
+func (c Config) CallOptions() []llms.CallOption {
+	options := []llms.CallOption{llms.WithModel(c.Model)}
+	if c.Temperature != nil {
+		options = append(options, llms.WithTemperature(*c.Temperature))
+	}
+	return options
+}
+
+// String identifies the settings in cache keys and logs.
+func (c Config) String() string {
+	if c.Temperature == nil {
+		return c.Model
+	}
+	return fmt.Sprintf("%s@%g", c.Model, *c.Temperature)
+}
@@ -0,0 +1,236 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/tmc/langchaingo/llms"
+)
+
+// Fake is a deterministic llms.Model. By default it answers with Answer; set
+// Respond to script other answers.
+type Fake struct {
+	Respond func(prompt string) string
+
+	mu      sync.Mutex
+	prompts []string
+}
+
+func NewFake() *Fake {
+	return &Fake{Respond: Answer}
+}
+
+// Answer summarizes prompts that ask for a synopsis with SummarizeByKeyword,
+// answers prompts that ask for filters with FiltersByKeyword and classifies
+// everything else with ClassifyByKeyword.
+func Answer(prompt string) string {
+	switch {
+	case strings.Contains(prompt, `"synopsis"`):
+		return SummarizeByKeyword(prompt)
+	case strings.Contains(prompt, `"exclude_content_warnings"`):
+		return FiltersByKeyword(prompt)
+	default:
+		return ClassifyByKeyword(prompt)
+	}
+}
+
+var reviewKeywords = []struct {
+	ranking  string
+	keywords []string
+}{
+	{"Terrible", []string{"terrible", "awful", "unwatchable", "worst"}},
+	{"Bad", []string{"bad", "boring", "dull", "disappointing"}},
+	{"Excellent", []string{"excellent", "masterpiece", "brilliant", "outstanding", "timeless"}},
+	{"Good", []string{"good", "enjoyable", "fun", "solid"}},
+}
+
+// ClassifyByKeyword finds the review wherever the prompt puts it: one mention
+// of each ranking name, the list the prompt offers to choose from, is taken
+// out and the rest of the prompt is searched for keywords.
+func ClassifyByKeyword(prompt string) string {
+	review := strings.ToLower(prompt)
+	for _, group := range reviewKeywords {
+		review = strings.Replace(review, strings.ToLower(group.ranking), "", 1)
+	}
+	for _, group := range reviewKeywords {
+		for _, keyword := range group.keywords {
+			if strings.Contains(review, keyword) {
+				return group.ranking
+			}
+		}
+	}
+	return "Okay"
+}
+
+var moodKeywords = []struct {
+	tag      string
+	keywords []string
+}{
+	{"funny", []string{"funny", "hilarious", "witty"}},
+	{"dark", []string{"dark", "bleak", "grim"}},
+	{"tense", []string{"tense", "thrilling", "suspense"}},
+	{"heartfelt", []string{"moving", "heartfelt", "touching"}},
+	{"uplifting", []string{"uplifting", "joyful", "hopeful", "light"}},
+}
+
+var warningKeywords = []struct {
+	warning  string
+	keywords []string
+}{
+	{"violence", []string{"violen", "gore", "bloody"}},
+	{"strong language", []string{"swear", "profan", "language"}},
+	{"drug use", []string{"drug"}},
+	{"frightening scenes", []string{"scary", "horror", "frighten"}},
+}
+
+// SummarizeByKeyword answers a movie summary prompt with JSON. The synopsis
+// names the movie from the "Title:" line; mood tags and content warnings come
+// from keywords in the text after the last newline, where the review is.
+func SummarizeByKeyword(prompt string) string {
+	title := "this movie"
+	for _, line := range strings.Split(prompt, "\n") {
+		if rest, ok := strings.CutPrefix(line, "Title: "); ok {
+			title = rest
+		}
+	}
+	review := strings.ToLower(prompt[strings.LastIndex(prompt, "\n")+1:])
+
+	moods, warnings := []string{}, []string{}
+	for _, group := range moodKeywords {
+		for _, keyword := range group.keywords {
+			if strings.Contains(review, keyword) {
+				moods = append(moods, group.tag)
+				break
+			}
+		}
+	}
+	for _, group := range warningKeywords {
+		for _, keyword := range group.keywords {
+			if strings.Contains(review, keyword) {
+				warnings = append(warnings, group.warning)
+				break
+			}
+		}
+	}
+
+	answer, _ := json.Marshal(map[string]any{
+		"synopsis":         "A film called " + title + ".",
+		"mood_tags":        moods,
+		"content_warnings": warnings,
+	})
+	return string(answer)
+}
+
+var (
+	afterYear  = regexp.MustCompile(`(?:after|since|from) ((?:19|20)[0-9]{2})`)
+	beforeYear = regexp.MustCompile(`before ((?:19|20)[0-9]{2})`)
+)
+
+// FiltersByKeyword answers a recommendation filters prompt with JSON, reading
+// the request from the text after the last newline:
+//   - a known genre is picked when the request contains its name or, for
+//     longer names, its first five letters ("animated" picks "Animation");
+//   - mood tags come from the same keywords as SummarizeByKeyword;
+//   - "family" or "kids" excludes every known content warning;
+//   - "after 1990" and "before 2000" bound the year, and "short" the runtime.
+func FiltersByKeyword(prompt string) string {
+	known := func(label string) []string {
+		for _, line := range strings.Split(prompt, "\n") {
+			if rest, ok := strings.CutPrefix(line, label+": "); ok && rest != "" {
+				return strings.Split(rest, ", ")
+			}
+		}
+		return nil
+	}
+	request := strings.ToLower(prompt[strings.LastIndex(prompt, "\n")+1:])
+
+	genres := []string{}
+	for _, genre := range known("Known genres") {
+		stem := strings.ToLower(genre)
+		if len(stem) > 5 {
+			stem = stem[:5]
+		}
+		if strings.Contains(request, stem) {
+			genres = append(genres, genre)
+		}
+	}
+
+	moods := []string{}
+	for _, group := range moodKeywords {
+		for _, keyword := range group.keywords {
+			if strings.Contains(request, keyword) {
+				moods = append(moods, group.tag)
+				break
+			}
+		}
+	}
+
+	warnings := []string{}
+	if strings.Contains(request, "family") || strings.Contains(request, "kids") {
+		warnings = append(warnings, known("Known content warnings")...)
+	}
+
+	var minYear, maxYear, maxRuntime int
+	if match := afterYear.FindStringSubmatch(request); match != nil {
+		minYear, _ = strconv.Atoi(match[1])
+	}
+	if match := beforeYear.FindStringSubmatch(request); match != nil {
+		maxYear, _ = strconv.Atoi(match[1])
+		maxYear--
+	}
+	if strings.Contains(request, "short") {
+		maxRuntime = 100
+	}
+
+	answer, _ := json.Marshal(map[string]any{
+		"genres":                   genres,
+		"mood_tags":                moods,
+		"exclude_content_warnings": warnings,
+		"min_year":                 minYear,
+		"max_year":                 maxYear,
+		"max_runtime_minutes":      maxRuntime,
+		"reply":                    "Here is what I found for you.",
+	})
+	return string(answer)
+}
+
+func (f *Fake) GenerateContent(_ context.Context, messages []llms.MessageContent, _ ...llms.CallOption) (*llms.ContentResponse, error) {
+	var prompt strings.Builder
+	for _, message := range messages {
+		for _, part := range message.Parts {
+			if text, ok := part.(llms.TextContent); ok {
+				prompt.WriteString(text.Text)
+			}
+		}
+	}
+
+	f.mu.Lock()
+	f.prompts = append(f.prompts, prompt.String())
+	f.mu.Unlock()
+
+	answer := f.Respond(prompt.String())
+	return &llms.ContentResponse{
+		Choices: []*llms.ContentChoice{{
+			Content: answer,
+			GenerationInfo: map[string]any{
+				"PromptTokens":     len(strings.Fields(prompt.String())),
+				"CompletionTokens": len(strings.Fields(answer)),
+			},
+		}},
+	}, nil
+}
+
+func (f *Fake) Call(ctx context.Context, prompt string, options ...llms.CallOption) (string, error) {
+	return llms.GenerateFromSinglePrompt(ctx, f, prompt, options...)
+}
+
+// Prompts returns every prompt the fake has received.
+func (f *Fake) Prompts() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.prompts...)
+}
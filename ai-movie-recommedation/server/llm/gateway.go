@@ -0,0 +1,138 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/metrics"
+	"github.com/princepal9120/ai-movie-recommedation/server/tracing"
+	"github.com/tmc/langchaingo/llms"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Completion is the model's answer to one prompt.
+type Completion struct {
+	Text             string
+	PromptTokens     int
+	CompletionTokens int
+	Cached           bool
+}
+
+// Gateway is how the server talks to the model: answers are served from the
+// Cache when possible, and calls are refused once the daily Budget is spent.
+type Gateway struct {
+	Model  llms.Model
+	Config Config
+	// Cache is nil when LLM_CACHE is "off".
+	Cache  *Cache
+	Budget *Budget
+}
+
+// NewGateway combines New, ConfigFromEnv and BudgetFromEnv, storing cache
+// entries and usage in the database.
+func NewGateway(client *mongo.Client) (*Gateway, error) {
+	model, err := New()
+	if err != nil {
+		return nil, err
+	}
+	config, err := ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	budget, err := BudgetFromEnv(database.OpenCollection("llm_usage", client))
+	if err != nil {
+		return nil, err
+	}
+
+	gateway := &Gateway{Model: model, Config: config, Budget: budget}
+	if !strings.EqualFold(os.Getenv("LLM_CACHE"), "off") {
+		gateway.Cache = &Cache{Entries: database.OpenCollection("llm_cache", client)}
+	}
+	return gateway, nil
+}
+
+// Complete answers prompt. operation labels metrics, traces and cache entries.
+// When the budget is spent it returns a *BudgetError without calling the model.
+func (g *Gateway) Complete(ctx context.Context, operation, prompt string) (*Completion, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "llm "+operation)
+	defer span.End()
+	span.SetAttributes(attribute.String("gen_ai.system", Provider()), attribute.String("gen_ai.request.model", g.Config.Model))
+
+	key := CacheKey(operation, g.Config, prompt)
+	if g.Cache != nil {
+		completion, ok, err := g.Cache.Get(ctx, key)
+		if err != nil {
+			slog.Warn("LLM cache lookup failed", "operation", operation, "error", err)
+		}
+		span.SetAttributes(attribute.Bool("llm.cache_hit", ok))
+		if ok {
+			metrics.LLMCacheLookups.WithLabelValues(operation, "hit").Inc()
+			if err := g.Budget.RecordCacheHit(ctx); err != nil {
+				slog.Warn("failed to record LLM usage", "operation", operation, "error", err)
+			}
+			return completion, nil
+		}
+		metrics.LLMCacheLookups.WithLabelValues(operation, "miss").Inc()
+	}
+
+	if err := g.Budget.Check(ctx); err != nil {
+		if errors.Is(err, ErrBudgetExceeded) {
+			metrics.LLMCalls.WithLabelValues(operation, "budget_exceeded").Inc()
+		}
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	start := time.Now()
+	content, err := g.Model.GenerateContent(ctx, []llms.MessageContent{
+		llms.TextParts(llms.ChatMessageTypeHuman, prompt),
+	}, g.Config.CallOptions()...)
+	metrics.LLMCallDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	metrics.LLMCalls.WithLabelValues(operation, metrics.Outcome(err)).Inc()
+
+	if err == nil && len(content.Choices) == 0 {
+		err = errors.New("empty response from LLM")
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	generationInfo := content.Choices[0].GenerationInfo
+	metrics.ObserveTokenUsage(operation, generationInfo)
+	completion := &Completion{Text: strings.TrimSpace(content.Choices[0].Content)}
+	if promptTokens, ok := generationInfo["PromptTokens"].(int); ok {
+		completion.PromptTokens = promptTokens
+		span.SetAttributes(attribute.Int("gen_ai.usage.input_tokens", promptTokens))
+	}
+	if completionTokens, ok := generationInfo["CompletionTokens"].(int); ok {
+		completion.CompletionTokens = completionTokens
+		span.SetAttributes(attribute.Int("gen_ai.usage.output_tokens", completionTokens))
+	}
+
+	if err := g.Budget.Record(ctx, completion.PromptTokens, completion.CompletionTokens); err != nil {
+		slog.Warn("failed to record LLM usage", "operation", operation, "error", err)
+	}
+	if g.Cache != nil {
+		if err := g.Cache.Put(ctx, key, operation, g.Config, completion); err != nil {
+			slog.Warn("failed to cache LLM response", "operation", operation, "error", err)
+		}
+	}
+	return completion, nil
+}
+
+// Forget drops the cached answer to prompt, for callers that found it
+// unusable; the next Complete asks the model again.
+func (g *Gateway) Forget(ctx context.Context, operation, prompt string) error {
+	if g.Cache == nil {
+		return nil
+	}
+	return g.Cache.Delete(ctx, CacheKey(operation, g.Config, prompt))
+}
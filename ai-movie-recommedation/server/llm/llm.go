@@ -0,0 +1,95 @@
+package llm
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/tmc/langchaingo/llms"
+	"github.com/tmc/langchaingo/llms/openai"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+var (
+	mu       sync.RWMutex
+	override llms.Model
+
+	// The OpenAI client is reused across calls; it is rebuilt only when the
+	// API key changes.
+	openAIMu    sync.Mutex
+	openAIKey   string
+	openAIModel llms.Model
+)
+
+// New returns the model selected by LLM_PROVIDER: "openai" (the default) or
+// "fake" for offline development.
+func New() (llms.Model, error) {
+	mu.RLock()
+	model := override
+	mu.RUnlock()
+	if model != nil {
+		return model, nil
+	}
+
+	switch strings.ToLower(os.Getenv("LLM_PROVIDER")) {
+	case "", "openai":
+		return newOpenAI()
+	case "fake":
+		return NewFake(), nil
+	default:
+		return nil, errors.New("unsupported LLM_PROVIDER " + os.Getenv("LLM_PROVIDER"))
+	}
+}
+
+// Provider names the backend New would use, for telemetry attributes.
+func Provider() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	if override != nil {
+		return "override"
+	}
+	if provider := strings.ToLower(os.Getenv("LLM_PROVIDER")); provider != "" {
+		return provider
+	}
+	return "openai"
+}
+
+// Use makes New return model until the returned restore function is called.
+// It is intended for tests.
+func Use(model llms.Model) (restore func()) {
+	mu.Lock()
+	previous := override
+	override = model
+	mu.Unlock()
+
+	return func() {
+		mu.Lock()
+		override = previous
+		mu.Unlock()
+	}
+}
+
+func newOpenAI() (llms.Model, error) {
+	apiKey := os.Getenv("OPENAI_API_KEY")
+	if apiKey == "" {
+		return nil, errors.New("could not read OPENAI_API_KEY")
+	}
+
+	openAIMu.Lock()
+	defer openAIMu.Unlock()
+	if openAIModel != nil && openAIKey == apiKey {
+		return openAIModel, nil
+	}
+
+	model, err := openai.New(
+		openai.WithToken(apiKey),
+		openai.WithHTTPClient(&http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	openAIKey, openAIModel = apiKey, model
+	return model, nil
+}
@@ -0,0 +1,117 @@
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+	"strings"
+)
+
+type contextKey struct{}
+
+const redacted = "[REDACTED]"
+
+// Keys whose values must never reach the logs, matched case-insensitively.
+var secretKeys = map[string]bool{
+	"password":       true,
+	"token":          true,
+	"access_token":   true,
+	"refresh_token":  true,
+	"authorization":  true,
+	"cookie":         true,
+	"secret":         true,
+	"secret_key":     true,
+	"api_key":        true,
+	"openai_api_key": true,
+}
+
+func Init() *slog.Logger {
+	logger := New(os.Stdout, os.Getenv("LOG_FORMAT"), os.Getenv("LOG_LEVEL"))
+	slog.SetDefault(logger)
+	return logger
+}
+
+func New(w io.Writer, format, level string) *slog.Logger {
+	opts := &slog.HandlerOptions{
+		Level:       parseLevel(level),
+		ReplaceAttr: redactAttr,
+	}
+
+	var handler slog.Handler
+	if strings.EqualFold(format, "text") {
+		handler = slog.NewTextHandler(w, opts)
+	} else {
+		handler = slog.NewJSONHandler(w, opts)
+	}
+
+	return slog.New(handler)
+}
+
+func parseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+func redactAttr(groups []string, a slog.Attr) slog.Attr {
+	key := strings.ToLower(a.Key)
+
+	switch {
+	case secretKeys[key]:
+		return slog.String(a.Key, redacted)
+	case key == "email":
+		return slog.String(a.Key, MaskEmail(a.Value.String()))
+	case strings.HasSuffix(key, "_uri") || strings.HasSuffix(key, "_url"):
+		return slog.String(a.Key, RedactURI(a.Value.String()))
+	}
+
+	return a
+}
+
+// RedactURI strips credentials from a connection string such as MONGODB_URI.
+func RedactURI(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return redacted
+	}
+	if u.User != nil {
+		u.User = url.User("REDACTED")
+	}
+	return u.String()
+}
+
+// MaskEmail keeps only the first character of the local part, e.g. b***@hotmail.com.
+func MaskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return redacted
+	}
+	return email[:1] + "***" + email[at:]
+}
+
+func WithContext(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+func FromContext(ctx context.Context) *slog.Logger {
+	if ctx != nil {
+		if logger, ok := ctx.Value(contextKey{}).(*slog.Logger); ok {
+			return logger
+		}
+	}
+	return slog.Default()
+}
+
+// With returns a copy of ctx whose logger carries the extra attributes.
+func With(ctx context.Context, args ...any) context.Context {
+	return WithContext(ctx, FromContext(ctx).With(args...))
+}
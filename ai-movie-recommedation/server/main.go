@@ -2,33 +2,43 @@ package main
 
 import (
 	"context"
-	"fmt"
-	"log"
+	"errors"
+	"log/slog"
 	"os"
 	"strings"
-	"time"
 
-	"github.com/gin-contrib/cors"
-	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
 	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/enrichment"
+	"github.com/princepal9120/ai-movie-recommedation/server/events"
+	"github.com/princepal9120/ai-movie-recommedation/server/jobs"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
 	"github.com/princepal9120/ai-movie-recommedation/server/routes"
+	"github.com/princepal9120/ai-movie-recommedation/server/tracing"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 )
 
 func main() {
 	// This is the main function
 
-	router := gin.Default()
+	err := godotenv.Load(".env")
 
-	router.GET("/hello", func(c *gin.Context) {
-		c.String(200, "Hello, MagicStreamMovies!")
-	})
+	logging.Init()
 
-	err := godotenv.Load(".env")
 	if err != nil {
-		log.Println("Warning: unable to find .env file")
+		slog.Warn("unable to find .env file")
+	}
+
+	shutdownTracing, err := tracing.Init(context.Background())
+	if err != nil {
+		slog.Error("failed to initialise tracing", "error", err)
+		os.Exit(1)
 	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			slog.Error("failed to flush traces", "error", err)
+		}
+	}()
 
 	allowedOrigins := os.Getenv("ALLOWED_ORIGINS")
 
@@ -37,43 +47,66 @@ func main() {
 		origins = strings.Split(allowedOrigins, ",")
 		for i := range origins {
 			origins[i] = strings.TrimSpace(origins[i])
-			log.Println("Allowed Origin:", origins[i])
 		}
 	} else {
 		origins = []string{"http://localhost:8080"}
-		log.Println("Allowed Origin: http://localhost:8080")
 	}
-
-	config := cors.Config{}
-	config.AllowOrigins = origins
-	config.AllowMethods = []string{"GET", "POST", "PATCH", "PUT", "DELETE", "OPTIONS"}
-	//config.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization"}
-	config.AllowHeaders = []string{"Origin", "Content-Type", "Authorization"}
-	config.ExposeHeaders = []string{"Content-Length"}
-	config.AllowCredentials = true
-	config.MaxAge = 12 * time.Hour
-
-	router.Use(cors.New(config))
-	router.Use(gin.Logger())
+	slog.Info("configured CORS", "allowed_origins", origins)
 
 	var client *mongo.Client = database.Connect()
 
 	if err := client.Ping(context.Background(), nil); err != nil {
-		log.Fatalf("Failed to reach server: %v", err)
+		slog.Error("failed to reach MongoDB", "error", err)
+		os.Exit(1)
+	}
+	// The server does not run migrations. It creates the indexes it relies on
+	// and drops the ones they superseded, so it starts on an unmigrated
+	// database too.
+	if err := database.EnsureIndexes(context.Background(), client); err != nil {
+		slog.Error("failed to ensure indexes", "error", err)
+		os.Exit(1)
 	}
 	defer func() {
 		err := client.Disconnect(context.Background())
 		if err != nil {
-			log.Fatalf("Failed to disconnect from MongoDB: %v", err)
+			slog.Error("failed to disconnect from MongoDB", "error", err)
 		}
 
 	}()
 
-	routes.SetupUnProtectedRoutes(router, client)
-	routes.SetupProtectedRoutes(router, client)
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+
+	refresher, err := enrichment.NewRefresher(database.OpenCollection("movies", client))
+	switch {
+	case err == nil:
+		slog.Info("starting metadata refresh job", "provider", refresher.Provider.Name(), "interval", refresher.Interval)
+		go refresher.Run(ctx)
+	case errors.Is(err, enrichment.ErrDisabled):
+		slog.Info("metadata enrichment disabled")
+	default:
+		slog.Error("failed to configure metadata refresh job", "error", err)
+	}
+
+	worker, err := jobs.NewWorker(client)
+	if err != nil {
+		slog.Error("failed to configure job worker", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("starting job worker", "poll_interval", worker.PollInterval, "rankings_check_interval", worker.RankingsCheckInterval)
+	go worker.Run(ctx)
+
+	source, err := events.Start(ctx, client)
+	if err != nil {
+		slog.Error("failed to start catalog events", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("streaming catalog events", "source", source)
+
+	router := routes.NewRouter(client, origins)
 
 	if err := router.Run(":8081"); err != nil {
-		fmt.Println("Failed to start server", err)
+		slog.Error("failed to start server", "error", err)
 	}
 
 }
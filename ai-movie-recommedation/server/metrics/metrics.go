@@ -0,0 +1,130 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "magicstream"
+
+var (
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP requests by method, route and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestsInFlight = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_in_flight",
+		Help:      "Number of HTTP requests currently being served.",
+	})
+
+	MongoCommandDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "mongo",
+		Name:      "command_duration_seconds",
+		Help:      "Latency of MongoDB commands by command name, collection and outcome.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"command", "collection", "outcome"})
+
+	LLMCalls = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "calls_total",
+		Help:      "LLM calls by operation and outcome: success, error or budget_exceeded.",
+	}, []string{"operation", "outcome"})
+
+	LLMCallDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "call_duration_seconds",
+		Help:      "Latency of LLM calls by operation.",
+		Buckets:   []float64{.1, .25, .5, 1, 2, 5, 10, 20, 40, 80},
+	}, []string{"operation"})
+
+	LLMTokens = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "tokens_total",
+		Help:      "Tokens consumed by LLM calls, split into prompt and completion tokens.",
+	}, []string{"operation", "type"})
+
+	LLMCacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "cache_lookups_total",
+		Help:      "LLM response cache lookups by operation and result: hit or miss.",
+	}, []string{"operation", "result"})
+
+	ResponseCacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "response_cache_lookups_total",
+		Help:      "Response cache lookups by scope and result: hit or miss.",
+	}, []string{"scope", "result"})
+
+	MetadataLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "enrichment",
+		Name:      "lookups_total",
+		Help:      "Metadata provider lookups by provider and outcome: success, not_found or error.",
+	}, []string{"provider", "outcome"})
+
+	JobRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "jobs",
+		Name:      "runs_total",
+		Help:      "Background job runs by job type and outcome: succeeded, retried, deferred (LLM budget exhausted), failed or superseded.",
+	}, []string{"type", "outcome"})
+
+	RecommendationRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "recommendations",
+		Name:      "requests_total",
+		Help:      "Recommendation requests by result: hit when at least one movie was returned, miss otherwise.",
+	}, []string{"result"})
+
+	ExperimentExposures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "recommendations",
+		Name:      "experiment_exposures_total",
+		Help:      "Recommendation responses served by an experiment, by experiment and variant.",
+	}, []string{"experiment", "variant"})
+
+	RecommendedMovies = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "recommendations",
+		Name:      "movies_served_total",
+		Help:      "Total number of movies returned by the recommendation endpoint.",
+	})
+)
+
+func Outcome(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "success"
+}
+
+// ObserveRecommendations records a recommendation response of n movies.
+func ObserveRecommendations(n int) {
+	if n == 0 {
+		RecommendationRequests.WithLabelValues("miss").Inc()
+		return
+	}
+	RecommendationRequests.WithLabelValues("hit").Inc()
+	RecommendedMovies.Add(float64(n))
+}
+
+// ObserveTokenUsage reads the token counters langchaingo puts in GenerationInfo.
+func ObserveTokenUsage(operation string, generationInfo map[string]any) {
+	for key, tokenType := range map[string]string{"PromptTokens": "prompt", "CompletionTokens": "completion"} {
+		if n, ok := generationInfo[key].(int); ok && n > 0 {
+			LLMTokens.WithLabelValues(operation, tokenType).Add(float64(n))
+		}
+	}
+}
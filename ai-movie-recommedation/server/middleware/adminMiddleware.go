@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/utils"
+)
+
+// AdminMiddleWare must run after AuthMiddleWare.
+func AdminMiddleWare() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, err := utils.GetRoleFromContext(c)
+		if err != nil {
+			c.Error(apperrors.ErrUnauthenticated.WithCause(err))
+			c.Abort()
+			return
+		}
+
+		if role != "ADMIN" {
+			logging.FromContext(c).Warn("admin: access denied", "role", role)
+			c.Error(apperrors.ErrAdminRequired)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
@@ -1,40 +1,48 @@
 package middleware
 
 import (
-	"log"
-	"net/http"
-
 	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
 	"github.com/princepal9120/ai-movie-recommedation/server/utils"
 )
 
 func AuthMiddleWare() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		logger := logging.FromContext(c)
+
 		token, err := utils.GetAccessToken(c)
 		if err != nil {
-			log.Printf("Auth Middleware: Failed to get access token - %v", err)
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Access token not found"})
+			logger.Warn("auth: access token not found", "error", err)
+			c.Error(apperrors.ErrUnauthenticated.WithCause(err))
 			c.Abort()
 			return
 		}
 		if token == "" {
-			log.Printf("Auth Middleware: Empty token received")
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "No token provided"})
+			logger.Warn("auth: empty token received")
+			c.Error(apperrors.ErrUnauthenticated)
 			c.Abort()
 			return
 		}
 		claims, err := utils.ValidateToken(token)
 
 		if err != nil {
-			log.Printf("Auth Middleware: Token validation failed - %v", err)
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
+			logger.Warn("auth: token validation failed", "error", err)
+			c.Error(apperrors.ErrInvalidToken.WithCause(err))
 			c.Abort()
 			return
 		}
 
-		log.Printf("Auth Middleware: User authenticated - UserID: %s, Role: %s", claims.UserId, claims.Role)
 		c.Set("userId", claims.UserId)
 		c.Set("role", claims.Role)
+		c.Set("profileId", claims.ProfileId)
+		attrs := []any{"user_id", claims.UserId, "role", claims.Role}
+		if claims.ProfileId != "" {
+			attrs = append(attrs, "profile_id", claims.ProfileId)
+		}
+		c.Request = c.Request.WithContext(logging.With(c.Request.Context(), attrs...))
+
+		logging.FromContext(c).Debug("auth: user authenticated")
 		c.Next()
 
 	}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/metrics"
+	"github.com/princepal9120/ai-movie-recommedation/server/respcache"
+)
+
+// Cache-Control visibilities for CacheMiddleWare.
+const (
+	// CachePublic lets shared caches such as CDNs store the response.
+	CachePublic = "public"
+	// CachePrivate limits storage to the client, for responses behind auth.
+	CachePrivate = "private"
+)
+
+// CacheMiddleWare serves successful responses of the route from respcache,
+// keyed by request URI within scope, and answers If-None-Match with 304 when
+// the client already has the current body.
+func CacheMiddleWare(scope, visibility string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.Request.URL.RequestURI()
+
+		entry, ok := respcache.Get(scope, key)
+		if ok {
+			metrics.ResponseCacheLookups.WithLabelValues(scope, "hit").Inc()
+			c.Header("X-Cache", "HIT")
+			serveCached(c, entry, visibility)
+			c.Abort()
+			return
+		}
+		metrics.ResponseCacheLookups.WithLabelValues(scope, "miss").Inc()
+
+		generation := respcache.Generation(scope)
+		writer := c.Writer
+		recorder := &bufferedWriter{ResponseWriter: writer}
+		c.Writer = recorder
+		c.Next()
+		c.Writer = writer
+
+		if recorder.Status() != http.StatusOK || len(c.Errors) > 0 {
+			// Errors are rendered by ErrorMiddleWare; anything else the
+			// handler wrote goes out as it was.
+			if recorder.body.Len() > 0 {
+				writer.Write(recorder.body.Bytes())
+			}
+			return
+		}
+
+		entry = respcache.Put(scope, key, generation, bytes.Clone(recorder.body.Bytes()), writer.Header().Get("Content-Type"))
+		c.Header("X-Cache", "MISS")
+		serveCached(c, entry, visibility)
+	}
+}
+
+func serveCached(c *gin.Context, entry *respcache.Entry, visibility string) {
+	c.Header("ETag", entry.ETag)
+	if ttl := respcache.TTL(); ttl > 0 {
+		c.Header("Cache-Control", fmt.Sprintf("%s, max-age=%d", visibility, int(ttl.Seconds())))
+	} else {
+		c.Header("Cache-Control", visibility+", no-cache")
+	}
+
+	if match := c.GetHeader("If-None-Match"); match != "" && respcache.Matches(match, entry.ETag) {
+		c.Status(http.StatusNotModified)
+		c.Writer.WriteHeaderNow()
+		return
+	}
+	c.Data(http.StatusOK, entry.ContentType, entry.Body)
+}
+
+// bufferedWriter holds back the body so it can be cached before it is sent.
+type bufferedWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {}
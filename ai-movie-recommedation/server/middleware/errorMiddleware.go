@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+)
+
+// ErrorMiddleWare renders the last error attached with c.Error as problem+json.
+func ErrorMiddleWare() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		appErr := apperrors.From(c.Errors.Last().Err)
+
+		logger := logging.FromContext(c)
+		if appErr.Status >= http.StatusInternalServerError {
+			logger.Error("request failed", "code", appErr.Code, "error", appErr.Cause)
+		} else if appErr.Cause != nil {
+			logger.Debug("request rejected", "code", appErr.Code, "error", appErr.Cause)
+		}
+
+		c.Header("Content-Type", apperrors.ProblemContentType)
+		c.JSON(appErr.Status, appErr.Problem(c.Request.URL.Path, c.GetString("requestId")))
+	}
+}
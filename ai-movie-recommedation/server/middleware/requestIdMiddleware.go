@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const RequestIdHeader = "X-Request-ID"
+
+var validRequestId = regexp.MustCompile(`^[A-Za-z0-9._-]{1,128}$`)
+
+func RequestIdMiddleWare() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestId := c.GetHeader(RequestIdHeader)
+		if !validRequestId.MatchString(requestId) {
+			requestId = uuid.NewString()
+		}
+
+		c.Set("requestId", requestId)
+		c.Header(RequestIdHeader, requestId)
+
+		attrs := []any{"request_id", requestId}
+		if spanContext := trace.SpanContextFromContext(c.Request.Context()); spanContext.HasTraceID() {
+			attrs = append(attrs, "trace_id", spanContext.TraceID().String())
+		}
+		ctx := logging.With(c.Request.Context(), attrs...)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/popularity"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// ViewMiddleWare counts a view of the movie in the imdb_id parameter whenever
+// it is served, including from the response cache or as a 304.
+func ViewMiddleWare(client *mongo.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if status := c.Writer.Status(); status != http.StatusOK && status != http.StatusNotModified {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c, 5*time.Second)
+		defer cancel()
+
+		imdbID := c.Param("imdb_id")
+		if err := popularity.Record(ctx, client, imdbID, popularity.View); err != nil {
+			logging.FromContext(c).Warn("failed to record movie view", "imdb_id", imdbID, "error", err)
+		}
+	}
+}
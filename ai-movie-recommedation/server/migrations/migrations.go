@@ -0,0 +1,220 @@
+// Package migrations applies numbered, reversible changes to the database and
+// records each applied version in the schema_migrations collection.
+package migrations
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const collectionName = "schema_migrations"
+
+//go:embed data/*.json
+var embedded embed.FS
+
+// SeedData holds the JSON files the seed migrations read.
+var SeedData, _ = fs.Sub(embedded, "data")
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(ctx context.Context, m *Migrator) error
+	Down    func(ctx context.Context, m *Migrator) error
+}
+
+// Record is the document stored in schema_migrations for an applied migration.
+type Record struct {
+	Version   int       `bson:"version"`
+	Name      string    `bson:"name"`
+	AppliedAt time.Time `bson:"applied_at"`
+}
+
+type Status struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+type Migrator struct {
+	DB     *mongo.Database
+	Data   fs.FS
+	DryRun bool
+	Out    io.Writer
+
+	migrations []Migration
+}
+
+// New returns a Migrator for the registered migrations. Data defaults to the
+// embedded seed files and Out to io.Discard.
+func New(db *mongo.Database) *Migrator {
+	return &Migrator{DB: db, Data: SeedData, Out: io.Discard, migrations: All}
+}
+
+func (m *Migrator) WithMigrations(migrations []Migration) *Migrator {
+	clone := *m
+	clone.migrations = migrations
+	return &clone
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	if err := checkOrder(m.migrations); err != nil {
+		return nil, err
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		record, ok := applied[migration.Version]
+		statuses = append(statuses, Status{Migration: migration, Applied: ok, AppliedAt: record.AppliedAt})
+		delete(applied, migration.Version)
+	}
+	for version := range applied {
+		return nil, fmt.Errorf("database has migration %04d %s which this build does not know about", version, applied[version].Name)
+	}
+	return statuses, nil
+}
+
+// Up applies pending migrations in order, stopping after version to.
+// A zero to applies everything.
+func (m *Migrator) Up(ctx context.Context, to int) (int, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := m.ensureIndex(ctx); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, status := range statuses {
+		if status.Applied {
+			continue
+		}
+		if to > 0 && status.Version > to {
+			break
+		}
+
+		m.printf("%s %04d %s\n", m.verb("applying", "would apply"), status.Version, status.Name)
+		if err := status.Up(ctx, m); err != nil {
+			return count, fmt.Errorf("migration %04d %s: %w", status.Version, status.Name, err)
+		}
+		if !m.DryRun {
+			record := Record{Version: status.Version, Name: status.Name, AppliedAt: time.Now().UTC()}
+			if _, err := m.DB.Collection(collectionName).InsertOne(ctx, record); err != nil {
+				return count, fmt.Errorf("recording migration %04d: %w", status.Version, err)
+			}
+		}
+		count++
+	}
+	return count, nil
+}
+
+// Down rolls back the most recently applied migrations, newest first.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for i := len(statuses) - 1; i >= 0 && count < steps; i-- {
+		status := statuses[i]
+		if !status.Applied {
+			continue
+		}
+		if status.Down == nil {
+			return count, fmt.Errorf("migration %04d %s cannot be rolled back", status.Version, status.Name)
+		}
+
+		m.printf("%s %04d %s\n", m.verb("rolling back", "would roll back"), status.Version, status.Name)
+		if err := status.Down(ctx, m); err != nil {
+			return count, fmt.Errorf("rolling back %04d %s: %w", status.Version, status.Name, err)
+		}
+		if !m.DryRun {
+			if _, err := m.DB.Collection(collectionName).DeleteOne(ctx, bson.D{{Key: "version", Value: status.Version}}); err != nil {
+				return count, fmt.Errorf("unrecording migration %04d: %w", status.Version, err)
+			}
+		}
+		count++
+	}
+	return count, nil
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int]Record, error) {
+	cursor, err := m.DB.Collection(collectionName).Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	var records []Record
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int]Record, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
+
+// ensureIndex stops two concurrent runs from recording the same version twice.
+func (m *Migrator) ensureIndex(ctx context.Context) error {
+	if m.DryRun {
+		return nil
+	}
+	_, err := m.DB.Collection(collectionName).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "version", Value: 1}},
+		Options: options.Index().SetName("version_1").SetUnique(true),
+	})
+	return err
+}
+
+func (m *Migrator) readJSON(name string, v any) error {
+	data, err := fs.ReadFile(m.Data, name)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	return nil
+}
+
+func (m *Migrator) printf(format string, args ...any) {
+	fmt.Fprintf(m.Out, format, args...)
+}
+
+func (m *Migrator) verb(live, dryRun string) string {
+	if m.DryRun {
+		return dryRun
+	}
+	return live
+}
+
+func checkOrder(migrations []Migration) error {
+	last := 0
+	for _, migration := range migrations {
+		if migration.Version <= last {
+			return fmt.Errorf("migration %04d %s is out of order", migration.Version, migration.Name)
+		}
+		if migration.Up == nil {
+			return errors.New("migration " + migration.Name + " has no Up step")
+		}
+		last = migration.Version
+	}
+	return nil
+}
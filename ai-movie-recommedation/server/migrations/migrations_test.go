@@ -0,0 +1,284 @@
+package migrations_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/migrations"
+	"github.com/princepal9120/ai-movie-recommedation/server/mongotest"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func newDatabase(t *testing.T) *mongo.Database {
+	t.Helper()
+	server, err := mongotest.NewServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { server.Close() })
+
+	client, err := mongo.Connect(options.Client().ApplyURI(server.URI()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Disconnect(context.Background()) })
+
+	return client.Database("migrations_test")
+}
+
+func count(t *testing.T, db *mongo.Database, collection string, filter bson.D) int64 {
+	t.Helper()
+	n, err := db.Collection(collection).CountDocuments(context.Background(), filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestUpIsIdempotent(t *testing.T) {
+	db := newDatabase(t)
+	ctx := context.Background()
+	migrator := migrations.New(db)
+
+	applied, err := migrator.Up(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if applied != len(migrations.All) {
+		t.Fatalf("applied %d migrations, want %d", applied, len(migrations.All))
+	}
+
+	applied, err = migrator.Up(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if applied != 0 {
+		t.Fatalf("second run applied %d migrations, want 0", applied)
+	}
+
+	for collection, want := range map[string]int64{"genres": 9, "rankings": 6, "users": 3, "movies": 15, "schema_migrations": int64(len(migrations.All))} {
+		if got := count(t, db, collection, bson.D{}); got != want {
+			t.Errorf("%s: got %d documents, want %d", collection, got, want)
+		}
+	}
+	if got := count(t, db, "rankings", bson.D{{Key: "ranking_name", Value: "Not_Ranked"}, {Key: "unranked", Value: true}}); got != 1 {
+		t.Errorf("Not_Ranked is not flagged unranked")
+	}
+}
+
+func TestSeedUpsertsExistingDocuments(t *testing.T) {
+	db := newDatabase(t)
+	ctx := context.Background()
+
+	// A movie that was added and reviewed before the seed ran.
+	_, err := db.Collection("movies").InsertOne(ctx, bson.D{
+		{Key: "imdb_id", Value: "tt0245429"},
+		{Key: "title", Value: "Spirited Away (old title)"},
+		{Key: "admin_review", Value: "Reviewed by an admin."},
+		{Key: "ranking", Value: bson.D{{Key: "ranking_value", Value: 2}, {Key: "ranking_name", Value: "Good"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := migrations.New(db).Up(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := count(t, db, "movies", bson.D{{Key: "imdb_id", Value: "tt0245429"}}); got != 1 {
+		t.Fatalf("got %d copies of tt0245429, want 1", got)
+	}
+
+	var movie bson.M
+	if err := db.Collection("movies").FindOne(ctx, bson.D{{Key: "imdb_id", Value: "tt0245429"}}).Decode(&movie); err != nil {
+		t.Fatal(err)
+	}
+	if movie["title"] != "Spirited Away" {
+		t.Errorf("title: got %v, want catalog title from movies.json", movie["title"])
+	}
+	if movie["admin_review"] != "Reviewed by an admin." {
+		t.Errorf("admin_review: got %v, want existing review kept", movie["admin_review"])
+	}
+}
+
+func TestDryRunWritesNothing(t *testing.T) {
+	db := newDatabase(t)
+	ctx := context.Background()
+
+	migrator := migrations.New(db)
+	migrator.DryRun = true
+
+	if _, err := migrator.Up(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	names, err := db.ListCollectionNames(ctx, bson.D{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 0 {
+		t.Fatalf("dry run created collections %v", names)
+	}
+}
+
+func TestDownRollsBackInReverseOrder(t *testing.T) {
+	db := newDatabase(t)
+	ctx := context.Background()
+	migrator := migrations.New(db)
+
+	if _, err := migrator.Up(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	rolledBack, err := migrator.Down(ctx, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rolledBack != 4 {
+		t.Fatalf("rolled back %d migrations, want 4", rolledBack)
+	}
+	if got := count(t, db, "rankings", bson.D{{Key: "unranked", Value: true}}); got != 0 {
+		t.Errorf("rankings: got %d unranked tiers after rollback, want 0", got)
+	}
+	if got := count(t, db, "movies", bson.D{}); got != 0 {
+		t.Errorf("movies: got %d documents after rollback, want 0", got)
+	}
+	if got := count(t, db, "users", bson.D{}); got != 0 {
+		t.Errorf("users: got %d documents after rollback, want 0", got)
+	}
+	if got := count(t, db, "genres", bson.D{}); got != 9 {
+		t.Errorf("genres: got %d documents, want 9 (not rolled back)", got)
+	}
+
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, status := range statuses {
+		if want := status.Version <= 3; status.Applied != want {
+			t.Errorf("%04d %s: applied=%v, want %v", status.Version, status.Name, status.Applied, want)
+		}
+	}
+
+	applied, err := migrator.Up(ctx, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if applied != 1 {
+		t.Fatalf("up to 4 applied %d migrations, want 1", applied)
+	}
+}
+
+func TestUniqueIndexesRejectDuplicates(t *testing.T) {
+	db := newDatabase(t)
+	ctx := context.Background()
+
+	if _, err := migrations.New(db).Up(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	genres := db.Collection("genres")
+	if _, err := genres.InsertOne(ctx, bson.D{{Key: "genre_id", Value: 1}, {Key: "genre_name", Value: "Comedy"}}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := genres.InsertOne(ctx, bson.D{{Key: "genre_id", Value: 1}, {Key: "genre_name", Value: "Comedy again"}})
+	if !mongo.IsDuplicateKeyError(err) {
+		t.Fatalf("got %v, want duplicate key error", err)
+	}
+}
+
+func TestOnlyOneExperimentIsActive(t *testing.T) {
+	db := newDatabase(t)
+	ctx := context.Background()
+
+	// The index came after the first migration, so startup creates it.
+	t.Setenv("DATABASE_NAME", db.Name())
+	if err := database.EnsureIndexes(ctx, db.Client()); err != nil {
+		t.Fatal(err)
+	}
+
+	experiments := db.Collection("experiments")
+	for _, doc := range []bson.D{
+		{{Key: "name", Value: "paused"}, {Key: "active", Value: false}},
+		{{Key: "name", Value: "stopped"}, {Key: "active", Value: false}},
+		{{Key: "name", Value: "running"}, {Key: "active", Value: true}},
+	} {
+		if _, err := experiments.InsertOne(ctx, doc); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err := experiments.InsertOne(ctx, bson.D{{Key: "name", Value: "another"}, {Key: "active", Value: true}})
+	if !mongo.IsDuplicateKeyError(err) {
+		t.Fatalf("second active experiment: got %v, want duplicate key error", err)
+	}
+	_, err = experiments.UpdateOne(ctx, bson.D{{Key: "name", Value: "paused"}}, bson.D{{Key: "$set", Value: bson.D{{Key: "active", Value: true}}}})
+	if !mongo.IsDuplicateKeyError(err) {
+		t.Fatalf("activating a paused experiment: got %v, want duplicate key error", err)
+	}
+}
+
+func TestActivityIsUniquePerProfile(t *testing.T) {
+	db := newDatabase(t)
+	ctx := context.Background()
+	ratings := db.Collection("ratings")
+
+	// A database from before profiles has one rating per user and movie.
+	old := mongo.IndexModel{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "imdb_id", Value: 1}}, Options: options.Index().SetName("user_id_1_imdb_id_1").SetUnique(true)}
+	if _, err := ratings.Indexes().CreateOne(ctx, old); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrations.New(db).Up(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, rating := range []bson.D{
+		{{Key: "user_id", Value: "u1"}, {Key: "imdb_id", Value: "tt0000001"}},
+		{{Key: "user_id", Value: "u1"}, {Key: "profile_id", Value: "p1"}, {Key: "imdb_id", Value: "tt0000001"}},
+		{{Key: "user_id", Value: "u1"}, {Key: "profile_id", Value: "p2"}, {Key: "imdb_id", Value: "tt0000001"}},
+	} {
+		if _, err := ratings.InsertOne(ctx, rating); err != nil {
+			t.Fatalf("insert %v: %v", rating, err)
+		}
+	}
+	for _, rating := range []bson.D{
+		{{Key: "user_id", Value: "u1"}, {Key: "imdb_id", Value: "tt0000001"}},
+		{{Key: "user_id", Value: "u1"}, {Key: "profile_id", Value: "p1"}, {Key: "imdb_id", Value: "tt0000001"}},
+	} {
+		if _, err := ratings.InsertOne(ctx, rating); !mongo.IsDuplicateKeyError(err) {
+			t.Fatalf("insert %v again: got %v, want duplicate key error", rating, err)
+		}
+	}
+}
+
+func TestRollbackKeepsLaterIndexes(t *testing.T) {
+	db := newDatabase(t)
+	ctx := context.Background()
+	migrator := migrations.New(db)
+
+	if _, err := migrator.Up(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("DATABASE_NAME", db.Name())
+	if err := database.EnsureIndexes(ctx, db.Client()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Down(ctx, len(migrations.All)); err != nil {
+		t.Fatal(err)
+	}
+
+	specs, err := db.Collection("movies").Indexes().ListSpecifications(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var kept []string
+	for _, spec := range specs {
+		kept = append(kept, spec.Name)
+	}
+	if !slices.Contains(kept, "metadata.enriched_at_1") || slices.Contains(kept, "imdb_id_1") {
+		t.Fatalf("movies indexes after rollback: %v, want metadata.enriched_at_1 kept and imdb_id_1 dropped", kept)
+	}
+}
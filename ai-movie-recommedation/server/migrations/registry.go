@@ -0,0 +1,261 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// All lists every migration in version order. Append new migrations at the
+// end; never renumber or edit one that has shipped.
+var All = []Migration{
+	{Version: 1, Name: "create_indexes", Up: createIndexes, Down: dropIndexes},
+	{Version: 2, Name: "seed_genres", Up: seedGenres, Down: unseedGenres},
+	{Version: 3, Name: "seed_rankings", Up: seedRankings, Down: unseedRankings},
+	{Version: 4, Name: "seed_users", Up: seedUsers, Down: unseedUsers},
+	{Version: 5, Name: "seed_movies", Up: seedMovies, Down: unseedMovies},
+	{Version: 6, Name: "flag_unranked_tier", Up: flagUnrankedTier, Down: unflagUnrankedTier},
+	{Version: 7, Name: "scope_activity_to_profiles", Up: scopeActivityToProfiles, Down: unscopeActivityFromProfiles},
+}
+
+// initialIndexes are the indexes the first release relied on. They are
+// frozen here: indexes added since are created at startup by
+// database.EnsureIndexes, or by a later migration when existing data has to
+// change with them.
+var initialIndexes = []database.Index{
+	{Collection: "users", Keys: bson.D{{Key: "email", Value: 1}}, Unique: true},
+	{Collection: "users", Keys: bson.D{{Key: "user_id", Value: 1}}, Unique: true},
+	{Collection: "movies", Keys: bson.D{{Key: "imdb_id", Value: 1}}, Unique: true},
+	{Collection: "movies", Keys: bson.D{{Key: "genre.genre_name", Value: 1}}},
+	{Collection: "movies", Keys: bson.D{{Key: "ranking.ranking_value", Value: 1}}},
+	{Collection: "genres", Keys: bson.D{{Key: "genre_id", Value: 1}}, Unique: true},
+	{Collection: "rankings", Keys: bson.D{{Key: "ranking_name", Value: 1}}, Unique: true},
+}
+
+// createIndexes makes initialIndexes exist before any seed data is written.
+func createIndexes(ctx context.Context, m *Migrator) error {
+	collections, grouped := database.IndexesByCollection(initialIndexes)
+	for _, collection := range collections {
+		indexModels := make([]mongo.IndexModel, 0, len(grouped[collection]))
+		for _, index := range grouped[collection] {
+			indexModels = append(indexModels, index.Model())
+		}
+		if err := m.createIndexes(ctx, collection, indexModels...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropIndexes drops only initialIndexes, so rolling back to version 0 leaves
+// the indexes of later features alone.
+func dropIndexes(ctx context.Context, m *Migrator) error {
+	collections, grouped := database.IndexesByCollection(initialIndexes)
+	for _, collection := range collections {
+		names := make([]string, 0, len(grouped[collection]))
+		for _, index := range grouped[collection] {
+			names = append(names, index.Name())
+		}
+		if err := m.dropIndexes(ctx, collection, names...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func seedGenres(ctx context.Context, m *Migrator) error {
+	var genres []models.Genre
+	if err := m.readJSON("genres.json", &genres); err != nil {
+		return err
+	}
+
+	writes := make([]*mongo.UpdateOneModel, 0, len(genres))
+	for _, genre := range genres {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "genre_id", Value: genre.GenreID}}).
+			SetUpdate(bson.D{{Key: "$set", Value: genre}}))
+	}
+	return m.upsert(ctx, "genres", writes)
+}
+
+func unseedGenres(ctx context.Context, m *Migrator) error {
+	var genres []models.Genre
+	if err := m.readJSON("genres.json", &genres); err != nil {
+		return err
+	}
+
+	ids := bson.A{}
+	for _, genre := range genres {
+		ids = append(ids, genre.GenreID)
+	}
+	return m.deleteSeeded(ctx, "genres", "genre_id", ids)
+}
+
+func seedRankings(ctx context.Context, m *Migrator) error {
+	var rankings []models.Ranking
+	if err := m.readJSON("rankings.json", &rankings); err != nil {
+		return err
+	}
+
+	writes := make([]*mongo.UpdateOneModel, 0, len(rankings))
+	for _, ranking := range rankings {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "ranking_name", Value: ranking.RankingName}}).
+			SetUpdate(bson.D{{Key: "$set", Value: ranking}}))
+	}
+	return m.upsert(ctx, "rankings", writes)
+}
+
+func unseedRankings(ctx context.Context, m *Migrator) error {
+	var rankings []models.Ranking
+	if err := m.readJSON("rankings.json", &rankings); err != nil {
+		return err
+	}
+
+	names := bson.A{}
+	for _, ranking := range rankings {
+		names = append(names, ranking.RankingName)
+	}
+	return m.deleteSeeded(ctx, "rankings", "ranking_name", names)
+}
+
+// seedUsers only inserts missing users: existing accounts keep their
+// passwords, tokens and preferences.
+func seedUsers(ctx context.Context, m *Migrator) error {
+	var users []models.User
+	if err := m.readJSON("users.json", &users); err != nil {
+		return err
+	}
+
+	writes := make([]*mongo.UpdateOneModel, 0, len(users))
+	for _, user := range users {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "user_id", Value: user.UserID}}).
+			SetUpdate(bson.D{{Key: "$setOnInsert", Value: user}}))
+	}
+	return m.upsert(ctx, "users", writes)
+}
+
+func unseedUsers(ctx context.Context, m *Migrator) error {
+	var users []models.User
+	if err := m.readJSON("users.json", &users); err != nil {
+		return err
+	}
+
+	ids := bson.A{}
+	for _, user := range users {
+		ids = append(ids, user.UserID)
+	}
+	return m.deleteSeeded(ctx, "users", "user_id", ids)
+}
+
+// seedMovies keeps catalog fields in sync with movies.json but never
+// overwrites an admin review or ranking that was set after seeding.
+func seedMovies(ctx context.Context, m *Migrator) error {
+	var movies []models.Movie
+	if err := m.readJSON("movies.json", &movies); err != nil {
+		return err
+	}
+
+	writes := make([]*mongo.UpdateOneModel, 0, len(movies))
+	for _, movie := range movies {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "imdb_id", Value: movie.ImdbID}}).
+			SetUpdate(bson.D{
+				{Key: "$set", Value: bson.D{
+					{Key: "title", Value: movie.Title},
+					{Key: "poster_path", Value: movie.PosterPath},
+					{Key: "youtube_id", Value: movie.YouTubeID},
+					{Key: "genre", Value: movie.Genre},
+				}},
+				{Key: "$setOnInsert", Value: bson.D{
+					{Key: "admin_review", Value: movie.AdminReview},
+					{Key: "ranking", Value: movie.Ranking},
+				}},
+			}))
+	}
+	return m.upsert(ctx, "movies", writes)
+}
+
+func unseedMovies(ctx context.Context, m *Migrator) error {
+	var movies []models.Movie
+	if err := m.readJSON("movies.json", &movies); err != nil {
+		return err
+	}
+
+	ids := bson.A{}
+	for _, movie := range movies {
+		ids = append(ids, movie.ImdbID)
+	}
+	return m.deleteSeeded(ctx, "movies", "imdb_id", ids)
+}
+
+// unrankedValue is the ranking value that meant "not ranked" before tiers
+// carried an explicit unranked flag.
+const unrankedValue = 999
+
+// flagUnrankedTier marks the ranking tier with the old magic value, and the
+// movies embedding it, as unranked.
+func flagUnrankedTier(ctx context.Context, m *Migrator) error {
+	if err := m.updateMany(ctx, "rankings",
+		bson.D{{Key: "ranking_value", Value: unrankedValue}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "unranked", Value: true}}}}); err != nil {
+		return err
+	}
+	return m.updateMany(ctx, "movies",
+		bson.D{{Key: "ranking.ranking_value", Value: unrankedValue}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "ranking.unranked", Value: true}}}})
+}
+
+func unflagUnrankedTier(ctx context.Context, m *Migrator) error {
+	if err := m.updateMany(ctx, "rankings",
+		bson.D{{Key: "unranked", Value: true}},
+		bson.D{{Key: "$unset", Value: bson.D{{Key: "unranked", Value: ""}}}}); err != nil {
+		return err
+	}
+	return m.updateMany(ctx, "movies",
+		bson.D{{Key: "ranking.unranked", Value: true}},
+		bson.D{{Key: "$unset", Value: bson.D{{Key: "ranking.unranked", Value: ""}}}})
+}
+
+// activityCollections keep one document per user and movie, and since
+// profiles per user, profile and movie.
+var activityCollections = []string{"ratings", "watchlist"}
+
+var (
+	userMovieIndex = database.Index{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "imdb_id", Value: 1}}, Unique: true}
+	// profileMovieIndex must match the entries in database.Indexes.
+	profileMovieIndex = database.Index{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "profile_id", Value: 1}, {Key: "imdb_id", Value: 1}}, Unique: true}
+)
+
+// scopeActivityToProfiles replaces the unique index on user and movie with
+// one that includes the profile, so that each profile of a household can
+// rate and save a movie.
+func scopeActivityToProfiles(ctx context.Context, m *Migrator) error {
+	for _, collection := range activityCollections {
+		if err := m.createIndexes(ctx, collection, profileMovieIndex.Model()); err != nil {
+			return err
+		}
+		if err := m.dropIndexes(ctx, collection, userMovieIndex.Name()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unscopeActivityFromProfiles fails while two profiles of an account have
+// rated or saved the same movie.
+func unscopeActivityFromProfiles(ctx context.Context, m *Migrator) error {
+	for _, collection := range activityCollections {
+		if err := m.createIndexes(ctx, collection, userMovieIndex.Model()); err != nil {
+			return err
+		}
+		if err := m.dropIndexes(ctx, collection, profileMovieIndex.Name()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// indexNotFound is the server error code for dropping an index that does not exist.
+const indexNotFound = 27
+
+func (m *Migrator) createIndexes(ctx context.Context, collection string, indexes ...mongo.IndexModel) error {
+	if m.DryRun {
+		m.printf("  would create %d index(es) on %s\n", len(indexes), collection)
+		return nil
+	}
+	names, err := m.DB.Collection(collection).Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return err
+	}
+	m.printf("  created indexes %v on %s\n", names, collection)
+	return nil
+}
+
+func (m *Migrator) dropIndexes(ctx context.Context, collection string, names ...string) error {
+	for _, name := range names {
+		if m.DryRun {
+			m.printf("  would drop index %s on %s\n", name, collection)
+			continue
+		}
+		err := m.DB.Collection(collection).Indexes().DropOne(ctx, name)
+		var cmdErr mongo.CommandError
+		if err != nil && !(errors.As(err, &cmdErr) && cmdErr.Code == indexNotFound) {
+			return err
+		}
+		m.printf("  dropped index %s on %s\n", name, collection)
+	}
+	return nil
+}
+
+// upsert runs one upserting update per seed document, so re-running a seed
+// updates what it owns instead of skipping or duplicating it. In dry-run mode
+// it only reports how many documents would be inserted or updated.
+func (m *Migrator) upsert(ctx context.Context, collection string, writes []*mongo.UpdateOneModel) error {
+	coll := m.DB.Collection(collection)
+
+	if m.DryRun {
+		existing := 0
+		for _, write := range writes {
+			count, err := coll.CountDocuments(ctx, write.Filter)
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				existing++
+			}
+		}
+		m.printf("  would insert %d and update %d %s\n", len(writes)-existing, existing, collection)
+		return nil
+	}
+
+	if len(writes) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, len(writes))
+	for i, write := range writes {
+		models[i] = write.SetUpsert(true)
+	}
+	result, err := coll.BulkWrite(ctx, models)
+	if err != nil {
+		return err
+	}
+	m.printf("  inserted %d, updated %d, unchanged %d %s\n",
+		result.UpsertedCount, result.ModifiedCount, result.MatchedCount-result.ModifiedCount, collection)
+	return nil
+}
+
+// updateMany applies update to every document matching filter.
+func (m *Migrator) updateMany(ctx context.Context, collection string, filter, update bson.D) error {
+	coll := m.DB.Collection(collection)
+
+	if m.DryRun {
+		count, err := coll.CountDocuments(ctx, filter)
+		if err != nil {
+			return err
+		}
+		m.printf("  would update %d %s\n", count, collection)
+		return nil
+	}
+
+	result, err := coll.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	m.printf("  updated %d %s\n", result.ModifiedCount, collection)
+	return nil
+}
+
+// deleteSeeded removes the documents whose key is one of values.
+func (m *Migrator) deleteSeeded(ctx context.Context, collection, key string, values bson.A) error {
+	filter := bson.D{{Key: key, Value: bson.D{{Key: "$in", Value: values}}}}
+	coll := m.DB.Collection(collection)
+
+	if m.DryRun {
+		count, err := coll.CountDocuments(ctx, filter)
+		if err != nil {
+			return err
+		}
+		m.printf("  would delete %d %s\n", count, collection)
+		return nil
+	}
+
+	result, err := coll.DeleteMany(ctx, filter)
+	if err != nil {
+		return err
+	}
+	m.printf("  deleted %d %s\n", result.DeletedCount, collection)
+	return nil
+}
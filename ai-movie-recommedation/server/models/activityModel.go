@@ -0,0 +1,79 @@
+package models
+
+import "time"
+
+// Rating is a user's score for a movie in the ratings collection. A user, or
+// each of their profiles, has at most one rating per movie; rating again
+// replaces it.
+type Rating struct {
+	UserID    string    `bson:"user_id" json:"-"`
+	ProfileID string    `bson:"profile_id,omitempty" json:"-"`
+	ImdbID    string    `bson:"imdb_id" json:"imdb_id"`
+	Score     int       `bson:"score" json:"score"`
+	RatedAt   time.Time `bson:"rated_at" json:"rated_at"`
+	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+// RatingRequest rates a movie from 1 (worst) to 5 (best).
+type RatingRequest struct {
+	Score int `json:"score" validate:"required,min=1,max=5"`
+}
+
+// WatchlistEntry is a movie a user saved for later, in the watchlist
+// collection.
+type WatchlistEntry struct {
+	UserID    string    `bson:"user_id"`
+	ProfileID string    `bson:"profile_id,omitempty"`
+	ImdbID    string    `bson:"imdb_id"`
+	AddedAt   time.Time `bson:"added_at"`
+}
+
+// TrendingMovie is a movie with its popularity score in a trending window.
+type TrendingMovie struct {
+	Movie Movie   `json:"movie"`
+	Score float64 `json:"score"`
+}
+
+// ActivityStats counts what happened to a movie in a trending window.
+type ActivityStats struct {
+	Views         int     `json:"views"`
+	Ratings       int     `json:"ratings"`
+	WatchlistAdds int     `json:"watchlist_adds"`
+	Score         float64 `json:"score"`
+}
+
+// MovieStats is a movie's activity in each trending window, with its ratings
+// and watchlist entries of all time.
+type MovieStats struct {
+	ImdbID        string        `json:"imdb_id"`
+	Day           ActivityStats `json:"day"`
+	Week          ActivityStats `json:"week"`
+	Month         ActivityStats `json:"month"`
+	RatingCount   int           `json:"rating_count"`
+	AverageRating float64       `json:"average_rating"`
+	Watchlisted   int           `json:"watchlisted"`
+}
+
+// TrendingQuery selects the trending window and how many movies to return.
+type TrendingQuery struct {
+	Window string `form:"window" json:"window" validate:"omitempty,oneof=day week month"`
+	Limit  int    `form:"limit" json:"limit" validate:"omitempty,min=1,max=50"`
+}
+
+// OnboardingQuery sets how many movies to offer a new user for rating.
+type OnboardingQuery struct {
+	Limit int `form:"limit" json:"limit" validate:"omitempty,min=1,max=50"`
+}
+
+// OnboardingSample is a spread of movies for a new user to rate, with how
+// many ratings onboarding needs and how many the user has given.
+type OnboardingSample struct {
+	Rated    int     `json:"rated"`
+	Required int     `json:"required"`
+	Movies   []Movie `json:"movies"`
+}
+
+// OnboardingResult holds the favourite genres derived from a user's ratings.
+type OnboardingResult struct {
+	FavouriteGenres []Genre `json:"favourite_genres"`
+}
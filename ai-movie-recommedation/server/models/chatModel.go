@@ -0,0 +1,18 @@
+package models
+
+// ChatRequest asks for recommendations in the viewer's own words.
+type ChatRequest struct {
+	Message string `json:"message" validate:"required,max=500"`
+	Limit   int    `json:"limit" validate:"omitempty,min=1,max=20"`
+}
+
+// ChatFilters is what the LLM understood from a ChatRequest. Empty lists and
+// zero numbers do not filter.
+type ChatFilters struct {
+	Genres                 []string `json:"genres"`
+	MoodTags               []string `json:"mood_tags"`
+	ExcludeContentWarnings []string `json:"exclude_content_warnings"`
+	MinYear                int      `json:"min_year"`
+	MaxYear                int      `json:"max_year"`
+	MaxRuntimeMinutes      int      `json:"max_runtime_minutes"`
+}
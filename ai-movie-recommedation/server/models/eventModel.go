@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// CatalogEvent is a change to a movie streamed from /movies/events. Which
+// optional field is set depends on Type: the new movie for movie-added, the
+// new review for review-updated and the new tier for ranking-changed.
+type CatalogEvent struct {
+	Type        string    `json:"type"`
+	ImdbID      string    `json:"imdb_id"`
+	Movie       *Movie    `json:"movie,omitempty"`
+	AdminReview *string   `json:"admin_review,omitempty"`
+	Ranking     *Ranking  `json:"ranking,omitempty"`
+	At          time.Time `json:"at"`
+}
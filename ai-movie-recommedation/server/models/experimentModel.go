@@ -0,0 +1,65 @@
+package models
+
+import "time"
+
+// Experiment splits recommendation traffic between strategies. Users are
+// bucketed by user_id, and household profiles by profile_id, so each one sees
+// the same variant for as long as the variants and weights stay the same. At
+// most one experiment is active.
+type Experiment struct {
+	Name        string              `bson:"name" json:"name"`
+	Description string              `bson:"description,omitempty" json:"description,omitempty"`
+	Active      bool                `bson:"active" json:"active"`
+	Variants    []ExperimentVariant `bson:"variants" json:"variants"`
+	CreatedAt   time.Time           `bson:"created_at" json:"created_at"`
+	UpdatedAt   time.Time           `bson:"updated_at" json:"updated_at"`
+}
+
+// ExperimentVariant serves a recommendation strategy to a share of users
+// proportional to its weight. A weight of 0 takes the variant out of traffic.
+type ExperimentVariant struct {
+	Name     string `bson:"name" json:"name" validate:"required,min=1,max=50"`
+	Strategy string `bson:"strategy" json:"strategy" validate:"required"`
+	Weight   int    `bson:"weight" json:"weight" validate:"min=0,max=100"`
+}
+
+// ExperimentRequest creates or replaces an experiment.
+type ExperimentRequest struct {
+	Description string              `json:"description" validate:"max=500"`
+	Active      bool                `json:"active"`
+	Variants    []ExperimentVariant `json:"variants" validate:"required,min=2,max=10,dive"`
+}
+
+// ExperimentExposure records recommendations served to a user, or one of
+// their profiles, in an experiment, in the experiment_exposures collection.
+type ExperimentExposure struct {
+	Experiment string    `bson:"experiment"`
+	Variant    string    `bson:"variant"`
+	UserID     string    `bson:"user_id"`
+	ProfileID  string    `bson:"profile_id,omitempty"`
+	Strategy   string    `bson:"strategy"`
+	Movies     []string  `bson:"movies"`
+	At         time.Time `bson:"at"`
+}
+
+// ExperimentResults compares an experiment's variants.
+type ExperimentResults struct {
+	Experiment string                    `json:"experiment"`
+	Active     bool                      `json:"active"`
+	Variants   []ExperimentVariantResult `json:"variants"`
+}
+
+// ExperimentVariantResult is what a variant's users were shown and did with
+// it. Each profile of a household counts as a user. A conversion is a movie
+// the user saved or rated 4 or more after the variant first recommended it to
+// them.
+type ExperimentVariantResult struct {
+	Variant        string  `json:"variant"`
+	Strategy       string  `json:"strategy"`
+	Weight         int     `json:"weight"`
+	Users          int     `json:"users"`
+	Exposures      int     `json:"exposures"`
+	MoviesShown    int     `json:"movies_shown"`
+	Conversions    int     `json:"conversions"`
+	ConversionRate float64 `json:"conversion_rate"`
+}
@@ -0,0 +1,15 @@
+package models
+
+// Feed is a viewer's home feed, its rows in display order.
+type Feed struct {
+	Rows []FeedRow `json:"rows"`
+}
+
+// FeedRow is one titled row of the home feed. No movie appears in more than
+// one row of a feed.
+type FeedRow struct {
+	ID       string  `json:"id"`
+	Title    string  `json:"title"`
+	Strategy string  `json:"strategy"`
+	Movies   []Movie `json:"movies"`
+}
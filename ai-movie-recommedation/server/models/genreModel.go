@@ -0,0 +1,22 @@
+package models
+
+// GenreRequest creates a genre. Without GenreID the next free one is used.
+type GenreRequest struct {
+	GenreID   int    `json:"genre_id" validate:"omitempty,min=1"`
+	GenreName string `json:"genre_name" validate:"required,min=2,max=100"`
+	ParentID  *int   `json:"parent_id" validate:"omitempty,min=1"`
+}
+
+// GenreUpdateRequest replaces a genre's name and parent. Leaving ParentID out
+// makes it a top-level genre.
+type GenreUpdateRequest struct {
+	GenreName string `json:"genre_name" validate:"required,min=2,max=100"`
+	ParentID  *int   `json:"parent_id" validate:"omitempty,min=1"`
+}
+
+// GenreUpdate is the updated genre and, after a rename, the job that renames
+// the copies embedded in movies, users and profiles.
+type GenreUpdate struct {
+	Genre Genre `json:"genre"`
+	Job   *Job  `json:"job,omitempty"`
+}
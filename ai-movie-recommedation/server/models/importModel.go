@@ -0,0 +1,30 @@
+package models
+
+import "github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+
+// RowError reports why one row of a bulk import was rejected. Row is the
+// 1-based position of the movie in the file, not counting a CSV header.
+type RowError struct {
+	Row    int                    `json:"row"`
+	ImdbID string                 `json:"imdb_id,omitempty"`
+	Errors []apperrors.FieldError `json:"errors"`
+}
+
+type ImportReport struct {
+	Total     int        `json:"total"`
+	Inserted  int        `json:"inserted"`
+	Updated   int        `json:"updated"`
+	Unchanged int        `json:"unchanged"`
+	Failed    int        `json:"failed"`
+	DryRun    bool       `json:"dry_run"`
+	Errors    []RowError `json:"errors"`
+	// Reviewed lists the movies whose admin review the import changed. Their
+	// ranking is out of date until it is queued again.
+	Reviewed []ReviewChange `json:"-"`
+}
+
+// ReviewChange is the new admin review of a movie.
+type ReviewChange struct {
+	ImdbID      string
+	AdminReview string
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Job is a unit of background work persisted in the jobs collection. Which
+// optional fields are set depends on Type: a review_ranking job names the
+// movie and, once it succeeds, the ranking it was given and the prompt
+// version that produced it; a rerank_all job records why it was queued and
+// how many review jobs it fanned out; a genre_cascade job names the genre and
+// how many movies, users and profiles it updated.
+type Job struct {
+	ID          bson.ObjectID `bson:"_id,omitempty" json:"job_id"`
+	Type        string        `bson:"type" json:"type"`
+	Status      string        `bson:"status" json:"status"`
+	ImdbID      string        `bson:"imdb_id,omitempty" json:"imdb_id,omitempty"`
+	AdminReview string        `bson:"admin_review,omitempty" json:"admin_review,omitempty"`
+	Reason      string        `bson:"reason,omitempty" json:"reason,omitempty"`
+	Ranking     *Ranking      `bson:"ranking,omitempty" json:"ranking,omitempty"`
+	Prompt      *PromptRef    `bson:"prompt,omitempty" json:"prompt,omitempty"`
+	Enqueued    int           `bson:"enqueued,omitempty" json:"enqueued,omitempty"`
+	GenreID     int           `bson:"genre_id,omitempty" json:"genre_id,omitempty"`
+	Updated     int           `bson:"updated,omitempty" json:"updated,omitempty"`
+	Attempts    int           `bson:"attempts" json:"attempts"`
+	MaxAttempts int           `bson:"max_attempts" json:"max_attempts"`
+	LastError   string        `bson:"last_error,omitempty" json:"last_error,omitempty"`
+	RunAfter    time.Time     `bson:"run_after" json:"run_after"`
+	LockedUntil *time.Time    `bson:"locked_until,omitempty" json:"-"`
+	CreatedAt   time.Time     `bson:"created_at" json:"created_at"`
+	UpdatedAt   time.Time     `bson:"updated_at" json:"updated_at"`
+	FinishedAt  *time.Time    `bson:"finished_at,omitempty" json:"finished_at,omitempty"`
+}
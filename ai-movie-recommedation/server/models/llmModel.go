@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// LLMUsage is one UTC day of LLM consumption, stored in the llm_usage
+// collection with the day as its id. The budget fields are filled in from
+// configuration when the usage is reported; zero means unlimited.
+type LLMUsage struct {
+	Day              string    `bson:"_id" json:"day"`
+	Calls            int64     `bson:"calls" json:"calls"`
+	CacheHits        int64     `bson:"cache_hits" json:"cache_hits"`
+	PromptTokens     int64     `bson:"prompt_tokens" json:"prompt_tokens"`
+	CompletionTokens int64     `bson:"completion_tokens" json:"completion_tokens"`
+	CostUSD          float64   `bson:"cost_usd" json:"cost_usd"`
+	TokenBudget      int64     `bson:"-" json:"token_budget"`
+	CostBudgetUSD    float64   `bson:"-" json:"cost_budget_usd"`
+	ResetsAt         time.Time `bson:"-" json:"resets_at"`
+}
@@ -1,17 +1,29 @@
 package models
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
+// Genre is a document in the genres collection. Movies, users and profiles
+// embed copies of it with only GenreID and GenreName; a genre_cascade job
+// rewrites those copies when a genre is renamed.
 type Genre struct {
 	GenreID   int    `bson:"genre_id" json:"genre_id" validate:"required"`
 	GenreName string `bson:"genre_name" json:"genre_name" validate:"required,min=2,max=100"`
+	// ParentID makes the genre a sub-genre of another one.
+	ParentID *int `bson:"parent_id,omitempty" json:"parent_id,omitempty"`
 }
 
+// Ranking is a tier of the ranking scale in the rankings collection. Movies
+// embed a copy of their tier; lower values are better.
 type Ranking struct {
 	RankingValue int    `bson:"ranking_value" json:"ranking_value" validate:"required"`
 	RankingName  string `bson:"ranking_name" json:"ranking_name" validate:"required"`
+	// Unranked marks the tier of movies without a ranked review. The LLM is
+	// never offered it as an answer.
+	Unranked bool `bson:"unranked,omitempty" json:"unranked,omitempty"`
 }
 
 type Movie struct {
@@ -23,4 +35,35 @@ type Movie struct {
 	Genre       []Genre       `bson:"genre" json:"genre" validate:"required,dive"`
 	AdminReview string        `bson:"admin_review" json:"admin_review"`
 	Ranking     Ranking       `bson:"ranking" json:"ranking" validate:"required"`
+	// Maturity is who the movie is suitable for: kids, teen or adult.
+	// Unrated movies are only recommended to adult profiles.
+	Maturity string `bson:"maturity,omitempty" json:"maturity,omitempty" validate:"omitempty,oneof=kids teen adult"`
+	// RankingPrompt is the prompt version that produced Ranking, when it was
+	// ranked from the admin review.
+	RankingPrompt *PromptRef `bson:"ranking_prompt,omitempty" json:"ranking_prompt,omitempty"`
+	Metadata      *Metadata  `bson:"metadata,omitempty" json:"metadata,omitempty"`
+	Summary       *Summary   `bson:"summary,omitempty" json:"summary,omitempty"`
+}
+
+// Metadata is filled in from an external catalog by the enrichment package.
+type Metadata struct {
+	ReleaseYear    int       `bson:"release_year,omitempty" json:"release_year,omitempty"`
+	RuntimeMinutes int       `bson:"runtime_minutes,omitempty" json:"runtime_minutes,omitempty"`
+	Cast           []string  `bson:"cast,omitempty" json:"cast,omitempty"`
+	Director       string    `bson:"director,omitempty" json:"director,omitempty"`
+	Overview       string    `bson:"overview,omitempty" json:"overview,omitempty"`
+	Language       string    `bson:"language,omitempty" json:"language,omitempty"`
+	Source         string    `bson:"source" json:"source"`
+	EnrichedAt     time.Time `bson:"enriched_at" json:"enriched_at"`
+	// AttemptedAt is when the last lookup ran, whether or not it succeeded.
+	AttemptedAt time.Time `bson:"attempted_at,omitempty" json:"-"`
+}
+
+// Summary is written by the LLM from a movie's admin review and metadata.
+type Summary struct {
+	Synopsis        string    `bson:"synopsis" json:"synopsis"`
+	MoodTags        []string  `bson:"mood_tags" json:"mood_tags"`
+	ContentWarnings []string  `bson:"content_warnings" json:"content_warnings"`
+	Prompt          PromptRef `bson:"prompt" json:"prompt"`
+	GeneratedAt     time.Time `bson:"generated_at" json:"generated_at"`
 }
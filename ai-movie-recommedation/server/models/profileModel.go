@@ -0,0 +1,47 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// Maturity levels, from the most to the least restricted. Profiles have one
+// as their setting and movies as their rating.
+const (
+	MaturityKids  = "kids"
+	MaturityTeen  = "teen"
+	MaturityAdult = "adult"
+)
+
+var maturityLevels = []string{MaturityKids, MaturityTeen, MaturityAdult}
+
+// AllowedMaturities returns the movie ratings a profile with the given
+// setting may be recommended, or nil when every movie is allowed. Movies
+// without a rating count as adult.
+func AllowedMaturities(setting string) []string {
+	level := slices.Index(maturityLevels, setting)
+	if level < 0 || setting == MaturityAdult {
+		return nil
+	}
+	return maturityLevels[:level+1]
+}
+
+// Profile is one member of a household sharing an account, in the profiles
+// collection. Each profile has its own favourite genres, ratings and
+// watchlist; activity without a profile selected belongs to the account.
+type Profile struct {
+	ProfileID       string    `bson:"profile_id" json:"profile_id"`
+	UserID          string    `bson:"user_id" json:"-"`
+	Name            string    `bson:"name" json:"name"`
+	FavouriteGenres []Genre   `bson:"favourite_genres" json:"favourite_genres"`
+	Maturity        string    `bson:"maturity" json:"maturity"`
+	CreatedAt       time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt       time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+// ProfileRequest creates or updates a profile.
+type ProfileRequest struct {
+	Name            string  `json:"name" validate:"required,min=1,max=50"`
+	FavouriteGenres []Genre `json:"favourite_genres" validate:"dive"`
+	Maturity        string  `json:"maturity" validate:"required,oneof=kids teen adult"`
+}
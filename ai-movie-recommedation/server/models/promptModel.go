@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+// PromptTemplate is one immutable version of a named prompt. Built-in versions
+// ship with the server; admins add database versions on top of them, and the
+// highest version is the one in use.
+type PromptTemplate struct {
+	Name        string     `bson:"name" json:"name"`
+	Version     int        `bson:"version" json:"version"`
+	Body        string     `bson:"body" json:"body"`
+	Description string     `bson:"description,omitempty" json:"description,omitempty"`
+	Source      string     `bson:"-" json:"source"`
+	CreatedBy   string     `bson:"created_by,omitempty" json:"created_by,omitempty"`
+	CreatedAt   *time.Time `bson:"created_at,omitempty" json:"created_at,omitempty"`
+}
+
+// PromptRef names the prompt version that produced an LLM result.
+type PromptRef struct {
+	Name    string `bson:"name" json:"name"`
+	Version int    `bson:"version" json:"version"`
+}
+
+type PromptTemplateRequest struct {
+	Body        string `json:"body" validate:"required"`
+	Description string `json:"description" validate:"max=500"`
+}
+
+// PromptPreviewRequest runs a stored version, or an unsaved Body, against
+// sample reviews. Version 0 means the version in use.
+type PromptPreviewRequest struct {
+	Version int      `json:"version" validate:"min=0"`
+	Body    string   `json:"body"`
+	Reviews []string `json:"reviews" validate:"required,min=1,max=20,dive,required"`
+}
+
+type PromptPreview struct {
+	Prompt  PromptRef             `json:"prompt"`
+	Results []PromptPreviewResult `json:"results"`
+}
+
+// PromptPreviewResult is the outcome for one sample review. Error is set when
+// the model answered with something that is not a ranking.
+type PromptPreviewResult struct {
+	Review  string   `json:"review"`
+	Prompt  string   `json:"prompt"`
+	Answer  string   `json:"answer"`
+	Ranking *Ranking `json:"ranking,omitempty"`
+	Cached  bool     `json:"cached"`
+	Error   string   `json:"error,omitempty"`
+}
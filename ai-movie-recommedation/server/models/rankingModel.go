@@ -0,0 +1,26 @@
+package models
+
+// RankingTierRequest is one tier of a new ranking scale.
+type RankingTierRequest struct {
+	RankingValue int    `json:"ranking_value" validate:"required,min=1"`
+	RankingName  string `json:"ranking_name" validate:"required,min=2,max=50"`
+	Unranked     bool   `json:"unranked"`
+	// Replaces names current tiers whose movies move to this one, such as the
+	// tier's name before a rename.
+	Replaces []string `json:"replaces" validate:"omitempty,dive,required"`
+}
+
+// RankingScaleRequest replaces the whole ranking scale. Tiers are listed from
+// best to worst, with the unranked tier last.
+type RankingScaleRequest struct {
+	Rankings []RankingTierRequest `json:"rankings" validate:"required,min=2,dive"`
+}
+
+// RankingScaleUpdate is the new scale, how many movies were moved to another
+// tier and, when the tiers offered to the LLM changed, the rerank_all job
+// that re-ranks reviewed movies.
+type RankingScaleUpdate struct {
+	Rankings []Ranking `json:"rankings"`
+	Remapped int       `json:"remapped"`
+	Job      *Job      `json:"job,omitempty"`
+}
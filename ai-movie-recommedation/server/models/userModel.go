@@ -33,4 +33,6 @@ type UserResponse struct {
 	Token           string  `json:"token"`
 	RefreshToken    string  `json:"refresh_token"`
 	FavouriteGenres []Genre `json:"favourite_genres"`
+	// Profiles lists the household's profiles to pick from after login.
+	Profiles []Profile `json:"profiles"`
 }
\ No newline at end of file
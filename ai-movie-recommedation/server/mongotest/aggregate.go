@@ -0,0 +1,208 @@
+package mongotest
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (s *Server) aggregate(db, coll string, cmd bson.D) (bson.D, error) {
+	pipeline, _ := lookup(cmd, "pipeline")
+	stages, _ := pipeline.(bson.A)
+
+	docs, err := s.lookupCollection(db, coll).query(nil, nil, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range stages {
+		stage, isDoc := item.(bson.D)
+		if !isDoc || len(stage) != 1 {
+			return nil, fmt.Errorf("mongotest: invalid pipeline stage %v", item)
+		}
+		docs, err = runStage(docs, stage[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return cursorResponse(db, coll, docs), nil
+}
+
+func runStage(docs []bson.D, stage bson.E) ([]bson.D, error) {
+	switch stage.Key {
+	case "$match":
+		filter, _ := stage.Value.(bson.D)
+		var out []bson.D
+		for _, doc := range docs {
+			matched, err := matches(doc, filter)
+			if err != nil {
+				return nil, err
+			}
+			if matched {
+				out = append(out, doc)
+			}
+		}
+		return out, nil
+	case "$sort":
+		spec, _ := stage.Value.(bson.D)
+		sortDocs(docs, spec)
+		return docs, nil
+	case "$skip":
+		return window(docs, int(toFloat(stage.Value)), 0), nil
+	case "$limit":
+		return window(docs, 0, int(toFloat(stage.Value))), nil
+	case "$sample":
+		// Deterministic stand-in: the first n documents.
+		spec, _ := stage.Value.(bson.D)
+		return window(docs, 0, intArg(spec, "size")), nil
+	case "$project":
+		spec, _ := stage.Value.(bson.D)
+		for i := range docs {
+			docs[i] = project(docs[i], spec)
+		}
+		return docs, nil
+	case "$count":
+		field, _ := stage.Value.(string)
+		if len(docs) == 0 {
+			return nil, nil
+		}
+		return []bson.D{{{Key: field, Value: int32(len(docs))}}}, nil
+	case "$unwind":
+		path, _ := stage.Value.(string)
+		if spec, isDoc := stage.Value.(bson.D); isDoc {
+			path, _ = mustLookup(spec, "path").(string)
+		}
+		field := strings.TrimPrefix(path, "$")
+		var out []bson.D
+		for _, doc := range docs {
+			arr, isArr := firstValue(doc, field).(bson.A)
+			if !isArr {
+				continue
+			}
+			for _, elem := range arr {
+				unwound, err := setPath(cloneDoc(doc), strings.Split(field, "."), elem)
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, unwound)
+			}
+		}
+		return out, nil
+	case "$group":
+		spec, _ := stage.Value.(bson.D)
+		return group(docs, spec)
+	}
+	return nil, fmt.Errorf("mongotest: pipeline stage %s is not supported", stage.Key)
+}
+
+type groupState struct {
+	id     any
+	values map[string]any
+	counts map[string]int
+}
+
+func group(docs []bson.D, spec bson.D) ([]bson.D, error) {
+	idExpr, _ := lookup(spec, "_id")
+
+	var groups []*groupState
+	for _, doc := range docs {
+		id := evalExpr(doc, idExpr)
+
+		var state *groupState
+		for _, g := range groups {
+			if equal(g.id, id) {
+				state = g
+				break
+			}
+		}
+		if state == nil {
+			state = &groupState{id: id, values: map[string]any{}, counts: map[string]int{}}
+			groups = append(groups, state)
+		}
+
+		for _, field := range spec {
+			if field.Key == "_id" {
+				continue
+			}
+			acc, _ := field.Value.(bson.D)
+			if len(acc) != 1 {
+				return nil, fmt.Errorf("mongotest: invalid accumulator for %s", field.Key)
+			}
+			value := evalExpr(doc, acc[0].Value)
+			current, seen := state.values[field.Key]
+
+			switch acc[0].Key {
+			case "$sum":
+				if isNumber(value) {
+					state.values[field.Key] = addNumbers(current, value)
+				} else if !seen {
+					state.values[field.Key] = int32(0)
+				}
+			case "$avg":
+				if isNumber(value) {
+					state.values[field.Key] = toFloat(current) + toFloat(value)
+					state.counts[field.Key]++
+				}
+			case "$min":
+				if value != nil && (!seen || compare(value, current) < 0) {
+					state.values[field.Key] = value
+				}
+			case "$max":
+				if value != nil && (!seen || compare(value, current) > 0) {
+					state.values[field.Key] = value
+				}
+			case "$first":
+				if !seen {
+					state.values[field.Key] = value
+				}
+			case "$last":
+				state.values[field.Key] = value
+			case "$push", "$addToSet":
+				arr, _ := current.(bson.A)
+				if acc[0].Key == "$push" || !anyEqual([]any(arr), value) {
+					arr = append(arr, value)
+				}
+				state.values[field.Key] = arr
+			default:
+				return nil, fmt.Errorf("mongotest: accumulator %s is not supported", acc[0].Key)
+			}
+		}
+	}
+
+	out := make([]bson.D, 0, len(groups))
+	for _, g := range groups {
+		doc := bson.D{{Key: "_id", Value: g.id}}
+		for _, field := range spec {
+			if field.Key == "_id" {
+				continue
+			}
+			value := g.values[field.Key]
+			if n := g.counts[field.Key]; n > 0 {
+				value = toFloat(value) / float64(n)
+			}
+			doc = append(doc, bson.E{Key: field.Key, Value: value})
+		}
+		out = append(out, doc)
+	}
+	return out, nil
+}
+
+// evalExpr evaluates the aggregation expressions the server relies on:
+// field paths ("$field"), literals and documents of expressions.
+func evalExpr(doc bson.D, expr any) any {
+	switch e := expr.(type) {
+	case string:
+		if strings.HasPrefix(e, "$") {
+			return firstValue(doc, strings.TrimPrefix(e, "$"))
+		}
+		return e
+	case bson.D:
+		out := bson.D{}
+		for _, elem := range e {
+			out = append(out, bson.E{Key: elem.Key, Value: evalExpr(doc, elem.Value)})
+		}
+		return out
+	}
+	return expr
+}
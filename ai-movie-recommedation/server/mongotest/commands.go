@@ -0,0 +1,637 @@
+package mongotest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type index struct {
+	name   string
+	key    bson.D
+	unique bool
+	sparse bool
+	// partial holds the partialFilterExpression; only matching documents
+	// are indexed.
+	partial bson.D
+}
+
+type collection struct {
+	docs    []bson.D
+	indexes []index
+}
+
+type commandError struct {
+	code     int32
+	codeName string
+	message  string
+}
+
+func (e *commandError) Error() string {
+	return e.message
+}
+
+func errorResponse(err error) bson.D {
+	code, codeName := int32(8), "UnknownError"
+	if cmdErr, ok := err.(*commandError); ok {
+		code, codeName = cmdErr.code, cmdErr.codeName
+	}
+	return bson.D{
+		{Key: "ok", Value: 0.0},
+		{Key: "errmsg", Value: err.Error()},
+		{Key: "code", Value: code},
+		{Key: "codeName", Value: codeName},
+	}
+}
+
+func ok(fields ...bson.E) bson.D {
+	return append(bson.D(fields), bson.E{Key: "ok", Value: 1.0})
+}
+
+func (s *Server) runCommand(cmd bson.D, connID int32) bson.D {
+	if len(cmd) == 0 {
+		return errorResponse(fmt.Errorf("mongotest: empty command"))
+	}
+	name := cmd[0].Key
+	dbName, _ := lookup(cmd, "$db")
+	db, _ := dbName.(string)
+	collName, _ := cmd[0].Value.(string)
+
+	switch strings.ToLower(name) {
+	case "hello", "ismaster":
+		return ok(
+			bson.E{Key: "ismaster", Value: true},
+			bson.E{Key: "isWritablePrimary", Value: true},
+			bson.E{Key: "helloOk", Value: true},
+			bson.E{Key: "maxBsonObjectSize", Value: int32(16 * 1024 * 1024)},
+			bson.E{Key: "maxMessageSizeBytes", Value: int32(48000000)},
+			bson.E{Key: "maxWriteBatchSize", Value: int32(100000)},
+			bson.E{Key: "localTime", Value: bson.NewDateTimeFromTime(time.Now())},
+			bson.E{Key: "logicalSessionTimeoutMinutes", Value: int32(30)},
+			bson.E{Key: "connectionId", Value: connID},
+			bson.E{Key: "minWireVersion", Value: int32(0)},
+			bson.E{Key: "maxWireVersion", Value: int32(21)},
+			bson.E{Key: "readOnly", Value: false},
+		)
+	case "ping", "endsessions", "killcursors", "aborttransaction", "committransaction":
+		return ok()
+	case "buildinfo":
+		return ok(
+			bson.E{Key: "version", Value: "7.0.0"},
+			bson.E{Key: "versionArray", Value: bson.A{int32(7), int32(0), int32(0), int32(0)}},
+		)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var response bson.D
+	var err error
+	switch name {
+	case "find":
+		response, err = s.find(db, collName, cmd)
+	case "aggregate":
+		response, err = s.aggregate(db, collName, cmd)
+	case "count":
+		response, err = s.count(db, collName, cmd)
+	case "distinct":
+		response, err = s.distinct(db, collName, cmd)
+	case "insert":
+		response, err = s.insert(db, collName, cmd)
+	case "update":
+		response, err = s.update(db, collName, cmd)
+	case "delete":
+		response, err = s.delete(db, collName, cmd)
+	case "findAndModify", "findandmodify":
+		response, err = s.findAndModify(db, collName, cmd)
+	case "createIndexes":
+		response, err = s.createIndexes(db, collName, cmd)
+	case "listIndexes":
+		response, err = s.listIndexes(db, collName)
+	case "dropIndexes":
+		response, err = s.dropIndexes(db, collName, cmd)
+	case "listCollections":
+		response = s.listCollections(db)
+	case "create":
+		s.collection(db, collName)
+		response = ok()
+	case "drop":
+		delete(s.databases[db], collName)
+		response = ok()
+	case "dropDatabase":
+		delete(s.databases, db)
+		response = ok()
+	case "getMore":
+		err = &commandError{code: 43, codeName: "CursorNotFound", message: "mongotest: cursors are always exhausted in the first batch"}
+	default:
+		err = &commandError{code: 59, codeName: "CommandNotFound", message: fmt.Sprintf("no such command: '%s'", name)}
+	}
+	if err != nil {
+		return errorResponse(err)
+	}
+	return response
+}
+
+func (s *Server) collection(db, name string) *collection {
+	if s.databases[db] == nil {
+		s.databases[db] = map[string]*collection{}
+	}
+	coll := s.databases[db][name]
+	if coll == nil {
+		coll = &collection{indexes: []index{{name: "_id_", key: bson.D{{Key: "_id", Value: int32(1)}}, unique: true}}}
+		s.databases[db][name] = coll
+	}
+	return coll
+}
+
+// lookupCollection is collection for reads: like MongoDB, querying a missing
+// collection does not create it.
+func (s *Server) lookupCollection(db, name string) *collection {
+	if coll := s.databases[db][name]; coll != nil {
+		return coll
+	}
+	return &collection{}
+}
+
+func docArg(cmd bson.D, key string) bson.D {
+	v, _ := lookup(cmd, key)
+	d, _ := v.(bson.D)
+	return d
+}
+
+func intArg(cmd bson.D, key string) int {
+	v, _ := lookup(cmd, key)
+	return int(toFloat(v))
+}
+
+func cursorResponse(db, coll string, docs []bson.D) bson.D {
+	batch := bson.A{}
+	for _, doc := range docs {
+		batch = append(batch, doc)
+	}
+	return ok(bson.E{Key: "cursor", Value: bson.D{
+		{Key: "firstBatch", Value: batch},
+		{Key: "id", Value: int64(0)},
+		{Key: "ns", Value: db + "." + coll},
+	}})
+}
+
+func (c *collection) filter(filter bson.D) ([]int, error) {
+	var positions []int
+	for i, doc := range c.docs {
+		matched, err := matches(doc, filter)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			positions = append(positions, i)
+		}
+	}
+	return positions, nil
+}
+
+func (c *collection) query(filter, sortSpec bson.D, skip, limit int) ([]bson.D, error) {
+	positions, err := c.filter(filter)
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]bson.D, 0, len(positions))
+	for _, i := range positions {
+		docs = append(docs, cloneDoc(c.docs[i]))
+	}
+	sortDocs(docs, sortSpec)
+	return window(docs, skip, limit), nil
+}
+
+func window(docs []bson.D, skip, limit int) []bson.D {
+	if skip > 0 {
+		if skip >= len(docs) {
+			return nil
+		}
+		docs = docs[skip:]
+	}
+	if limit < 0 {
+		limit = -limit
+	}
+	if limit > 0 && limit < len(docs) {
+		docs = docs[:limit]
+	}
+	return docs
+}
+
+func (s *Server) find(db, coll string, cmd bson.D) (bson.D, error) {
+	docs, err := s.lookupCollection(db, coll).query(docArg(cmd, "filter"), docArg(cmd, "sort"), intArg(cmd, "skip"), intArg(cmd, "limit"))
+	if err != nil {
+		return nil, err
+	}
+	if projection := docArg(cmd, "projection"); len(projection) > 0 {
+		for i := range docs {
+			docs[i] = project(docs[i], projection)
+		}
+	}
+	return cursorResponse(db, coll, docs), nil
+}
+
+func (s *Server) count(db, coll string, cmd bson.D) (bson.D, error) {
+	docs, err := s.lookupCollection(db, coll).query(docArg(cmd, "query"), nil, intArg(cmd, "skip"), intArg(cmd, "limit"))
+	if err != nil {
+		return nil, err
+	}
+	return ok(bson.E{Key: "n", Value: int64(len(docs))}), nil
+}
+
+func (s *Server) distinct(db, coll string, cmd bson.D) (bson.D, error) {
+	key, _ := lookup(cmd, "key")
+	docs, err := s.lookupCollection(db, coll).query(docArg(cmd, "query"), nil, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	values := bson.A{}
+	for _, doc := range docs {
+		for _, v := range resolvePath(doc, key.(string)) {
+			if _, isArr := v.(bson.A); isArr {
+				continue
+			}
+			if !anyEqual([]any(values), v) {
+				values = append(values, v)
+			}
+		}
+	}
+	return ok(bson.E{Key: "values", Value: values}), nil
+}
+
+func duplicateKeyError(db, coll string, idx index) *commandError {
+	return &commandError{
+		code:     11000,
+		codeName: "DuplicateKey",
+		message:  fmt.Sprintf("E11000 duplicate key error collection: %s.%s index: %s", db, coll, idx.name),
+	}
+}
+
+// checkUnique reports the first unique index that candidate would violate,
+// ignoring the document at position skip (the one being replaced).
+func (c *collection) checkUnique(candidate bson.D, skip int) (index, bool) {
+	for _, idx := range c.indexes {
+		if !idx.unique {
+			continue
+		}
+		key := indexKey(candidate, idx)
+		if key == nil {
+			continue
+		}
+		for i, doc := range c.docs {
+			if i == skip {
+				continue
+			}
+			if other := indexKey(doc, idx); other != nil && equal(key, other) {
+				return idx, true
+			}
+		}
+	}
+	return index{}, false
+}
+
+func indexKey(doc bson.D, idx index) bson.A {
+	key := bson.A{}
+	present := false
+	for _, field := range idx.key {
+		v := firstValue(doc, field.Key)
+		if v != nil {
+			present = true
+		}
+		key = append(key, v)
+	}
+	if idx.sparse && !present {
+		return nil
+	}
+	if idx.partial != nil {
+		if matched, err := matches(doc, idx.partial); err != nil || !matched {
+			return nil
+		}
+	}
+	return key
+}
+
+func ensureID(doc bson.D) bson.D {
+	if _, ok := lookup(doc, "_id"); ok {
+		return doc
+	}
+	return append(bson.D{{Key: "_id", Value: bson.NewObjectID()}}, doc...)
+}
+
+func (s *Server) insert(db, coll string, cmd bson.D) (bson.D, error) {
+	c := s.collection(db, coll)
+	docs, _ := lookup(cmd, "documents")
+	ordered := true
+	if v, found := lookup(cmd, "ordered"); found {
+		ordered = truthy(v)
+	}
+
+	n := 0
+	writeErrors := bson.A{}
+	for i, item := range docs.(bson.A) {
+		doc := ensureID(item.(bson.D))
+		if idx, dup := c.checkUnique(doc, -1); dup {
+			writeErrors = append(writeErrors, bson.D{
+				{Key: "index", Value: int32(i)},
+				{Key: "code", Value: int32(11000)},
+				{Key: "errmsg", Value: duplicateKeyError(db, coll, idx).message},
+			})
+			if ordered {
+				break
+			}
+			continue
+		}
+		c.docs = append(c.docs, doc)
+		n++
+	}
+
+	response := ok(bson.E{Key: "n", Value: int32(n)})
+	if len(writeErrors) > 0 {
+		response = append(bson.D{{Key: "writeErrors", Value: writeErrors}}, response...)
+	}
+	return response, nil
+}
+
+func (s *Server) update(db, coll string, cmd bson.D) (bson.D, error) {
+	c := s.collection(db, coll)
+	updates, _ := lookup(cmd, "updates")
+
+	var matched, modified int32
+	upserted := bson.A{}
+	writeErrors := bson.A{}
+	for i, item := range updates.(bson.A) {
+		spec := item.(bson.D)
+		filter := docArg(spec, "q")
+		multi := truthy(mustLookup(spec, "multi"))
+		upsert := truthy(mustLookup(spec, "upsert"))
+
+		u, _ := lookup(spec, "u")
+		updateDoc, isDoc := u.(bson.D)
+		if !isDoc {
+			return nil, &commandError{code: 9, codeName: "FailedToParse", message: "mongotest: pipeline updates are not supported"}
+		}
+
+		positions, err := c.filter(filter)
+		if err != nil {
+			return nil, err
+		}
+		if !multi && len(positions) > 1 {
+			positions = positions[:1]
+		}
+
+		if len(positions) == 0 && upsert {
+			doc, err := applyUpdate(upsertSeed(filter), updateDoc, true)
+			if err != nil {
+				return nil, err
+			}
+			doc = ensureID(doc)
+			if idx, dup := c.checkUnique(doc, -1); dup {
+				writeErrors = append(writeErrors, bson.D{
+					{Key: "index", Value: int32(i)},
+					{Key: "code", Value: int32(11000)},
+					{Key: "errmsg", Value: duplicateKeyError(db, coll, idx).message},
+				})
+				continue
+			}
+			c.docs = append(c.docs, doc)
+			id, _ := lookup(doc, "_id")
+			upserted = append(upserted, bson.D{{Key: "index", Value: int32(i)}, {Key: "_id", Value: id}})
+			continue
+		}
+
+		for _, pos := range positions {
+			matched++
+			doc, err := applyUpdate(c.docs[pos], updateDoc, false)
+			if err != nil {
+				return nil, err
+			}
+			if idx, dup := c.checkUnique(doc, pos); dup {
+				writeErrors = append(writeErrors, bson.D{
+					{Key: "index", Value: int32(i)},
+					{Key: "code", Value: int32(11000)},
+					{Key: "errmsg", Value: duplicateKeyError(db, coll, idx).message},
+				})
+				break
+			}
+			if !equal(doc, c.docs[pos]) {
+				modified++
+			}
+			c.docs[pos] = doc
+		}
+	}
+
+	response := ok(
+		bson.E{Key: "n", Value: matched + int32(len(upserted))},
+		bson.E{Key: "nModified", Value: modified},
+	)
+	if len(upserted) > 0 {
+		response = append(bson.D{{Key: "upserted", Value: upserted}}, response...)
+	}
+	if len(writeErrors) > 0 {
+		response = append(bson.D{{Key: "writeErrors", Value: writeErrors}}, response...)
+	}
+	return response, nil
+}
+
+func mustLookup(doc bson.D, key string) any {
+	v, _ := lookup(doc, key)
+	return v
+}
+
+func (s *Server) delete(db, coll string, cmd bson.D) (bson.D, error) {
+	c := s.collection(db, coll)
+	deletes, _ := lookup(cmd, "deletes")
+
+	var n int32
+	for _, item := range deletes.(bson.A) {
+		spec := item.(bson.D)
+		positions, err := c.filter(docArg(spec, "q"))
+		if err != nil {
+			return nil, err
+		}
+		if intArg(spec, "limit") == 1 && len(positions) > 1 {
+			positions = positions[:1]
+		}
+		remove := map[int]bool{}
+		for _, pos := range positions {
+			remove[pos] = true
+		}
+		kept := c.docs[:0]
+		for i, doc := range c.docs {
+			if !remove[i] {
+				kept = append(kept, doc)
+			}
+		}
+		c.docs = kept
+		n += int32(len(positions))
+	}
+	return ok(bson.E{Key: "n", Value: n}), nil
+}
+
+func (s *Server) findAndModify(db, coll string, cmd bson.D) (bson.D, error) {
+	c := s.collection(db, coll)
+	filter := docArg(cmd, "query")
+	returnNew := truthy(mustLookup(cmd, "new"))
+	upsert := truthy(mustLookup(cmd, "upsert"))
+	remove := truthy(mustLookup(cmd, "remove"))
+	fields := docArg(cmd, "fields")
+
+	positions, err := c.filter(filter)
+	if err != nil {
+		return nil, err
+	}
+	if sortSpec := docArg(cmd, "sort"); len(sortSpec) > 0 && len(positions) > 1 {
+		sort.SliceStable(positions, func(i, j int) bool {
+			return less(c.docs[positions[i]], c.docs[positions[j]], sortSpec)
+		})
+	}
+
+	var value any
+	lastError := bson.D{{Key: "n", Value: int32(0)}, {Key: "updatedExisting", Value: false}}
+
+	u, _ := lookup(cmd, "update")
+	updateDoc, _ := u.(bson.D)
+
+	switch {
+	case len(positions) > 0 && remove:
+		pos := positions[0]
+		value = project(c.docs[pos], fields)
+		c.docs = append(c.docs[:pos], c.docs[pos+1:]...)
+		lastError = bson.D{{Key: "n", Value: int32(1)}}
+	case len(positions) > 0:
+		pos := positions[0]
+		before := c.docs[pos]
+		after, err := applyUpdate(before, updateDoc, false)
+		if err != nil {
+			return nil, err
+		}
+		if idx, dup := c.checkUnique(after, pos); dup {
+			return nil, duplicateKeyError(db, coll, idx)
+		}
+		c.docs[pos] = after
+		value = project(before, fields)
+		if returnNew {
+			value = project(after, fields)
+		}
+		lastError = bson.D{{Key: "n", Value: int32(1)}, {Key: "updatedExisting", Value: true}}
+	case upsert && !remove:
+		doc, err := applyUpdate(upsertSeed(filter), updateDoc, true)
+		if err != nil {
+			return nil, err
+		}
+		doc = ensureID(doc)
+		if idx, dup := c.checkUnique(doc, -1); dup {
+			return nil, duplicateKeyError(db, coll, idx)
+		}
+		c.docs = append(c.docs, doc)
+		if returnNew {
+			value = project(doc, fields)
+		}
+		id, _ := lookup(doc, "_id")
+		lastError = bson.D{{Key: "n", Value: int32(1)}, {Key: "updatedExisting", Value: false}, {Key: "upserted", Value: id}}
+	}
+
+	return ok(bson.E{Key: "lastErrorObject", Value: lastError}, bson.E{Key: "value", Value: value}), nil
+}
+
+func (s *Server) createIndexes(db, coll string, cmd bson.D) (bson.D, error) {
+	_, existed := s.databases[db][coll]
+	c := s.collection(db, coll)
+	before := len(c.indexes)
+
+	specs, _ := lookup(cmd, "indexes")
+	for _, item := range specs.(bson.A) {
+		spec := item.(bson.D)
+		idx := index{
+			key:    docArg(spec, "key"),
+			unique: truthy(mustLookup(spec, "unique")),
+			sparse: truthy(mustLookup(spec, "sparse")),
+		}
+		if partial := docArg(spec, "partialFilterExpression"); len(partial) > 0 {
+			idx.partial = partial
+		}
+		idx.name, _ = mustLookup(spec, "name").(string)
+
+		exists := false
+		for _, existing := range c.indexes {
+			if existing.name == idx.name {
+				if !equal(existing.key, idx.key) || existing.unique != idx.unique {
+					return nil, &commandError{code: 86, codeName: "IndexKeySpecsConflict", message: "mongotest: index " + idx.name + " already exists with different options"}
+				}
+				exists = true
+				break
+			}
+		}
+		if exists {
+			continue
+		}
+
+		if idx.unique {
+			seen := []bson.A{}
+			for _, doc := range c.docs {
+				key := indexKey(doc, idx)
+				if key == nil {
+					continue
+				}
+				for _, other := range seen {
+					if equal(key, other) {
+						return nil, duplicateKeyError(db, coll, idx)
+					}
+				}
+				seen = append(seen, key)
+			}
+		}
+		c.indexes = append(c.indexes, idx)
+	}
+
+	return ok(
+		bson.E{Key: "createdCollectionAutomatically", Value: !existed},
+		bson.E{Key: "numIndexesBefore", Value: int32(before)},
+		bson.E{Key: "numIndexesAfter", Value: int32(len(c.indexes))},
+	), nil
+}
+
+func (s *Server) listIndexes(db, coll string) (bson.D, error) {
+	c := s.lookupCollection(db, coll)
+	var docs []bson.D
+	for _, idx := range c.indexes {
+		doc := bson.D{{Key: "v", Value: int32(2)}, {Key: "key", Value: idx.key}, {Key: "name", Value: idx.name}}
+		if idx.unique {
+			doc = append(doc, bson.E{Key: "unique", Value: true})
+		}
+		if idx.sparse {
+			doc = append(doc, bson.E{Key: "sparse", Value: true})
+		}
+		if idx.partial != nil {
+			doc = append(doc, bson.E{Key: "partialFilterExpression", Value: idx.partial})
+		}
+		docs = append(docs, doc)
+	}
+	return cursorResponse(db, coll, docs), nil
+}
+
+func (s *Server) dropIndexes(db, coll string, cmd bson.D) (bson.D, error) {
+	c := s.collection(db, coll)
+	name, _ := mustLookup(cmd, "index").(string)
+	kept := []index{}
+	for _, idx := range c.indexes {
+		if idx.name == "_id_" || (name != "*" && idx.name != name) {
+			kept = append(kept, idx)
+		}
+	}
+	c.indexes = kept
+	return ok(), nil
+}
+
+func (s *Server) listCollections(db string) bson.D {
+	var docs []bson.D
+	for name := range s.databases[db] {
+		docs = append(docs, bson.D{{Key: "name", Value: name}, {Key: "type", Value: "collection"}})
+	}
+	sortDocs(docs, bson.D{{Key: "name", Value: int32(1)}})
+	return cursorResponse(db, "$cmd.listCollections", docs)
+}
@@ -0,0 +1,838 @@
+package mongotest
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// This file implements the subset of the MongoDB query language that the
+// server uses: filters, update operators, projections and sorts.
+
+func lookup(doc bson.D, key string) (any, bool) {
+	for _, elem := range doc {
+		if elem.Key == key {
+			return elem.Value, true
+		}
+	}
+	return nil, false
+}
+
+// resolve returns every value reachable through a dotted path. Arrays found
+// along the way are traversed, and arrays at the leaf are returned both as a
+// whole and element by element, mirroring MongoDB's matching semantics.
+func resolve(value any, path []string) []any {
+	if len(path) == 0 {
+		if arr, ok := value.(bson.A); ok {
+			return append([]any{arr}, arr...)
+		}
+		return []any{value}
+	}
+
+	switch v := value.(type) {
+	case bson.D:
+		child, ok := lookup(v, path[0])
+		if !ok {
+			return nil
+		}
+		return resolve(child, path[1:])
+	case bson.A:
+		if i, err := strconv.Atoi(path[0]); err == nil {
+			if i >= 0 && i < len(v) {
+				return resolve(v[i], path[1:])
+			}
+			return nil
+		}
+		var out []any
+		for _, elem := range v {
+			if d, ok := elem.(bson.D); ok {
+				out = append(out, resolve(d, path)...)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+func resolvePath(doc bson.D, path string) []any {
+	return resolve(doc, strings.Split(path, "."))
+}
+
+// firstValue returns the first value at path, or nil if it is missing.
+func firstValue(doc bson.D, path string) any {
+	values := resolvePath(doc, path)
+	if len(values) == 0 {
+		return nil
+	}
+	return values[0]
+}
+
+func matches(doc bson.D, filter bson.D) (bool, error) {
+	for _, elem := range filter {
+		ok, err := matchElement(doc, elem)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func matchElement(doc bson.D, elem bson.E) (bool, error) {
+	switch elem.Key {
+	case "$and", "$or", "$nor":
+		clauses, ok := elem.Value.(bson.A)
+		if !ok {
+			return false, fmt.Errorf("%s requires an array", elem.Key)
+		}
+		for _, clause := range clauses {
+			sub, ok := clause.(bson.D)
+			if !ok {
+				return false, fmt.Errorf("%s clauses must be documents", elem.Key)
+			}
+			matched, err := matches(doc, sub)
+			if err != nil {
+				return false, err
+			}
+			switch {
+			case elem.Key == "$and" && !matched:
+				return false, nil
+			case elem.Key == "$or" && matched:
+				return true, nil
+			case elem.Key == "$nor" && matched:
+				return false, nil
+			}
+		}
+		return elem.Key != "$or", nil
+	case "$expr", "$where", "$text":
+		return false, fmt.Errorf("mongotest: %s is not supported", elem.Key)
+	}
+
+	return matchValues(resolvePath(doc, elem.Key), elem.Value)
+}
+
+func isOperatorDoc(value any) (bson.D, bool) {
+	d, ok := value.(bson.D)
+	if !ok || len(d) == 0 {
+		return nil, false
+	}
+	for _, elem := range d {
+		if !strings.HasPrefix(elem.Key, "$") {
+			return nil, false
+		}
+	}
+	return d, true
+}
+
+func matchValues(values []any, cond any) (bool, error) {
+	if ops, ok := isOperatorDoc(cond); ok {
+		for _, op := range ops {
+			matched, err := matchOperator(values, op, ops)
+			if err != nil || !matched {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+	if re, ok := cond.(bson.Regex); ok {
+		return matchRegex(values, re.Pattern, re.Options)
+	}
+	return anyEqual(values, cond), nil
+}
+
+func anyEqual(values []any, target any) bool {
+	if target == nil && len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if equal(v, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchOperator(values []any, op bson.E, siblings bson.D) (bool, error) {
+	switch op.Key {
+	case "$eq":
+		return anyEqual(values, op.Value), nil
+	case "$ne":
+		return !anyEqual(values, op.Value), nil
+	case "$gt", "$gte", "$lt", "$lte":
+		for _, v := range values {
+			if typeOrder(v) != typeOrder(op.Value) {
+				continue
+			}
+			c := compare(v, op.Value)
+			if (op.Key == "$gt" && c > 0) || (op.Key == "$gte" && c >= 0) ||
+				(op.Key == "$lt" && c < 0) || (op.Key == "$lte" && c <= 0) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "$in", "$nin":
+		list, ok := op.Value.(bson.A)
+		if !ok {
+			return false, fmt.Errorf("%s requires an array", op.Key)
+		}
+		found := false
+		for _, candidate := range list {
+			if anyEqual(values, candidate) {
+				found = true
+				break
+			}
+		}
+		return found == (op.Key == "$in"), nil
+	case "$exists":
+		return (len(values) > 0) == truthy(op.Value), nil
+	case "$regex":
+		pattern, _ := op.Value.(string)
+		options, _ := lookup(siblings, "$options")
+		optionStr, _ := options.(string)
+		return matchRegex(values, pattern, optionStr)
+	case "$options":
+		return true, nil
+	case "$size":
+		for _, v := range values {
+			if arr, ok := v.(bson.A); ok {
+				return float64(len(arr)) == toFloat(op.Value), nil
+			}
+		}
+		return false, nil
+	case "$all":
+		list, ok := op.Value.(bson.A)
+		if !ok {
+			return false, fmt.Errorf("$all requires an array")
+		}
+		for _, candidate := range list {
+			if !anyEqual(values, candidate) {
+				return false, nil
+			}
+		}
+		return len(list) > 0, nil
+	case "$elemMatch":
+		sub, ok := op.Value.(bson.D)
+		if !ok {
+			return false, fmt.Errorf("$elemMatch requires a document")
+		}
+		for _, v := range values {
+			arr, ok := v.(bson.A)
+			if !ok {
+				continue
+			}
+			for _, elem := range arr {
+				var matched bool
+				var err error
+				if d, isDoc := elem.(bson.D); isDoc && !isOperatorOnly(sub) {
+					matched, err = matches(d, sub)
+				} else {
+					matched, err = matchValues([]any{elem}, sub)
+				}
+				if err != nil {
+					return false, err
+				}
+				if matched {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	case "$not":
+		matched, err := matchValues(values, op.Value)
+		return !matched, err
+	}
+	return false, fmt.Errorf("mongotest: query operator %s is not supported", op.Key)
+}
+
+func isOperatorOnly(d bson.D) bool {
+	_, ok := isOperatorDoc(d)
+	return ok
+}
+
+func matchRegex(values []any, pattern, options string) (bool, error) {
+	flags := ""
+	if strings.Contains(options, "i") {
+		flags = "(?i)"
+	}
+	re, err := regexp.Compile(flags + pattern)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range values {
+		if s, ok := v.(string); ok && re.MatchString(s) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func truthy(v any) bool {
+	switch t := v.(type) {
+	case bool:
+		return t
+	case nil:
+		return false
+	case int32, int64, float64:
+		return toFloat(t) != 0
+	}
+	return true
+}
+
+func isNumber(v any) bool {
+	switch v.(type) {
+	case int32, int64, float64, int:
+		return true
+	}
+	return false
+}
+
+func toFloat(v any) float64 {
+	switch n := v.(type) {
+	case int32:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case int:
+		return float64(n)
+	case float64:
+		return n
+	}
+	return 0
+}
+
+// typeOrder follows MongoDB's BSON comparison order.
+func typeOrder(v any) int {
+	switch v.(type) {
+	case nil, bson.Null, bson.Undefined:
+		return 1
+	case int32, int64, float64, int, bson.Decimal128:
+		return 2
+	case string, bson.Symbol:
+		return 3
+	case bson.D:
+		return 4
+	case bson.A:
+		return 5
+	case bson.Binary:
+		return 6
+	case bson.ObjectID:
+		return 7
+	case bool:
+		return 8
+	case bson.DateTime, time.Time:
+		return 9
+	case bson.Timestamp:
+		return 10
+	case bson.Regex:
+		return 11
+	}
+	return 12
+}
+
+func compare(a, b any) int {
+	oa, ob := typeOrder(a), typeOrder(b)
+	if oa != ob {
+		return oa - ob
+	}
+	switch x := a.(type) {
+	case string:
+		return strings.Compare(x, b.(string))
+	case bool:
+		y := b.(bool)
+		switch {
+		case x == y:
+			return 0
+		case !x:
+			return -1
+		}
+		return 1
+	case bson.ObjectID:
+		y := b.(bson.ObjectID)
+		return bytes.Compare(x[:], y[:])
+	case bson.DateTime:
+		return compareInt(int64(x), int64(toDateTime(b)))
+	case time.Time:
+		return compareInt(int64(bson.NewDateTimeFromTime(x)), int64(toDateTime(b)))
+	case bson.Timestamp:
+		y := b.(bson.Timestamp)
+		return compareInt(int64(x.T)<<32|int64(x.I), int64(y.T)<<32|int64(y.I))
+	case bson.D, bson.A:
+		ra, _ := bson.Marshal(bson.D{{Key: "v", Value: a}})
+		rb, _ := bson.Marshal(bson.D{{Key: "v", Value: b}})
+		return bytes.Compare(ra, rb)
+	}
+	if oa == 2 {
+		fa, fb := toFloat(a), toFloat(b)
+		switch {
+		case fa < fb:
+			return -1
+		case fa > fb:
+			return 1
+		}
+		return 0
+	}
+	return 0
+}
+
+func toDateTime(v any) bson.DateTime {
+	switch t := v.(type) {
+	case bson.DateTime:
+		return t
+	case time.Time:
+		return bson.NewDateTimeFromTime(t)
+	}
+	return 0
+}
+
+func compareInt(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func equal(a, b any) bool {
+	if isNumber(a) && isNumber(b) {
+		return toFloat(a) == toFloat(b)
+	}
+	switch x := a.(type) {
+	case bson.D:
+		y, ok := b.(bson.D)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if x[i].Key != y[i].Key || !equal(x[i].Value, y[i].Value) {
+				return false
+			}
+		}
+		return true
+	case bson.A:
+		y, ok := b.(bson.A)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !equal(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case nil, bson.Null:
+		return typeOrder(b) == 1
+	}
+	if typeOrder(a) != typeOrder(b) {
+		return false
+	}
+	return compare(a, b) == 0
+}
+
+// sortDocs sorts docs in place by a sort specification such as {ranking.ranking_value: 1}.
+func sortDocs(docs []bson.D, spec bson.D) {
+	if len(spec) == 0 {
+		return
+	}
+	sort.SliceStable(docs, func(i, j int) bool {
+		return less(docs[i], docs[j], spec)
+	})
+}
+
+func less(a, b bson.D, spec bson.D) bool {
+	for _, key := range spec {
+		c := compare(sortValue(a, key.Key), sortValue(b, key.Key))
+		if c == 0 {
+			continue
+		}
+		if toFloat(key.Value) < 0 {
+			return c > 0
+		}
+		return c < 0
+	}
+	return false
+}
+
+func sortValue(doc bson.D, path string) any {
+	values := resolvePath(doc, path)
+	if len(values) == 0 {
+		return nil
+	}
+	// Arrays sort by their first element.
+	if _, ok := values[0].(bson.A); ok && len(values) > 1 {
+		return values[1]
+	}
+	return values[0]
+}
+
+// project applies an inclusion or exclusion projection.
+func project(doc bson.D, projection bson.D) bson.D {
+	if len(projection) == 0 {
+		return doc
+	}
+
+	includeID := true
+	inclusion := false
+	tree := bson.D{}
+	for _, elem := range projection {
+		if elem.Key == "_id" {
+			includeID = truthy(elem.Value)
+			continue
+		}
+		if truthy(elem.Value) {
+			inclusion = true
+		}
+		tree = addProjectionPath(tree, strings.Split(elem.Key, "."))
+	}
+
+	var out bson.D
+	if inclusion {
+		out = includeFields(doc, tree)
+		if id, ok := lookup(doc, "_id"); ok && includeID {
+			out = append(bson.D{{Key: "_id", Value: id}}, out...)
+		}
+		return out
+	}
+
+	out = excludeFields(doc, tree)
+	if !includeID {
+		out = removeKey(out, "_id")
+	}
+	return out
+}
+
+// addProjectionPath records a path in a tree of nested bson.D; leaves have a nil value.
+func addProjectionPath(tree bson.D, path []string) bson.D {
+	for i, elem := range tree {
+		if elem.Key == path[0] {
+			if len(path) == 1 {
+				tree[i].Value = nil
+				return tree
+			}
+			sub, _ := elem.Value.(bson.D)
+			if elem.Value == nil {
+				return tree
+			}
+			tree[i].Value = addProjectionPath(sub, path[1:])
+			return tree
+		}
+	}
+	if len(path) == 1 {
+		return append(tree, bson.E{Key: path[0], Value: nil})
+	}
+	return append(tree, bson.E{Key: path[0], Value: addProjectionPath(bson.D{}, path[1:])})
+}
+
+func includeFields(doc bson.D, tree bson.D) bson.D {
+	out := bson.D{}
+	for _, elem := range doc {
+		sub, ok := lookup(tree, elem.Key)
+		if !ok || elem.Key == "_id" {
+			continue
+		}
+		if sub == nil {
+			out = append(out, elem)
+			continue
+		}
+		if projected, ok := includeNested(elem.Value, sub.(bson.D)); ok {
+			out = append(out, bson.E{Key: elem.Key, Value: projected})
+		}
+	}
+	return out
+}
+
+func includeNested(value any, tree bson.D) (any, bool) {
+	switch v := value.(type) {
+	case bson.D:
+		return includeFields(v, tree), true
+	case bson.A:
+		out := bson.A{}
+		for _, elem := range v {
+			if projected, ok := includeNested(elem, tree); ok {
+				out = append(out, projected)
+			}
+		}
+		return out, true
+	}
+	return nil, false
+}
+
+func excludeFields(doc bson.D, tree bson.D) bson.D {
+	out := bson.D{}
+	for _, elem := range doc {
+		sub, ok := lookup(tree, elem.Key)
+		if !ok {
+			out = append(out, elem)
+			continue
+		}
+		if sub == nil {
+			continue
+		}
+		switch v := elem.Value.(type) {
+		case bson.D:
+			out = append(out, bson.E{Key: elem.Key, Value: excludeFields(v, sub.(bson.D))})
+		case bson.A:
+			arr := bson.A{}
+			for _, item := range v {
+				if d, isDoc := item.(bson.D); isDoc {
+					arr = append(arr, excludeFields(d, sub.(bson.D)))
+				} else {
+					arr = append(arr, item)
+				}
+			}
+			out = append(out, bson.E{Key: elem.Key, Value: arr})
+		default:
+			out = append(out, elem)
+		}
+	}
+	return out
+}
+
+func removeKey(doc bson.D, key string) bson.D {
+	out := bson.D{}
+	for _, elem := range doc {
+		if elem.Key != key {
+			out = append(out, elem)
+		}
+	}
+	return out
+}
+
+func setKey(doc bson.D, key string, value any) bson.D {
+	for i, elem := range doc {
+		if elem.Key == key {
+			doc[i].Value = value
+			return doc
+		}
+	}
+	return append(doc, bson.E{Key: key, Value: value})
+}
+
+// setPath assigns value at a dotted path, creating intermediate documents.
+func setPath(doc bson.D, path []string, value any) (bson.D, error) {
+	if len(path) == 1 {
+		return setKey(doc, path[0], value), nil
+	}
+	child, _ := lookup(doc, path[0])
+	switch c := child.(type) {
+	case nil:
+		sub, err := setPath(bson.D{}, path[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		return setKey(doc, path[0], sub), nil
+	case bson.D:
+		sub, err := setPath(c, path[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		return setKey(doc, path[0], sub), nil
+	case bson.A:
+		i, err := strconv.Atoi(path[1])
+		if err != nil || i < 0 || i >= len(c) {
+			return nil, fmt.Errorf("mongotest: cannot traverse array at %q with %q", path[0], path[1])
+		}
+		if len(path) == 2 {
+			c[i] = value
+			return doc, nil
+		}
+		elem, ok := c[i].(bson.D)
+		if !ok {
+			return nil, fmt.Errorf("mongotest: array element %s.%d is not a document", path[0], i)
+		}
+		sub, err := setPath(elem, path[2:], value)
+		if err != nil {
+			return nil, err
+		}
+		c[i] = sub
+		return doc, nil
+	}
+	return nil, fmt.Errorf("mongotest: cannot create field %q in a non-document value", path[1])
+}
+
+func unsetPath(doc bson.D, path []string) bson.D {
+	if len(path) == 1 {
+		return removeKey(doc, path[0])
+	}
+	if child, ok := lookup(doc, path[0]); ok {
+		if sub, isDoc := child.(bson.D); isDoc {
+			return setKey(doc, path[0], unsetPath(sub, path[1:]))
+		}
+	}
+	return doc
+}
+
+func isReplacement(update bson.D) bool {
+	for _, elem := range update {
+		if strings.HasPrefix(elem.Key, "$") {
+			return false
+		}
+	}
+	return true
+}
+
+// applyUpdate returns the updated copy of doc. inserting reports whether the
+// update is creating a document through an upsert, which enables $setOnInsert.
+func applyUpdate(doc bson.D, update bson.D, inserting bool) (bson.D, error) {
+	if isReplacement(update) {
+		out := bson.D{}
+		if id, ok := lookup(doc, "_id"); ok {
+			out = append(out, bson.E{Key: "_id", Value: id})
+		}
+		for _, elem := range update {
+			if elem.Key != "_id" || len(out) == 0 {
+				out = append(out, elem)
+			}
+		}
+		return out, nil
+	}
+
+	out := cloneDoc(doc)
+	var err error
+	for _, op := range update {
+		fields, ok := op.Value.(bson.D)
+		if !ok {
+			return nil, fmt.Errorf("mongotest: %s requires a document", op.Key)
+		}
+		for _, field := range fields {
+			path := strings.Split(field.Key, ".")
+			if strings.Contains(field.Key, "$") {
+				return nil, fmt.Errorf("mongotest: positional update %q is not supported", field.Key)
+			}
+			current := firstValue(out, field.Key)
+
+			switch op.Key {
+			case "$set":
+				out, err = setPath(out, path, field.Value)
+			case "$setOnInsert":
+				if inserting {
+					out, err = setPath(out, path, field.Value)
+				}
+			case "$unset":
+				out = unsetPath(out, path)
+			case "$inc":
+				sum := addNumbers(current, field.Value)
+				out, err = setPath(out, path, sum)
+			case "$min", "$max":
+				if current == nil || (op.Key == "$min" && compare(field.Value, current) < 0) || (op.Key == "$max" && compare(field.Value, current) > 0) {
+					out, err = setPath(out, path, field.Value)
+				}
+			case "$currentDate":
+				out, err = setPath(out, path, bson.NewDateTimeFromTime(time.Now()))
+			case "$push", "$addToSet":
+				arr, _ := current.(bson.A)
+				arr = append(bson.A{}, arr...)
+				items := bson.A{field.Value}
+				if d, isDoc := field.Value.(bson.D); isDoc {
+					if each, ok := lookup(d, "$each"); ok {
+						items, _ = each.(bson.A)
+					}
+				}
+				for _, item := range items {
+					if op.Key == "$addToSet" && anyEqual([]any(arr), item) {
+						continue
+					}
+					arr = append(arr, item)
+				}
+				out, err = setPath(out, path, arr)
+			case "$pull":
+				arr, _ := current.(bson.A)
+				kept := bson.A{}
+				for _, item := range arr {
+					var remove bool
+					if cond, isDoc := field.Value.(bson.D); isDoc {
+						if d, itemIsDoc := item.(bson.D); itemIsDoc && !isOperatorOnly(cond) {
+							remove, err = matches(d, cond)
+						} else {
+							remove, err = matchValues([]any{item}, cond)
+						}
+					} else {
+						remove = equal(item, field.Value)
+					}
+					if err != nil {
+						return nil, err
+					}
+					if !remove {
+						kept = append(kept, item)
+					}
+				}
+				if current != nil {
+					out, err = setPath(out, path, kept)
+				}
+			default:
+				return nil, fmt.Errorf("mongotest: update operator %s is not supported", op.Key)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return out, nil
+}
+
+func addNumbers(a, b any) any {
+	if a == nil {
+		return b
+	}
+	_, aFloat := a.(float64)
+	_, bFloat := b.(float64)
+	if aFloat || bFloat {
+		return toFloat(a) + toFloat(b)
+	}
+	_, a32 := a.(int32)
+	_, b32 := b.(int32)
+	sum := int64(toFloat(a)) + int64(toFloat(b))
+	if a32 && b32 && sum <= 1<<31-1 && sum >= -1<<31 {
+		return int32(sum)
+	}
+	return sum
+}
+
+// upsertSeed builds the starting document for an upsert from the equality
+// conditions in the query.
+func upsertSeed(filter bson.D) bson.D {
+	seed := bson.D{}
+	for _, elem := range filter {
+		if strings.HasPrefix(elem.Key, "$") {
+			continue
+		}
+		value := elem.Value
+		if ops, ok := isOperatorDoc(value); ok {
+			eq, found := lookup(ops, "$eq")
+			if !found {
+				continue
+			}
+			value = eq
+		}
+		seed, _ = setPath(seed, strings.Split(elem.Key, "."), value)
+	}
+	return seed
+}
+
+func cloneDoc(doc bson.D) bson.D {
+	out := make(bson.D, len(doc))
+	for i, elem := range doc {
+		out[i] = bson.E{Key: elem.Key, Value: cloneValue(elem.Value)}
+	}
+	return out
+}
+
+func cloneValue(v any) any {
+	switch t := v.(type) {
+	case bson.D:
+		return cloneDoc(t)
+	case bson.A:
+		out := make(bson.A, len(t))
+		for i, item := range t {
+			out[i] = cloneValue(item)
+		}
+		return out
+	}
+	return v
+}
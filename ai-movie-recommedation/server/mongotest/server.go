@@ -0,0 +1,278 @@
+// Package mongotest provides an in-process stand-in for a MongoDB server that
+// speaks enough of the wire protocol for the official Go driver, in the spirit
+// of net/http/httptest. Data lives in memory and is lost when the server closes.
+package mongotest
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+const (
+	opReply = 1
+	opQuery = 2004
+	opMsg   = 2013
+
+	flagChecksumPresent = 1 << 0
+	flagMoreToCome      = 1 << 1
+)
+
+type Server struct {
+	listener net.Listener
+
+	mu        sync.Mutex
+	databases map[string]map[string]*collection
+	requestID int32
+	connID    int32
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewServer starts a server listening on a random local port.
+func NewServer() (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		listener:  listener,
+		databases: map[string]map[string]*collection{},
+		quit:      make(chan struct{}),
+	}
+
+	s.wg.Add(1)
+	go s.acceptLoop()
+
+	return s, nil
+}
+
+// URI returns a connection string for the driver.
+func (s *Server) URI() string {
+	return fmt.Sprintf("mongodb://%s/?directConnection=true", s.listener.Addr())
+}
+
+func (s *Server) Close() error {
+	close(s.quit)
+	err := s.listener.Close()
+	s.wg.Wait()
+	return err
+}
+
+// Reset drops every database, keeping the server running.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.databases = map[string]map[string]*collection{}
+}
+
+func (s *Server) acceptLoop() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.serve(conn)
+		}()
+	}
+}
+
+// serve handles one client connection. Connections are closed when the
+// listener closes so that Close does not hang on idle pooled connections.
+func (s *Server) serve(conn net.Conn) {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-done:
+		case <-s.quit:
+		}
+		conn.Close()
+	}()
+
+	s.mu.Lock()
+	s.connID++
+	connID := s.connID
+	s.mu.Unlock()
+
+	for {
+		header := make([]byte, 16)
+		if _, err := io.ReadFull(conn, header); err != nil {
+			return
+		}
+		length := int32(binary.LittleEndian.Uint32(header[0:4]))
+		requestID := int32(binary.LittleEndian.Uint32(header[4:8]))
+		opCode := int32(binary.LittleEndian.Uint32(header[12:16]))
+		if length < 16 {
+			return
+		}
+
+		body := make([]byte, length-16)
+		if _, err := io.ReadFull(conn, body); err != nil {
+			return
+		}
+
+		var reply []byte
+		var err error
+		switch opCode {
+		case opMsg:
+			reply, err = s.handleOpMsg(requestID, body, connID)
+		case opQuery:
+			reply, err = s.handleOpQuery(requestID, body, connID)
+		default:
+			err = fmt.Errorf("mongotest: unsupported opcode %d", opCode)
+		}
+		if err != nil {
+			return
+		}
+		if reply == nil {
+			continue
+		}
+		if _, err := conn.Write(reply); err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) nextRequestID() int32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requestID++
+	return s.requestID
+}
+
+func (s *Server) handleOpMsg(requestID int32, body []byte, connID int32) ([]byte, error) {
+	if len(body) < 5 {
+		return nil, errors.New("mongotest: short OP_MSG")
+	}
+	flags := binary.LittleEndian.Uint32(body[0:4])
+	sections := body[4:]
+	if flags&flagChecksumPresent != 0 {
+		sections = sections[:len(sections)-4]
+	}
+
+	var command bson.D
+	sequences := map[string]bson.A{}
+	var sequenceOrder []string
+
+	for len(sections) > 0 {
+		kind := sections[0]
+		sections = sections[1:]
+		switch kind {
+		case 0:
+			size := int(binary.LittleEndian.Uint32(sections[0:4]))
+			if err := bson.Unmarshal(sections[:size], &command); err != nil {
+				return nil, err
+			}
+			sections = sections[size:]
+		case 1:
+			size := int(binary.LittleEndian.Uint32(sections[0:4]))
+			payload := sections[4:size]
+			sections = sections[size:]
+
+			end := 0
+			for payload[end] != 0 {
+				end++
+			}
+			identifier := string(payload[:end])
+			payload = payload[end+1:]
+
+			var docs bson.A
+			for len(payload) > 0 {
+				docSize := int(binary.LittleEndian.Uint32(payload[0:4]))
+				var doc bson.D
+				if err := bson.Unmarshal(payload[:docSize], &doc); err != nil {
+					return nil, err
+				}
+				docs = append(docs, doc)
+				payload = payload[docSize:]
+			}
+			if _, seen := sequences[identifier]; !seen {
+				sequenceOrder = append(sequenceOrder, identifier)
+			}
+			sequences[identifier] = append(sequences[identifier], docs...)
+		default:
+			return nil, fmt.Errorf("mongotest: unknown OP_MSG section kind %d", kind)
+		}
+	}
+	for _, identifier := range sequenceOrder {
+		command = append(command, bson.E{Key: identifier, Value: sequences[identifier]})
+	}
+
+	response := s.runCommand(command, connID)
+	if flags&flagMoreToCome != 0 {
+		return nil, nil
+	}
+
+	doc, err := bson.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := make([]byte, 16, 16+5+len(doc))
+	msg = binary.LittleEndian.AppendUint32(msg, 0)
+	msg = append(msg, 0)
+	msg = append(msg, doc...)
+	s.writeHeader(msg, requestID, opMsg)
+	return msg, nil
+}
+
+// handleOpQuery answers the legacy handshake the driver sends as OP_QUERY.
+func (s *Server) handleOpQuery(requestID int32, body []byte, connID int32) ([]byte, error) {
+	if len(body) < 4 {
+		return nil, errors.New("mongotest: short OP_QUERY")
+	}
+	rest := body[4:]
+	end := 0
+	for rest[end] != 0 {
+		end++
+	}
+	namespace := string(rest[:end])
+	rest = rest[end+1+8:]
+
+	size := int(binary.LittleEndian.Uint32(rest[0:4]))
+	var query bson.D
+	if err := bson.Unmarshal(rest[:size], &query); err != nil {
+		return nil, err
+	}
+	if inner, ok := lookup(query, "$query"); ok {
+		query, _ = inner.(bson.D)
+	}
+	if db, _, found := strings.Cut(namespace, "."); found {
+		query = append(query, bson.E{Key: "$db", Value: db})
+	}
+
+	response := s.runCommand(query, connID)
+	doc, err := bson.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := make([]byte, 16, 16+20+len(doc))
+	msg = binary.LittleEndian.AppendUint32(msg, 0) // responseFlags
+	msg = binary.LittleEndian.AppendUint64(msg, 0) // cursorID
+	msg = binary.LittleEndian.AppendUint32(msg, 0) // startingFrom
+	msg = binary.LittleEndian.AppendUint32(msg, 1) // numberReturned
+	msg = append(msg, doc...)
+	s.writeHeader(msg, requestID, opReply)
+	return msg, nil
+}
+
+func (s *Server) writeHeader(msg []byte, responseTo int32, opCode int32) {
+	binary.LittleEndian.PutUint32(msg[0:4], uint32(len(msg)))
+	binary.LittleEndian.PutUint32(msg[4:8], uint32(s.nextRequestID()))
+	binary.LittleEndian.PutUint32(msg[8:12], uint32(responseTo))
+	binary.LittleEndian.PutUint32(msg[12:16], uint32(opCode))
+}
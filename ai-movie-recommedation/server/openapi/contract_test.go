@@ -0,0 +1,156 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/openapi"
+	"github.com/princepal9120/ai-movie-recommedation/server/routes"
+)
+
+type specDocument struct {
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas map[string]struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+		} `json:"schemas"`
+	} `json:"components"`
+}
+
+func loadSpec(t *testing.T) specDocument {
+	t.Helper()
+	var spec specDocument
+	if err := json.Unmarshal(openapi.Spec, &spec); err != nil {
+		t.Fatalf("openapi.json is not valid JSON: %v", err)
+	}
+	return spec
+}
+
+var ginParam = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+func TestRoutesMatchSpec(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	routes.SetupUnProtectedRoutes(router, nil)
+	routes.SetupProtectedRoutes(router, nil)
+
+	registered := map[string]bool{}
+	for _, route := range router.Routes() {
+		path := ginParam.ReplaceAllString(route.Path, "{$1}")
+		registered[route.Method+" "+path] = true
+	}
+
+	documented := map[string]bool{}
+	for path, operations := range loadSpec(t).Paths {
+		for method := range operations {
+			documented[strings.ToUpper(method)+" "+path] = true
+		}
+	}
+
+	for route := range registered {
+		if !documented[route] {
+			t.Errorf("route %s is registered but missing from openapi.json", route)
+		}
+	}
+	for route := range documented {
+		if !registered[route] {
+			t.Errorf("route %s is documented in openapi.json but not registered", route)
+		}
+	}
+}
+
+func TestSchemasMatchModels(t *testing.T) {
+	spec := loadSpec(t)
+
+	cases := map[string]any{
+		"Movie":                   models.Movie{},
+		"CatalogEvent":            models.CatalogEvent{},
+		"Rating":                  models.Rating{},
+		"RatingRequest":           models.RatingRequest{},
+		"TrendingMovie":           models.TrendingMovie{},
+		"Feed":                    models.Feed{},
+		"FeedRow":                 models.FeedRow{},
+		"OnboardingSample":        models.OnboardingSample{},
+		"OnboardingResult":        models.OnboardingResult{},
+		"Experiment":              models.Experiment{},
+		"ExperimentVariant":       models.ExperimentVariant{},
+		"ExperimentRequest":       models.ExperimentRequest{},
+		"ExperimentResults":       models.ExperimentResults{},
+		"ExperimentVariantResult": models.ExperimentVariantResult{},
+		"ActivityStats":           models.ActivityStats{},
+		"MovieStats":              models.MovieStats{},
+		"Metadata":                models.Metadata{},
+		"Summary":                 models.Summary{},
+		"ChatRequest":             models.ChatRequest{},
+		"ChatFilters":             models.ChatFilters{},
+		"Job":                     models.Job{},
+		"LLMUsage":                models.LLMUsage{},
+		"PromptRef":               models.PromptRef{},
+		"PromptTemplate":          models.PromptTemplate{},
+		"PromptTemplateRequest":   models.PromptTemplateRequest{},
+		"PromptPreviewRequest":    models.PromptPreviewRequest{},
+		"PromptPreview":           models.PromptPreview{},
+		"PromptPreviewResult":     models.PromptPreviewResult{},
+		"Genre":                   models.Genre{},
+		"GenreRequest":            models.GenreRequest{},
+		"GenreUpdateRequest":      models.GenreUpdateRequest{},
+		"GenreUpdate":             models.GenreUpdate{},
+		"Ranking":                 models.Ranking{},
+		"RankingTierRequest":      models.RankingTierRequest{},
+		"RankingScaleRequest":     models.RankingScaleRequest{},
+		"RankingScaleUpdate":      models.RankingScaleUpdate{},
+		"UserLogin":               models.UserLogin{},
+		"UserResponse":            models.UserResponse{},
+		"Profile":                 models.Profile{},
+		"ProfileRequest":          models.ProfileRequest{},
+		"Problem":                 apperrors.Problem{},
+		"FieldError":              apperrors.FieldError{},
+		"ImportReport":            models.ImportReport{},
+		"RowError":                models.RowError{},
+	}
+
+	for name, model := range cases {
+		schema, ok := spec.Components.Schemas[name]
+		if !ok {
+			t.Errorf("schema %s is missing from openapi.json", name)
+			continue
+		}
+
+		var documented []string
+		for property := range schema.Properties {
+			documented = append(documented, property)
+		}
+		sort.Strings(documented)
+
+		if actual := jsonFields(reflect.TypeOf(model)); !reflect.DeepEqual(actual, documented) {
+			t.Errorf("schema %s properties = %v, model fields = %v", name, documented, actual)
+		}
+	}
+}
+
+func jsonFields(typ reflect.Type) []string {
+	var fields []string
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	return fields
+}
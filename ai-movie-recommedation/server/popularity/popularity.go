@@ -0,0 +1,212 @@
+// Package popularity turns what viewers do with movies into trending scores.
+//
+// Views, ratings and watchlist adds are counted per movie in hourly buckets
+// in the movie_activity collection. A movie's score in a window is the
+// weighted sum of its activity in that window, each bucket halved for every
+// half-life of its age, so a burst of interest today outranks the same burst
+// last week.
+package popularity
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Activity is something a viewer did with a movie; its value is the bucket
+// field that counts it.
+type Activity string
+
+const (
+	View         Activity = "views"
+	Rating       Activity = "ratings"
+	WatchlistAdd Activity = "watchlist_adds"
+)
+
+// Weights of each activity in a score: saving a movie says more than rating
+// it, which says more than opening it.
+var weights = map[Activity]float64{View: 1, Rating: 3, WatchlistAdd: 5}
+
+// Window is a trending period.
+type Window struct {
+	Name     string
+	Length   time.Duration
+	HalfLife time.Duration
+}
+
+var (
+	Day   = Window{Name: "day", Length: 24 * time.Hour, HalfLife: 6 * time.Hour}
+	Week  = Window{Name: "week", Length: 7 * 24 * time.Hour, HalfLife: 2 * 24 * time.Hour}
+	Month = Window{Name: "month", Length: 30 * 24 * time.Hour, HalfLife: 7 * 24 * time.Hour}
+)
+
+// ErrUnknownWindow is returned by WindowNamed for a name that is not a window.
+var ErrUnknownWindow = errors.New("unknown trending window")
+
+// WindowNamed returns the window called name: day, week or month.
+func WindowNamed(name string) (Window, error) {
+	for _, window := range []Window{Day, Week, Month} {
+		if window.Name == name {
+			return window, nil
+		}
+	}
+	return Window{}, ErrUnknownWindow
+}
+
+func collection(client *mongo.Client) *mongo.Collection {
+	return database.OpenCollection("movie_activity", client)
+}
+
+// Record counts one activity for the movie in the current hour.
+func Record(ctx context.Context, client *mongo.Client, imdbID string, activity Activity) error {
+	hour := time.Now().UTC().Truncate(time.Hour)
+	_, err := collection(client).UpdateOne(ctx,
+		bson.D{{Key: "imdb_id", Value: imdbID}, {Key: "hour", Value: hour}},
+		bson.D{{Key: "$inc", Value: bson.D{{Key: string(activity), Value: 1}}}},
+		options.UpdateOne().SetUpsert(true))
+	return err
+}
+
+// bucket is one movie's activity in one hour.
+type bucket struct {
+	ImdbID        string    `bson:"imdb_id"`
+	Hour          time.Time `bson:"hour"`
+	Views         int       `bson:"views"`
+	Ratings       int       `bson:"ratings"`
+	WatchlistAdds int       `bson:"watchlist_adds"`
+}
+
+// score is the bucket's weighted activity decayed to now. Activity is taken to
+// have happened in the middle of its hour.
+func (b *bucket) score(now time.Time, halfLife time.Duration) float64 {
+	age := max(now.Sub(b.Hour.Add(30*time.Minute)), 0)
+	weighted := float64(b.Views)*weights[View] +
+		float64(b.Ratings)*weights[Rating] +
+		float64(b.WatchlistAdds)*weights[WatchlistAdd]
+	return weighted * math.Exp2(-age.Hours()/halfLife.Hours())
+}
+
+func (b *bucket) within(now time.Time, window Window) bool {
+	return !b.Hour.Before(now.Add(-window.Length).Truncate(time.Hour))
+}
+
+// Score is a movie's popularity in a window.
+type Score struct {
+	ImdbID string
+	Score  float64
+}
+
+// Trending returns the limit highest scoring movies in window, best first.
+// Movies without activity in the window are left out.
+func Trending(ctx context.Context, client *mongo.Client, window Window, limit int) ([]Score, error) {
+	now := time.Now().UTC()
+	cursor, err := collection(client).Find(ctx,
+		bson.D{{Key: "hour", Value: bson.D{{Key: "$gte", Value: now.Add(-window.Length).Truncate(time.Hour)}}}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	totals := map[string]float64{}
+	for cursor.Next(ctx) {
+		var b bucket
+		if err := cursor.Decode(&b); err != nil {
+			return nil, err
+		}
+		totals[b.ImdbID] += b.score(now, window.HalfLife)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	scores := make([]Score, 0, len(totals))
+	for imdbID, score := range totals {
+		scores = append(scores, Score{ImdbID: imdbID, Score: round(score)})
+	}
+	slices.SortFunc(scores, func(a, b Score) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ImdbID, b.ImdbID)
+	})
+	if len(scores) > limit {
+		scores = scores[:limit]
+	}
+	return scores, nil
+}
+
+// Stats returns the movie's activity in every window, and its ratings and
+// watchlist entries of all time.
+func Stats(ctx context.Context, client *mongo.Client, imdbID string) (*models.MovieStats, error) {
+	now := time.Now().UTC()
+	cursor, err := collection(client).Find(ctx, bson.D{
+		{Key: "imdb_id", Value: imdbID},
+		{Key: "hour", Value: bson.D{{Key: "$gte", Value: now.Add(-Month.Length).Truncate(time.Hour)}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var buckets []bucket
+	if err := cursor.All(ctx, &buckets); err != nil {
+		return nil, err
+	}
+
+	stats := &models.MovieStats{ImdbID: imdbID}
+	for window, into := range map[Window]*models.ActivityStats{Day: &stats.Day, Week: &stats.Week, Month: &stats.Month} {
+		for i := range buckets {
+			b := &buckets[i]
+			if !b.within(now, window) {
+				continue
+			}
+			into.Views += b.Views
+			into.Ratings += b.Ratings
+			into.WatchlistAdds += b.WatchlistAdds
+			into.Score += b.score(now, window.HalfLife)
+		}
+		into.Score = round(into.Score)
+	}
+
+	ratings, err := database.OpenCollection("ratings", client).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "imdb_id", Value: imdbID}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: nil},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			{Key: "average", Value: bson.D{{Key: "$avg", Value: "$score"}}},
+		}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var summary []struct {
+		Count   int     `bson:"count"`
+		Average float64 `bson:"average"`
+	}
+	if err := ratings.All(ctx, &summary); err != nil {
+		return nil, err
+	}
+	if len(summary) > 0 {
+		stats.RatingCount = summary[0].Count
+		stats.AverageRating = round(summary[0].Average)
+	}
+
+	watchlisted, err := database.OpenCollection("watchlist", client).CountDocuments(ctx, bson.D{{Key: "imdb_id", Value: imdbID}})
+	if err != nil {
+		return nil, err
+	}
+	stats.Watchlisted = int(watchlisted)
+	return stats, nil
+}
+
+// round keeps scores readable; finer differences mean nothing.
+func round(score float64) float64 {
+	return math.Round(score*1000) / 1000
+}
@@ -0,0 +1,161 @@
+// Package profile keeps the profiles of households sharing an account. A
+// profile has its own favourite genres, maturity setting, ratings and
+// watchlist. The account itself acts as a further, unrestricted profile: it
+// owns the activity recorded without a profile selected, including all
+// activity from before profiles existed.
+package profile
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// MaxPerAccount bounds the profiles of an account.
+const MaxPerAccount = 5
+
+var (
+	// ErrNotFound is returned for a profile that does not exist or belongs
+	// to another account.
+	ErrNotFound = errors.New("profile not found")
+	// ErrLimit is returned when creating a profile on an account that
+	// already has MaxPerAccount.
+	ErrLimit = errors.New("profile limit reached")
+)
+
+// activityCollections hold per-profile activity.
+var activityCollections = []string{"ratings", "watchlist"}
+
+func collection(client *mongo.Client) *mongo.Collection {
+	return database.OpenCollection("profiles", client)
+}
+
+// Filter selects the activity of a profile in the ratings and watchlist
+// collections. An empty profileID selects the account's own activity.
+func Filter(userID, profileID string) bson.D {
+	if profileID == "" {
+		return bson.D{{Key: "user_id", Value: userID}, {Key: "profile_id", Value: bson.D{{Key: "$exists", Value: false}}}}
+	}
+	return bson.D{{Key: "user_id", Value: userID}, {Key: "profile_id", Value: profileID}}
+}
+
+// Create adds a profile to the account. The request's genres must already be
+// resolved against the genres collection.
+func Create(ctx context.Context, client *mongo.Client, userID string, req models.ProfileRequest) (*models.Profile, error) {
+	count, err := collection(client).CountDocuments(ctx, bson.D{{Key: "user_id", Value: userID}})
+	if err != nil {
+		return nil, err
+	}
+	if count >= MaxPerAccount {
+		return nil, ErrLimit
+	}
+
+	now := time.Now().UTC()
+	profile := models.Profile{
+		ProfileID:       bson.NewObjectID().Hex(),
+		UserID:          userID,
+		Name:            req.Name,
+		FavouriteGenres: genresOrEmpty(req.FavouriteGenres),
+		Maturity:        req.Maturity,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if _, err := collection(client).InsertOne(ctx, profile); err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// List returns the account's profiles in the order they were created.
+func List(ctx context.Context, client *mongo.Client, userID string) ([]models.Profile, error) {
+	cursor, err := collection(client).Find(ctx, bson.D{{Key: "user_id", Value: userID}},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "profile_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	profiles := []models.Profile{}
+	if err := cursor.All(ctx, &profiles); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// Get returns the account's profile, or ErrNotFound.
+func Get(ctx context.Context, client *mongo.Client, userID, profileID string) (*models.Profile, error) {
+	var profile models.Profile
+	err := collection(client).FindOne(ctx, bson.D{{Key: "user_id", Value: userID}, {Key: "profile_id", Value: profileID}}).Decode(&profile)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// Update replaces the profile's name, genres and maturity setting.
+func Update(ctx context.Context, client *mongo.Client, userID, profileID string, req models.ProfileRequest) (*models.Profile, error) {
+	result, err := collection(client).UpdateOne(ctx,
+		bson.D{{Key: "user_id", Value: userID}, {Key: "profile_id", Value: profileID}},
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "name", Value: req.Name},
+			{Key: "favourite_genres", Value: genresOrEmpty(req.FavouriteGenres)},
+			{Key: "maturity", Value: req.Maturity},
+			{Key: "updated_at", Value: time.Now().UTC()},
+		}}})
+	if err != nil {
+		return nil, err
+	}
+	if result.MatchedCount == 0 {
+		return nil, ErrNotFound
+	}
+	return Get(ctx, client, userID, profileID)
+}
+
+// SetGenres replaces the profile's favourite genres.
+func SetGenres(ctx context.Context, client *mongo.Client, userID, profileID string, genres []models.Genre) error {
+	result, err := collection(client).UpdateOne(ctx,
+		bson.D{{Key: "user_id", Value: userID}, {Key: "profile_id", Value: profileID}},
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "favourite_genres", Value: genresOrEmpty(genres)},
+			{Key: "updated_at", Value: time.Now().UTC()},
+		}}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Delete removes the profile with its ratings and watchlist. Its past
+// activity still counts towards the popularity of movies.
+func Delete(ctx context.Context, client *mongo.Client, userID, profileID string) error {
+	result, err := collection(client).DeleteOne(ctx, bson.D{{Key: "user_id", Value: userID}, {Key: "profile_id", Value: profileID}})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+	for _, name := range activityCollections {
+		if _, err := database.OpenCollection(name, client).DeleteMany(ctx, Filter(userID, profileID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func genresOrEmpty(genres []models.Genre) []models.Genre {
+	if genres == nil {
+		return []models.Genre{}
+	}
+	return genres
+}
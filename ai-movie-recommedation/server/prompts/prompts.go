@@ -0,0 +1,291 @@
+// Package prompts manages the versioned templates the server renders into LLM
+// prompts. Each name has built-in versions, embedded from templates/ as
+// <name>.v<version>.tmpl, and any versions admins saved to the
+// prompt_templates collection. Versions are never edited; the highest one is
+// in use. Templates use text/template with a join function.
+package prompts
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ReviewRanking classifies an admin review. It is rendered with
+// ReviewRankingData.
+const ReviewRanking = "review_ranking"
+
+// ReviewRankingData is what review ranking templates can refer to.
+type ReviewRankingData struct {
+	Rankings []string
+	Review   string
+}
+
+// MovieSummary writes a movie's synopsis, mood tags and content warnings. It
+// is rendered with MovieSummaryData.
+const MovieSummary = "movie_summary"
+
+// MovieSummaryData is what movie summary templates can refer to. Fields the
+// movie does not have are left empty.
+type MovieSummaryData struct {
+	Title    string
+	Genres   []string
+	Year     int
+	Director string
+	Cast     []string
+	Overview string
+	Review   string
+}
+
+// RecommendationFilters turns a viewer's request into catalog filters. It is
+// rendered with RecommendationFiltersData.
+const RecommendationFilters = "recommendation_filters"
+
+// RecommendationFiltersData is what recommendation filter templates can refer
+// to: the request and the values the catalog can be filtered by.
+type RecommendationFiltersData struct {
+	Genres          []string
+	MoodTags        []string
+	ContentWarnings []string
+	Message         string
+}
+
+const (
+	SourceBuiltin  = "builtin"
+	SourceDatabase = "database"
+)
+
+var (
+	ErrNotFound = errors.New("prompt template not found")
+	// ErrInvalid is wrapped by every error from Validate and Render.
+	ErrInvalid = errors.New("invalid prompt template")
+)
+
+// samples are rendered by Validate. Each template must place the marker
+// somewhere in its output, otherwise it would silently drop its input.
+var samples = map[string]struct {
+	data   any
+	marker string
+}{
+	ReviewRanking: {
+		data:   ReviewRankingData{Rankings: []string{"Excellent", "Good", "Okay"}, Review: "<<sample review>>"},
+		marker: "<<sample review>>",
+	},
+	MovieSummary: {
+		data: MovieSummaryData{
+			Title:    "<<sample title>>",
+			Genres:   []string{"Drama"},
+			Year:     1994,
+			Director: "Sample Director",
+			Cast:     []string{"First Actor", "Second Actor"},
+			Overview: "A sample overview.",
+			Review:   "A sample review.",
+		},
+		marker: "<<sample title>>",
+	},
+	RecommendationFilters: {
+		data: RecommendationFiltersData{
+			Genres:          []string{"Comedy", "Drama"},
+			MoodTags:        []string{"funny", "tense"},
+			ContentWarnings: []string{"violence"},
+			Message:         "<<sample request>>",
+		},
+		marker: "<<sample request>>",
+	},
+}
+
+var funcs = template.FuncMap{"join": strings.Join}
+
+//go:embed templates/*.tmpl
+var builtinFiles embed.FS
+
+var builtinName = regexp.MustCompile(`^([a-z_]+)\.v([0-9]+)\.tmpl$`)
+
+// builtins indexes the embedded templates by name, in version order.
+var builtins = sync.OnceValues(func() (map[string][]models.PromptTemplate, error) {
+	files, err := fs.Glob(builtinFiles, "templates/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	byName := map[string][]models.PromptTemplate{}
+	for _, file := range files {
+		match := builtinName.FindStringSubmatch(path.Base(file))
+		if match == nil {
+			return nil, fmt.Errorf("built-in prompt %s is not named <name>.v<version>.tmpl", file)
+		}
+		version, _ := strconv.Atoi(match[2])
+		body, err := builtinFiles.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		byName[match[1]] = append(byName[match[1]], models.PromptTemplate{
+			Name:    match[1],
+			Version: version,
+			Body:    string(body),
+			Source:  SourceBuiltin,
+		})
+	}
+	for _, versions := range byName {
+		sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+	}
+	return byName, nil
+})
+
+// Known reports whether name has built-in versions, which is what makes it a
+// prompt the server uses.
+func Known(name string) bool {
+	byName, err := builtins()
+	return err == nil && len(byName[name]) > 0
+}
+
+func collection(client *mongo.Client) *mongo.Collection {
+	return database.OpenCollection("prompt_templates", client)
+}
+
+// List returns every version of name, newest first.
+func List(ctx context.Context, client *mongo.Client, name string) ([]models.PromptTemplate, error) {
+	byName, err := builtins()
+	if err != nil {
+		return nil, err
+	}
+	if len(byName[name]) == 0 {
+		return nil, ErrNotFound
+	}
+
+	cursor, err := collection(client).Find(ctx, bson.D{{Key: "name", Value: name}})
+	if err != nil {
+		return nil, err
+	}
+	var stored []models.PromptTemplate
+	if err := cursor.All(ctx, &stored); err != nil {
+		return nil, err
+	}
+	for i := range stored {
+		stored[i].Source = SourceDatabase
+	}
+
+	versions := append(append([]models.PromptTemplate{}, byName[name]...), stored...)
+	sort.SliceStable(versions, func(i, j int) bool { return versions[i].Version > versions[j].Version })
+	return versions, nil
+}
+
+// Latest returns the version of name in use.
+func Latest(ctx context.Context, client *mongo.Client, name string) (*models.PromptTemplate, error) {
+	byName, err := builtins()
+	if err != nil {
+		return nil, err
+	}
+	versions := byName[name]
+	if len(versions) == 0 {
+		return nil, ErrNotFound
+	}
+	latest := versions[len(versions)-1]
+
+	var stored models.PromptTemplate
+	opts := options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}})
+	err = collection(client).FindOne(ctx, bson.D{{Key: "name", Value: name}}, opts).Decode(&stored)
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+	case err != nil:
+		return nil, err
+	case stored.Version > latest.Version:
+		stored.Source = SourceDatabase
+		latest = stored
+	}
+	return &latest, nil
+}
+
+// Get returns one version of name.
+func Get(ctx context.Context, client *mongo.Client, name string, version int) (*models.PromptTemplate, error) {
+	versions, err := List(ctx, client, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, prompt := range versions {
+		if prompt.Version == version {
+			return &prompt, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// Create validates body and saves it as the next version of name, which then
+// becomes the version in use. Two concurrent saves cannot claim the same
+// version: the loser gets a duplicate key error.
+func Create(ctx context.Context, client *mongo.Client, name, body, description, createdBy string) (*models.PromptTemplate, error) {
+	if err := Validate(name, body); err != nil {
+		return nil, err
+	}
+	latest, err := Latest(ctx, client, name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	saved := models.PromptTemplate{
+		Name:        name,
+		Version:     latest.Version + 1,
+		Body:        body,
+		Description: description,
+		CreatedBy:   createdBy,
+		CreatedAt:   &now,
+	}
+	if _, err := collection(client).InsertOne(ctx, saved); err != nil {
+		return nil, err
+	}
+	saved.Source = SourceDatabase
+	return &saved, nil
+}
+
+// Validate parses body and renders it with sample data for name.
+func Validate(name, body string) error {
+	sample, ok := samples[name]
+	if !ok {
+		return ErrNotFound
+	}
+	rendered, err := Render(&models.PromptTemplate{Name: name, Body: body}, sample.data)
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(rendered, sample.marker) {
+		return fmt.Errorf("%w: the template never outputs its input", ErrInvalid)
+	}
+	return nil
+}
+
+// Render executes the template with data. Referring to a field data does not
+// have is an error rather than an empty string.
+func Render(prompt *models.PromptTemplate, data any) (string, error) {
+	parsed, err := template.New(prompt.Name).Funcs(funcs).Option("missingkey=error").Parse(prompt.Body)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	var out strings.Builder
+	if err := parsed.Execute(&out, data); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	return strings.TrimSpace(out.String()), nil
+}
+
+// Ref identifies prompt in results it produced.
+func Ref(prompt *models.PromptTemplate) models.PromptRef {
+	return models.PromptRef{Name: prompt.Name, Version: prompt.Version}
+}
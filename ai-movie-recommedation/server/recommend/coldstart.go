@@ -0,0 +1,198 @@
+package recommend
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/popularity"
+	"github.com/princepal9120/ai-movie-recommedation/server/profile"
+	"github.com/princepal9120/ai-movie-recommedation/server/taxonomy"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const (
+	// MinOnboardingRatings is how many movies a new user rates before their
+	// favourite genres are derived from the ratings.
+	MinOnboardingRatings = 3
+	// maxDerivedGenres bounds the favourite genres derived from ratings.
+	maxDerivedGenres = 3
+	// neutralScore is the rating that says nothing about a genre; higher
+	// scores count for it and lower ones against it.
+	neutralScore = 3
+)
+
+// Popular recommends what is trending this month, topped up with the best
+// ranked movies. It needs nothing from the viewer, so it serves users whose
+// preferences are not known yet.
+type Popular struct {
+	Client *mongo.Client
+}
+
+func (s *Popular) Name() string { return "popular" }
+
+func (s *Popular) Recommend(ctx context.Context, viewer Viewer, limit int, exclude []string) ([]models.Movie, error) {
+	if limit <= 0 {
+		return []models.Movie{}, nil
+	}
+	trending := &Trending{Client: s.Client, Window: popularity.Month}
+	movies, err := trending.Recommend(ctx, viewer, limit, exclude)
+	if err != nil || len(movies) == limit {
+		return movies, err
+	}
+
+	exclude = slices.Clone(exclude)
+	for _, movie := range movies {
+		exclude = append(exclude, movie.ImdbID)
+	}
+	ranked, err := find(ctx, s.Client, viewer.Filter(bson.D{}), bson.D{{Key: "ranking.ranking_value", Value: 1}}, limit-len(movies), exclude)
+	if err != nil {
+		return nil, err
+	}
+	return append(movies, ranked...), nil
+}
+
+// TopPicks is the strategy for a viewer's own recommendations: their
+// favourite genres once they have some, and what is popular until then.
+func TopPicks(client *mongo.Client, genres taxonomy.Index, viewer Viewer) Strategy {
+	if len(viewer.Genres) == 0 {
+		return &Popular{Client: client}
+	}
+	return &GenreMatch{Client: client, Genres: genres}
+}
+
+// Sample returns up to limit movies for a new viewer to rate, taking the best
+// ranked movies of each top-level genre and its sub-genres in turn, so that
+// the sample spans the catalog. Movies the viewer already rated, or may not
+// be recommended, are left out.
+func Sample(ctx context.Context, client *mongo.Client, viewer Viewer, limit int) ([]models.Movie, error) {
+	genres, err := taxonomy.Load(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	ratings, err := viewerRatings(ctx, client, viewer)
+	if err != nil {
+		return nil, err
+	}
+	rated := make([]string, len(ratings))
+	for i, rating := range ratings {
+		rated[i] = rating.ImdbID
+	}
+
+	var roots []models.Genre
+	for _, genre := range genres {
+		if genre.ParentID == nil {
+			roots = append(roots, genre)
+		}
+	}
+	slices.SortFunc(roots, func(a, b models.Genre) int { return cmp.Compare(a.GenreID, b.GenreID) })
+
+	perGenre := make([][]models.Movie, len(roots))
+	for i, root := range roots {
+		filter := bson.D{{Key: "genre.genre_name", Value: bson.D{{Key: "$in", Value: genres.Subtree([]string{root.GenreName})}}}}
+		if perGenre[i], err = find(ctx, client, viewer.Filter(filter), bson.D{{Key: "ranking.ranking_value", Value: 1}}, limit, rated); err != nil {
+			return nil, err
+		}
+	}
+
+	// Take one movie from each genre in turn. A movie in several genres is
+	// offered for the first one only.
+	sample := []models.Movie{}
+	taken := map[string]bool{}
+	next := make([]int, len(perGenre))
+	for added := true; added && len(sample) < limit; {
+		added = false
+		for i, movies := range perGenre {
+			for next[i] < len(movies) && taken[movies[next[i]].ImdbID] {
+				next[i]++
+			}
+			if next[i] == len(movies) || len(sample) == limit {
+				continue
+			}
+			sample = append(sample, movies[next[i]])
+			taken[movies[next[i]].ImdbID] = true
+			next[i]++
+			added = true
+		}
+	}
+	return sample, nil
+}
+
+// PreferredGenres derives a viewer's favourite genres from their ratings with
+// GenresFromRatings.
+func PreferredGenres(ctx context.Context, client *mongo.Client, viewer Viewer) ([]models.Genre, error) {
+	ratings, err := viewerRatings(ctx, client, viewer)
+	if err != nil {
+		return nil, err
+	}
+	scores := make(map[string]int, len(ratings))
+	ids := make([]string, len(ratings))
+	for i, rating := range ratings {
+		scores[rating.ImdbID] = rating.Score
+		ids[i] = rating.ImdbID
+	}
+	movies, err := ByID(ctx, client, ids)
+	if err != nil {
+		return nil, err
+	}
+	genres, err := taxonomy.Load(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return GenresFromRatings(scores, movies, genres), nil
+}
+
+// GenresFromRatings derives favourite genres from scores, the ratings by IMDb
+// ID of the given movies. Each rating counts for the genres of its movie by
+// how far it is above or below a neutral 3, and the genres that come out
+// ahead are returned, strongest first.
+func GenresFromRatings(scores map[string]int, movies []models.Movie, genres taxonomy.Index) []models.Genre {
+	totals := map[int]int{}
+	for _, movie := range movies {
+		score, ok := scores[movie.ImdbID]
+		if !ok {
+			continue
+		}
+		for _, genre := range movie.Genre {
+			totals[genre.GenreID] += score - neutralScore
+		}
+	}
+	preferred := []models.Genre{}
+	for genreID, total := range totals {
+		// Genres deleted since the movie was tagged are skipped.
+		if genre, ok := genres[genreID]; ok && total > 0 {
+			preferred = append(preferred, genre)
+		}
+	}
+	slices.SortFunc(preferred, func(a, b models.Genre) int {
+		if c := cmp.Compare(totals[b.GenreID], totals[a.GenreID]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.GenreID, b.GenreID)
+	})
+	if len(preferred) > maxDerivedGenres {
+		preferred = preferred[:maxDerivedGenres]
+	}
+	return preferred
+}
+
+// RatingCount returns how many movies the viewer has rated.
+func RatingCount(ctx context.Context, client *mongo.Client, viewer Viewer) (int, error) {
+	count, err := database.OpenCollection("ratings", client).CountDocuments(ctx, profile.Filter(viewer.UserID, viewer.ProfileID))
+	return int(count), err
+}
+
+func viewerRatings(ctx context.Context, client *mongo.Client, viewer Viewer) ([]models.Rating, error) {
+	cursor, err := database.OpenCollection("ratings", client).Find(ctx, profile.Filter(viewer.UserID, viewer.ProfileID))
+	if err != nil {
+		return nil, err
+	}
+	var ratings []models.Rating
+	if err := cursor.All(ctx, &ratings); err != nil {
+		return nil, err
+	}
+	return ratings, nil
+}
@@ -0,0 +1,142 @@
+package recommend
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/popularity"
+	"github.com/princepal9120/ai-movie-recommedation/server/taxonomy"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// RowLimits is how many movies each feed row holds. A limit of zero leaves the
+// row out.
+type RowLimits struct {
+	TopPicks int
+	// Genre is the size of each "Because you like" row, and GenreRows how many
+	// of the viewer's favourite genres get one.
+	Genre     int
+	GenreRows int
+	Trending  int
+	New       int
+}
+
+// DefaultRowLimits are used for the rows FEED_ROW_LIMITS does not set.
+var DefaultRowLimits = RowLimits{TopPicks: 5, Genre: 8, GenreRows: 3, Trending: 10, New: 10}
+
+const maxRowLimit = 50
+
+var deprecatedOnce sync.Once
+
+// Limits reads the row limits from FEED_ROW_LIMITS, a comma separated list of
+// row=limit pairs such as "top_picks=10,genre=8,genre_rows=3,trending=10,new=10".
+// It is read on every call so that limits can change without a restart. The
+// deprecated RECOMMENDED_MOVIE_LIMIT still sets top_picks when
+// FEED_ROW_LIMITS does not.
+func Limits() RowLimits {
+	limits := DefaultRowLimits
+
+	if value := os.Getenv("RECOMMENDED_MOVIE_LIMIT"); value != "" {
+		deprecatedOnce.Do(func() {
+			slog.Warn("RECOMMENDED_MOVIE_LIMIT is deprecated, set top_picks in FEED_ROW_LIMITS instead")
+		})
+		if n, err := strconv.Atoi(value); err == nil && n >= 0 && n <= maxRowLimit {
+			limits.TopPicks = n
+		}
+	}
+
+	value := os.Getenv("FEED_ROW_LIMITS")
+	if value == "" {
+		return limits
+	}
+	parsed, err := ParseLimits(value, limits)
+	if err != nil {
+		slog.Warn("invalid FEED_ROW_LIMITS, using the defaults", "value", value, "error", err)
+		return limits
+	}
+	return parsed
+}
+
+// ParseLimits applies the row=limit pairs in value to base.
+func ParseLimits(value string, base RowLimits) (RowLimits, error) {
+	limits := base
+	fields := map[string]*int{
+		"top_picks":  &limits.TopPicks,
+		"genre":      &limits.Genre,
+		"genre_rows": &limits.GenreRows,
+		"trending":   &limits.Trending,
+		"new":        &limits.New,
+	}
+	for pair := range strings.SplitSeq(value, ",") {
+		name, number, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		field, known := fields[name]
+		if !ok || !known {
+			return base, fmt.Errorf("%q is not a row=limit pair", pair)
+		}
+		n, err := strconv.Atoi(number)
+		if err != nil || n < 0 || n > maxRowLimit {
+			return base, fmt.Errorf("%s must be a number from 0 to %d", name, maxRowLimit)
+		}
+		*field = n
+	}
+	return limits, nil
+}
+
+// Feed builds the viewer's home feed: their top picks (popular movies until
+// they have favourite genres), a row for each of their first favourite
+// genres, what is trending this week and what was added last. A movie appears
+// in the first row that has it only, and empty rows are left out.
+func Feed(ctx context.Context, client *mongo.Client, viewer Viewer, limits RowLimits) ([]models.FeedRow, error) {
+	genres, err := taxonomy.Load(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	genreMatch := &GenreMatch{Client: client, Genres: genres}
+
+	type row struct {
+		id, title string
+		strategy  Strategy
+		viewer    Viewer
+		limit     int
+	}
+	rows := []row{{"top_picks", "Top picks for you", TopPicks(client, genres, viewer), viewer, limits.TopPicks}}
+	for i, genre := range viewer.Genres {
+		if i == limits.GenreRows {
+			break
+		}
+		// Each genre row recommends for a viewer who likes only that genre.
+		genreViewer := viewer
+		genreViewer.Genres = []string{genre}
+		rows = append(rows, row{"genre:" + genre, "Because you like " + genre, genreMatch, genreViewer, limits.Genre})
+	}
+	rows = append(rows,
+		row{"trending", "Trending", &Trending{Client: client, Window: popularity.Week}, viewer, limits.Trending},
+		row{"new", "Newly added", &Newest{Client: client}, viewer, limits.New},
+	)
+
+	feed := []models.FeedRow{}
+	var shown []string
+	for _, r := range rows {
+		if r.limit == 0 {
+			continue
+		}
+		movies, err := r.strategy.Recommend(ctx, r.viewer, r.limit, shown)
+		if err != nil {
+			return nil, fmt.Errorf("%s row: %w", r.id, err)
+		}
+		if len(movies) == 0 {
+			continue
+		}
+		for _, movie := range movies {
+			shown = append(shown, movie.ImdbID)
+		}
+		feed = append(feed, models.FeedRow{ID: r.id, Title: r.title, Strategy: r.strategy.Name(), Movies: movies})
+	}
+	return feed, nil
+}
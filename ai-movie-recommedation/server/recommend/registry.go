@@ -0,0 +1,48 @@
+package recommend
+
+import (
+	"slices"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/popularity"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// strategies builds the live strategies by the name experiments refer to
+// them by.
+var strategies = map[string]func(client *mongo.Client, viewer Viewer) Strategy{
+	"top_picks": func(client *mongo.Client, viewer Viewer) Strategy {
+		return TopPicks(client, nil, viewer)
+	},
+	"genre_match": func(client *mongo.Client, _ Viewer) Strategy {
+		return &GenreMatch{Client: client}
+	},
+	"popular": func(client *mongo.Client, _ Viewer) Strategy {
+		return &Popular{Client: client}
+	},
+	"trending": func(client *mongo.Client, _ Viewer) Strategy {
+		return &Trending{Client: client, Window: popularity.Week}
+	},
+	"newest": func(client *mongo.Client, _ Viewer) Strategy {
+		return &Newest{Client: client}
+	},
+}
+
+// Named returns the strategy called name for viewer. top_picks is what
+// GET /recommendedmovies serves outside experiments.
+func Named(name string, client *mongo.Client, viewer Viewer) (Strategy, bool) {
+	build, ok := strategies[name]
+	if !ok {
+		return nil, false
+	}
+	return build(client, viewer), true
+}
+
+// Names lists the strategies Named knows, sorted.
+func Names() []string {
+	names := make([]string, 0, len(strategies))
+	for name := range strategies {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,187 @@
+// Package recommend holds the strategies movies are recommended by, and
+// composes them into the rows of the home feed.
+package recommend
+
+import (
+	"context"
+	"math"
+	"slices"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/popularity"
+	"github.com/princepal9120/ai-movie-recommedation/server/taxonomy"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Viewer is who recommendations are for.
+type Viewer struct {
+	UserID string
+	// ProfileID is the household profile watching, empty for the account
+	// itself.
+	ProfileID string
+	// Genres are the names of the viewer's favourite genres.
+	Genres []string
+	// Maturity is the profile's maturity setting; empty allows every movie.
+	Maturity string
+}
+
+// Allows reports whether the movie suits the viewer's maturity setting.
+func (v Viewer) Allows(movie models.Movie) bool {
+	allowed := models.AllowedMaturities(v.Maturity)
+	return allowed == nil || slices.Contains(allowed, movie.Maturity)
+}
+
+// Filter narrows a movies query to what the viewer may be recommended.
+func (v Viewer) Filter(filter bson.D) bson.D {
+	if allowed := models.AllowedMaturities(v.Maturity); allowed != nil {
+		filter = append(slices.Clone(filter), bson.E{Key: "maturity", Value: bson.D{{Key: "$in", Value: allowed}}})
+	}
+	return filter
+}
+
+// Strategy recommends up to limit movies for a viewer, best first, leaving out
+// the movies in exclude.
+type Strategy interface {
+	Name() string
+	Recommend(ctx context.Context, viewer Viewer, limit int, exclude []string) ([]models.Movie, error)
+}
+
+// GenreMatch recommends the best ranked movies in the viewer's favourite
+// genres and their sub-genres.
+type GenreMatch struct {
+	Client *mongo.Client
+	// Genres is loaded on every call when nil.
+	Genres taxonomy.Index
+}
+
+func (s *GenreMatch) Name() string { return "genre_match" }
+
+func (s *GenreMatch) Recommend(ctx context.Context, viewer Viewer, limit int, exclude []string) ([]models.Movie, error) {
+	if len(viewer.Genres) == 0 || limit <= 0 {
+		return []models.Movie{}, nil
+	}
+	genres := s.Genres
+	if genres == nil {
+		var err error
+		if genres, err = taxonomy.Load(ctx, s.Client); err != nil {
+			return nil, err
+		}
+	}
+
+	// A fan of a genre is recommended its sub-genres too.
+	filter := bson.D{{Key: "genre.genre_name", Value: bson.D{{Key: "$in", Value: genres.Subtree(viewer.Genres)}}}}
+	return find(ctx, s.Client, viewer.Filter(filter), bson.D{{Key: "ranking.ranking_value", Value: 1}}, limit, exclude)
+}
+
+// Trending recommends the most popular movies of a window, the same for every
+// viewer of a maturity setting.
+type Trending struct {
+	Client *mongo.Client
+	Window popularity.Window
+}
+
+func (s *Trending) Name() string { return "trending" }
+
+func (s *Trending) Recommend(ctx context.Context, viewer Viewer, limit int, exclude []string) ([]models.Movie, error) {
+	if limit <= 0 {
+		return []models.Movie{}, nil
+	}
+	// A restricted viewer may be allowed none of the top movies, so every
+	// trending movie is a candidate.
+	candidates := limit + len(exclude)
+	if models.AllowedMaturities(viewer.Maturity) != nil {
+		candidates = math.MaxInt
+	}
+	scores, err := popularity.Trending(ctx, s.Client, s.Window, candidates)
+	if err != nil {
+		return nil, err
+	}
+	excluded := set(exclude)
+	var ids []string
+	for _, score := range scores {
+		if !excluded[score.ImdbID] {
+			ids = append(ids, score.ImdbID)
+		}
+	}
+	movies, err := ByID(ctx, s.Client, ids)
+	if err != nil {
+		return nil, err
+	}
+	allowed := []models.Movie{}
+	for _, movie := range movies {
+		if viewer.Allows(movie) && len(allowed) < limit {
+			allowed = append(allowed, movie)
+		}
+	}
+	return allowed, nil
+}
+
+// Newest recommends the most recently added movies.
+type Newest struct {
+	Client *mongo.Client
+}
+
+func (s *Newest) Name() string { return "newest" }
+
+func (s *Newest) Recommend(ctx context.Context, viewer Viewer, limit int, exclude []string) ([]models.Movie, error) {
+	if limit <= 0 {
+		return []models.Movie{}, nil
+	}
+	// ObjectIDs start with their creation time.
+	return find(ctx, s.Client, viewer.Filter(bson.D{}), bson.D{{Key: "_id", Value: -1}}, limit, exclude)
+}
+
+func find(ctx context.Context, client *mongo.Client, filter, sort bson.D, limit int, exclude []string) ([]models.Movie, error) {
+	if len(exclude) > 0 {
+		filter = append(filter, bson.E{Key: "imdb_id", Value: bson.D{{Key: "$nin", Value: exclude}}})
+	}
+	opts := options.Find().SetSort(sort).SetLimit(int64(limit))
+	cursor, err := database.OpenCollection("movies", client).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	movies := []models.Movie{}
+	if err := cursor.All(ctx, &movies); err != nil {
+		return nil, err
+	}
+	return movies, nil
+}
+
+// ByID returns the movies in the order of ids, skipping any that no longer
+// exist.
+func ByID(ctx context.Context, client *mongo.Client, ids []string) ([]models.Movie, error) {
+	movies := []models.Movie{}
+	if len(ids) == 0 {
+		return movies, nil
+	}
+
+	cursor, err := database.OpenCollection("movies", client).Find(ctx, bson.D{{Key: "imdb_id", Value: bson.D{{Key: "$in", Value: ids}}}})
+	if err != nil {
+		return nil, err
+	}
+	var found []models.Movie
+	if err := cursor.All(ctx, &found); err != nil {
+		return nil, err
+	}
+	byID := make(map[string]models.Movie, len(found))
+	for _, movie := range found {
+		byID[movie.ImdbID] = movie
+	}
+	for _, id := range ids {
+		if movie, ok := byID[id]; ok {
+			movies = append(movies, movie)
+		}
+	}
+	return movies, nil
+}
+
+func set(values []string) map[string]bool {
+	s := make(map[string]bool, len(values))
+	for _, value := range values {
+		s[value] = true
+	}
+	return s
+}
@@ -0,0 +1,184 @@
+// Package respcache keeps rendered catalog responses in process for a short
+// TTL, so that repeated reads of the catalog do not reach MongoDB. Entries
+// are grouped in scopes that writers invalidate when the data behind them
+// changes. Each server has its own cache, so a write made through another
+// server is seen here once the TTL has passed.
+package respcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// ScopeMovies holds /movies and /movie/{imdb_id}.
+	ScopeMovies = "movies"
+	// ScopeGenres holds /genres.
+	ScopeGenres = "genres"
+)
+
+const (
+	defaultTTL = 30 * time.Second
+	// maxEntries bounds each scope; /movie/{imdb_id} adds one entry per movie.
+	maxEntries = 1000
+)
+
+// Entry is a cached 200 response.
+type Entry struct {
+	Body        []byte
+	ContentType string
+	ETag        string
+	StoredAt    time.Time
+}
+
+var (
+	mu          sync.RWMutex
+	scopes      = map[string]map[string]*Entry{}
+	generations = map[string]uint64{}
+	override    *time.Duration
+
+	envOnce sync.Once
+	envTTL  time.Duration
+)
+
+// TTL is how long entries are served, from RESPONSE_CACHE_TTL (default 30s).
+// Zero or a negative duration disables the cache.
+func TTL() time.Duration {
+	mu.RLock()
+	if override != nil {
+		defer mu.RUnlock()
+		return *override
+	}
+	mu.RUnlock()
+
+	envOnce.Do(func() {
+		envTTL = defaultTTL
+		value := os.Getenv("RESPONSE_CACHE_TTL")
+		if value == "" {
+			return
+		}
+		ttl, err := time.ParseDuration(value)
+		if err != nil {
+			slog.Warn("invalid RESPONSE_CACHE_TTL, using the default", "value", value, "default", defaultTTL)
+			return
+		}
+		envTTL = ttl
+	})
+	return envTTL
+}
+
+// SetTTL makes TTL return ttl until the returned restore function is called.
+// It is intended for tests.
+func SetTTL(ttl time.Duration) (restore func()) {
+	mu.Lock()
+	previous := override
+	override = &ttl
+	mu.Unlock()
+
+	return func() {
+		mu.Lock()
+		override = previous
+		mu.Unlock()
+	}
+}
+
+// Get returns the entry for key in scope unless it is missing or expired.
+func Get(scope, key string) (*Entry, bool) {
+	ttl := TTL()
+	mu.RLock()
+	defer mu.RUnlock()
+	entry, ok := scopes[scope][key]
+	if !ok || time.Since(entry.StoredAt) >= ttl {
+		return nil, false
+	}
+	return entry, true
+}
+
+// Generation changes whenever scope is invalidated. Take it before reading
+// the data a response is built from and pass it to Put.
+func Generation(scope string) uint64 {
+	mu.RLock()
+	defer mu.RUnlock()
+	return generations[scope]
+}
+
+// Put stores a response body under key in scope and returns the entry. The
+// entry is not stored when the cache is disabled, or when scope was
+// invalidated after generation was taken, since the body may then predate the
+// write that invalidated it.
+func Put(scope, key string, generation uint64, body []byte, contentType string) *Entry {
+	entry := &Entry{Body: body, ContentType: contentType, ETag: ETag(body), StoredAt: time.Now()}
+	ttl := TTL()
+	if ttl <= 0 {
+		return entry
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if generations[scope] != generation {
+		return entry
+	}
+	entries := scopes[scope]
+	if entries == nil {
+		entries = map[string]*Entry{}
+		scopes[scope] = entries
+	}
+	if len(entries) >= maxEntries {
+		for k, e := range entries {
+			if time.Since(e.StoredAt) >= ttl {
+				delete(entries, k)
+			}
+		}
+		if len(entries) >= maxEntries {
+			clear(entries)
+		}
+	}
+	entries[key] = entry
+	return entry
+}
+
+// Invalidate drops every entry in the given scopes.
+func Invalidate(names ...string) {
+	mu.Lock()
+	defer mu.Unlock()
+	for _, name := range names {
+		delete(scopes, name)
+		generations[name]++
+	}
+}
+
+// Clear drops every entry.
+func Clear() {
+	mu.Lock()
+	defer mu.Unlock()
+	for name := range scopes {
+		generations[name]++
+	}
+	clear(scopes)
+}
+
+// ETag is a strong entity tag for body. It depends only on the bytes, so a
+// response rebuilt after an invalidation that did not change it still
+// revalidates.
+func ETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// Matches reports whether an If-None-Match header value matches etag. Weak
+// tags compare equal to their strong form, as RFC 9110 requires for
+// If-None-Match.
+func Matches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
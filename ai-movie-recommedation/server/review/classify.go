@@ -0,0 +1,120 @@
+// Package review classifies admin reviews into the configured rankings with
+// the LLM selected by the llm package.
+package review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/llm"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/prompts"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const operation = "review_ranking"
+
+// ErrUnknownRanking is returned when the LLM answers with a name that is not
+// one of the configured rankings.
+var ErrUnknownRanking = errors.New("LLM answered with an unknown ranking")
+
+// Result is a classified review and how it was obtained.
+type Result struct {
+	Ranking models.Ranking
+	Prompt  models.PromptRef
+	// RenderedPrompt and Answer are what was sent to and returned by the LLM.
+	RenderedPrompt string
+	Answer         string
+	Cached         bool
+}
+
+var deprecatedOnce sync.Once
+
+// Classify asks the LLM which of the configured rankings adminReview matches,
+// using the review ranking prompt version in use. The BASE_PROMPT_TEMPLATE
+// the prompt used to come from is no longer read.
+func Classify(ctx context.Context, client *mongo.Client, adminReview string) (*Result, error) {
+	if os.Getenv("BASE_PROMPT_TEMPLATE") != "" {
+		deprecatedOnce.Do(func() {
+			slog.Warn("BASE_PROMPT_TEMPLATE is ignored, save a new version with POST /admin/prompts/review_ranking instead")
+		})
+	}
+	rankings, err := Rankings(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	prompt, err := prompts.Latest(ctx, client, prompts.ReviewRanking)
+	if err != nil {
+		return nil, err
+	}
+	gateway, err := llm.NewGateway(client)
+	if err != nil {
+		return nil, err
+	}
+	return Rank(ctx, gateway, prompt, rankings, adminReview)
+}
+
+// Rank classifies adminReview with the given prompt version. When the LLM
+// answers with something that is not a ranking, the partial Result is
+// returned together with ErrUnknownRanking.
+func Rank(ctx context.Context, gateway *llm.Gateway, prompt *models.PromptTemplate, rankings []models.Ranking, adminReview string) (*Result, error) {
+	var names []string
+	for _, ranking := range rankings {
+		if !ranking.Unranked {
+			names = append(names, ranking.RankingName)
+		}
+	}
+
+	rendered, err := prompts.Render(prompt, prompts.ReviewRankingData{Rankings: names, Review: adminReview})
+	if err != nil {
+		return nil, err
+	}
+
+	completion, err := gateway.Complete(ctx, operation, rendered)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Prompt:         prompts.Ref(prompt),
+		RenderedPrompt: rendered,
+		Answer:         completion.Text,
+		Cached:         completion.Cached,
+	}
+	for _, ranking := range rankings {
+		if ranking.RankingName == completion.Text && !ranking.Unranked {
+			result.Ranking = ranking
+			return result, nil
+		}
+	}
+	// Do not let a bad answer stick: a retry should ask the model again.
+	if err := gateway.Forget(ctx, operation, rendered); err != nil {
+		return nil, err
+	}
+	return result, fmt.Errorf("%w: %q", ErrUnknownRanking, completion.Text)
+}
+
+// Rankings returns every document in the rankings collection, best first.
+func Rankings(ctx context.Context, client *mongo.Client) ([]models.Ranking, error) {
+	var rankingCollection *mongo.Collection = database.OpenCollection("rankings", client)
+
+	opts := options.Find().SetSort(bson.D{{Key: "ranking_value", Value: 1}})
+	cursor, err := rankingCollection.Find(ctx, bson.D{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rankings []models.Ranking
+	if err := cursor.All(ctx, &rankings); err != nil {
+		return nil, err
+	}
+	return rankings, nil
+}
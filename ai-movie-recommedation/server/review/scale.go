@@ -0,0 +1,243 @@
+package review
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/events"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/respcache"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ScaleError is returned when a new ranking scale is not valid.
+type ScaleError struct {
+	Fields []apperrors.FieldError
+}
+
+func (e *ScaleError) Error() string {
+	return fmt.Sprintf("invalid ranking scale: %d problem(s)", len(e.Fields))
+}
+
+// ScaleChange is the outcome of ReplaceScale.
+type ScaleChange struct {
+	Rankings []models.Ranking
+	// Remapped is how many movies now embed a different tier.
+	Remapped int
+	// RankedChanged reports whether the tiers offered to the LLM changed, in
+	// which case reviewed movies need ranking again.
+	RankedChanged bool
+}
+
+// ValidateScale checks tiers, listed best first, against the current scale:
+// exactly one unranked tier, which comes last; unique names; values strictly
+// increasing; and every replaced name a current tier that is not kept.
+func ValidateScale(current []models.Ranking, tiers []models.RankingTierRequest) []apperrors.FieldError {
+	var fieldErrs []apperrors.FieldError
+	fail := func(field, rule, message string) {
+		fieldErrs = append(fieldErrs, apperrors.FieldError{Field: field, Rule: rule, Message: message})
+	}
+
+	unranked := 0
+	names := map[string]int{}
+	for i, tier := range tiers {
+		field := fmt.Sprintf("rankings[%d]", i)
+		if tier.Unranked {
+			unranked++
+			if i != len(tiers)-1 {
+				fail(field+".unranked", "order", "the unranked tier must come last")
+			}
+		}
+		if first, ok := names[tier.RankingName]; ok {
+			fail(field+".ranking_name", "unique", fmt.Sprintf("duplicates rankings[%d]", first))
+		} else {
+			names[tier.RankingName] = i
+		}
+		if i > 0 && tier.RankingValue <= tiers[i-1].RankingValue {
+			fail(field+".ranking_value", "order", "must be greater than the value of the tier before it; list tiers from best to worst")
+		}
+	}
+	if unranked != 1 {
+		fail("rankings", "unranked", "must contain exactly one unranked tier")
+	}
+	if len(tiers)-unranked < 1 {
+		fail("rankings", "min", "must contain at least one ranked tier")
+	}
+
+	currentNames := map[string]bool{}
+	for _, ranking := range current {
+		currentNames[ranking.RankingName] = true
+	}
+	replaced := map[string]bool{}
+	for i, tier := range tiers {
+		for j, name := range tier.Replaces {
+			field := fmt.Sprintf("rankings[%d].replaces[%d]", i, j)
+			switch {
+			case !currentNames[name]:
+				fail(field, "exists", fmt.Sprintf("%q is not a current tier", name))
+			case hasTier(names, name):
+				fail(field, "kept", fmt.Sprintf("%q is still a tier of the new scale", name))
+			case replaced[name]:
+				fail(field, "unique", fmt.Sprintf("%q is replaced more than once", name))
+			}
+			replaced[name] = true
+		}
+	}
+	return fieldErrs
+}
+
+func hasTier(names map[string]int, name string) bool {
+	_, ok := names[name]
+	return ok
+}
+
+// ReplaceScale makes tiers the ranking scale and moves every movie to its
+// tier in it: the tier of the same name, the tier that replaces its old one,
+// or, when its tier was dropped, the unranked tier.
+func ReplaceScale(ctx context.Context, client *mongo.Client, tiers []models.RankingTierRequest) (*ScaleChange, error) {
+	current, err := Rankings(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if fieldErrs := ValidateScale(current, tiers); len(fieldErrs) > 0 {
+		return nil, &ScaleError{Fields: fieldErrs}
+	}
+
+	change := &ScaleChange{}
+	names := bson.A{}
+	var unranked models.Ranking
+	writes := make([]mongo.WriteModel, 0, len(tiers)+1)
+	for _, tier := range tiers {
+		ranking := models.Ranking{RankingValue: tier.RankingValue, RankingName: tier.RankingName, Unranked: tier.Unranked}
+		change.Rankings = append(change.Rankings, ranking)
+		names = append(names, ranking.RankingName)
+		if ranking.Unranked {
+			unranked = ranking
+		}
+
+		set := bson.D{
+			{Key: "ranking_value", Value: ranking.RankingValue},
+			{Key: "ranking_name", Value: ranking.RankingName},
+		}
+		update := bson.D{{Key: "$unset", Value: bson.D{{Key: "unranked", Value: ""}}}}
+		if ranking.Unranked {
+			set = append(set, bson.E{Key: "unranked", Value: true})
+			update = bson.D{}
+		}
+		update = append(update, bson.E{Key: "$set", Value: set})
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "ranking_name", Value: ranking.RankingName}}).
+			SetUpdate(update).
+			SetUpsert(true))
+	}
+	// Dropped tiers are deleted only once the new ones exist, so the scale is
+	// never empty.
+	writes = append(writes, mongo.NewDeleteManyModel().
+		SetFilter(bson.D{{Key: "ranking_name", Value: bson.D{{Key: "$nin", Value: names}}}}))
+
+	if _, err := database.OpenCollection("rankings", client).BulkWrite(ctx, writes); err != nil {
+		return nil, err
+	}
+
+	movies := database.OpenCollection("movies", client)
+	var moved []models.CatalogEvent
+	if events.Source() == events.SourceLocal {
+		if moved, err = movedMovies(ctx, movies, current, change.Rankings, tiers); err != nil {
+			return nil, err
+		}
+	}
+	for i, tier := range tiers {
+		from := bson.A{tier.RankingName}
+		for _, name := range tier.Replaces {
+			from = append(from, name)
+		}
+		result, err := movies.UpdateMany(ctx,
+			bson.D{{Key: "ranking.ranking_name", Value: bson.D{{Key: "$in", Value: from}}}},
+			bson.D{{Key: "$set", Value: bson.D{{Key: "ranking", Value: change.Rankings[i]}}}})
+		if err != nil {
+			return nil, err
+		}
+		change.Remapped += int(result.ModifiedCount)
+	}
+	result, err := movies.UpdateMany(ctx,
+		bson.D{{Key: "ranking.ranking_name", Value: bson.D{{Key: "$nin", Value: names}}}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "ranking", Value: unranked}}}})
+	if err != nil {
+		return nil, err
+	}
+	change.Remapped += int(result.ModifiedCount)
+	if change.Remapped > 0 {
+		respcache.Invalidate(respcache.ScopeMovies)
+	}
+	events.Publish(moved...)
+
+	change.RankedChanged = !slices.Equal(rankedNames(current), rankedNames(change.Rankings))
+	return change, nil
+}
+
+// movedMovies returns a ranking-changed event for every movie whose tier is
+// replaced, dropped or given a new value by the new scale. It must run before
+// the movies are moved.
+func movedMovies(ctx context.Context, movies *mongo.Collection, current, rankings []models.Ranking, tiers []models.RankingTierRequest) ([]models.CatalogEvent, error) {
+	kept := map[string]models.Ranking{}
+	var unranked models.Ranking
+	for _, ranking := range rankings {
+		kept[ranking.RankingName] = ranking
+		if ranking.Unranked {
+			unranked = ranking
+		}
+	}
+	target := map[string]models.Ranking{}
+	for _, ranking := range current {
+		if next, ok := kept[ranking.RankingName]; !ok {
+			target[ranking.RankingName] = unranked
+		} else if next != ranking {
+			target[ranking.RankingName] = next
+		}
+	}
+	for i, tier := range tiers {
+		for _, name := range tier.Replaces {
+			target[name] = rankings[i]
+		}
+	}
+	if len(target) == 0 {
+		return nil, nil
+	}
+
+	names := bson.A{}
+	for name := range target {
+		names = append(names, name)
+	}
+	cursor, err := movies.Find(ctx,
+		bson.D{{Key: "ranking.ranking_name", Value: bson.D{{Key: "$in", Value: names}}}},
+		options.Find().SetProjection(bson.D{{Key: "imdb_id", Value: 1}, {Key: "ranking", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	var found []models.Movie
+	if err := cursor.All(ctx, &found); err != nil {
+		return nil, err
+	}
+	moved := make([]models.CatalogEvent, len(found))
+	for i, movie := range found {
+		moved[i] = events.RankingEvent(movie.ImdbID, target[movie.Ranking.RankingName])
+	}
+	return moved, nil
+}
+
+// rankedNames are the names offered to the LLM, sorted.
+func rankedNames(rankings []models.Ranking) []string {
+	var names []string
+	for _, ranking := range rankings {
+		if !ranking.Unranked {
+			names = append(names, ranking.RankingName)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,11 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/openapi"
+)
+
+func SetupDocsRoutes(router *gin.Engine) {
+	router.GET("/openapi.json", openapi.ServeSpec)
+	router.GET("/docs", openapi.ServeSwaggerUI)
+}
@@ -4,14 +4,50 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/princepal9120/ai-movie-recommedation/server/controllers"
 	"github.com/princepal9120/ai-movie-recommedation/server/middleware"
+	"github.com/princepal9120/ai-movie-recommedation/server/respcache"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 )
 
 func SetupProtectedRoutes(router *gin.Engine, client *mongo.Client) {
 	router.Use(middleware.AuthMiddleWare())
-	router.GET("/movie/:imdb_id", controllers.GetMovie(client))
+	router.GET("/movie/:imdb_id", middleware.ViewMiddleWare(client), middleware.CacheMiddleWare(respcache.ScopeMovies, middleware.CachePrivate), controllers.GetMovie(client))
+	router.PUT("/movie/:imdb_id/rating", controllers.RateMovie(client))
 	router.POST("/addmovie", controllers.AddMovie(client))
 	router.GET("/recommendedmovies", controllers.GetRecommendedMovies(client))
+	router.GET("/feed", controllers.GetFeed(client))
+	router.GET("/onboarding/movies", controllers.GetOnboardingMovies(client))
+	router.POST("/onboarding/complete", controllers.CompleteOnboarding(client))
+	router.POST("/recommendations/chat", controllers.ChatRecommendations(client))
 	router.PATCH("/updatereview/:imdb_id", controllers.AdminReviewUpdate(client))
+	router.GET("/watchlist", controllers.GetWatchlist(client))
+	router.PUT("/watchlist/:imdb_id", controllers.AddToWatchlist(client))
+	router.DELETE("/watchlist/:imdb_id", controllers.RemoveFromWatchlist(client))
+	router.GET("/profiles", controllers.ListProfiles(client))
+	router.POST("/profiles", controllers.CreateProfile(client))
+	router.PUT("/profiles/:profile_id", controllers.UpdateProfile(client))
+	router.DELETE("/profiles/:profile_id", controllers.DeleteProfile(client))
+	router.POST("/profiles/:profile_id/select", controllers.SelectProfile(client))
+
+	admin := router.Group("/admin", middleware.AdminMiddleWare())
+	admin.POST("/movies/import", controllers.ImportMovies(client))
+	admin.GET("/movies/export", controllers.ExportMovies(client))
+	admin.POST("/movies/:imdb_id/enrich", controllers.EnrichMovie(client))
+	admin.GET("/movies/:imdb_id/review-status", controllers.GetReviewStatus(client))
+	admin.GET("/movies/:imdb_id/stats", controllers.GetMovieStats(client))
+	admin.POST("/movies/rerank", controllers.RerankMovies(client))
+	admin.POST("/movies/:imdb_id/summarize", controllers.SummarizeMovie(client))
+	admin.GET("/rankings", controllers.GetRankings(client))
+	admin.PUT("/rankings", controllers.ReplaceRankings(client))
+	admin.POST("/genres", controllers.CreateGenre(client))
+	admin.PUT("/genres/:genre_id", controllers.UpdateGenre(client))
+	admin.DELETE("/genres/:genre_id", controllers.DeleteGenre(client))
+	admin.GET("/jobs/:job_id", controllers.GetJob(client))
+	admin.GET("/llm/usage", controllers.GetLLMUsage(client))
+	admin.GET("/experiments", controllers.ListExperiments(client))
+	admin.PUT("/experiments/:name", controllers.SaveExperiment(client))
+	admin.GET("/experiments/:name/results", controllers.GetExperimentResults(client))
+	admin.GET("/prompts/:name", controllers.ListPromptTemplates(client))
+	admin.POST("/prompts/:name", controllers.CreatePromptTemplate(client))
+	admin.POST("/prompts/:name/preview", controllers.PreviewPromptTemplate(client))
 
 }
@@ -0,0 +1,59 @@
+package routes
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/middleware"
+	"github.com/princepal9120/ai-movie-recommedation/server/tracing"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+)
+
+// NewRouter builds the engine with the global middleware chain and every route.
+func NewRouter(client *mongo.Client, allowedOrigins []string) *gin.Engine {
+	router := gin.New()
+	router.ContextWithFallback = true
+	router.Use(otelgin.Middleware(tracing.ServiceName()))
+	router.Use(middleware.RequestIdMiddleWare())
+	router.Use(middleware.LoggerMiddleWare())
+	router.Use(middleware.MetricsMiddleWare())
+	router.Use(middleware.ErrorMiddleWare())
+	// A panic is reported like any other internal error, so clients get a
+	// problem response rather than an empty 500.
+	router.Use(gin.CustomRecovery(func(c *gin.Context, recovered any) {
+		c.Error(apperrors.Internal(fmt.Errorf("panic: %v", recovered)))
+		c.Abort()
+	}))
+
+	router.GET("/hello", func(c *gin.Context) {
+		c.String(200, "Hello, MagicStreamMovies!")
+	})
+
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	config := cors.Config{}
+	config.AllowOrigins = allowedOrigins
+	config.AllowMethods = []string{"GET", "POST", "PATCH", "PUT", "DELETE", "OPTIONS"}
+	//config.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization"}
+	config.AllowHeaders = []string{"Origin", "Content-Type", "Authorization", middleware.RequestIdHeader}
+	config.ExposeHeaders = []string{"Content-Length", middleware.RequestIdHeader}
+	config.AllowCredentials = true
+	config.MaxAge = 12 * time.Hour
+
+	router.Use(cors.New(config))
+
+	router.NoRoute(func(c *gin.Context) {
+		c.Error(apperrors.ErrRouteNotFound)
+	})
+
+	SetupDocsRoutes(router)
+	SetupUnProtectedRoutes(router, client)
+	SetupProtectedRoutes(router, client)
+
+	return router
+}
@@ -3,17 +3,21 @@ package routes
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/princepal9120/ai-movie-recommedation/server/controllers"
+	"github.com/princepal9120/ai-movie-recommedation/server/middleware"
+	"github.com/princepal9120/ai-movie-recommedation/server/respcache"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 )
 
 func SetupUnProtectedRoutes(router *gin.Engine, client *mongo.Client) {
 
 
-	router.GET("/movies", controllers.GetMovies(client))
+	router.GET("/movies", middleware.CacheMiddleWare(respcache.ScopeMovies, middleware.CachePublic), controllers.GetMovies(client))
+	router.GET("/movies/events", controllers.StreamCatalogEvents())
+	router.GET("/movies/trending", middleware.CacheMiddleWare(respcache.ScopeMovies, middleware.CachePublic), controllers.GetTrendingMovies(client))
 	router.POST("/register", controllers.RegisterUser(client))
 	router.POST("/login", controllers.LoginUser(client))
 	router.POST("/logout", controllers.LogoutHandler(client))
 	router.POST("/refresh", controllers.RefreshTokenHandler(client))
-	router.GET("/genres", controllers.GetGenres(client))
+	router.GET("/genres", middleware.CacheMiddleWare(respcache.ScopeGenres, middleware.CachePublic), controllers.GetGenres(client))
 
 }
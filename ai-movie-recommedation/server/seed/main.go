@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/logging"
+	"github.com/princepal9120/ai-movie-recommedation/server/migrations"
+)
+
+const usage = `Usage: seed [flags] <command>
+
+Commands:
+  up       apply pending migrations (default)
+  down     roll back the most recent migrations
+  status   list migrations and whether they are applied
+
+Flags:
+`
+
+func main() {
+	envFile := flag.String("env-file", ".env", "path of the .env file to load")
+	dataDir := flag.String("data", "", "directory with genres/rankings/users/movies JSON (defaults to the embedded seed data)")
+	dryRun := flag.Bool("dry-run", false, "print what would change without writing")
+	to := flag.Int("to", 0, "up: stop after this version (0 applies all)")
+	steps := flag.Int("steps", 1, "down: number of migrations to roll back")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	err := godotenv.Load(*envFile)
+
+	logging.Init()
+
+	if err != nil {
+		slog.Warn("unable to load env file", "path", *envFile)
+	}
+
+	command := flag.Arg(0)
+	if command == "" {
+		command = "up"
+	}
+
+	client := database.Connect()
+	if client == nil {
+		os.Exit(1)
+	}
+	defer func() {
+		if err := client.Disconnect(context.Background()); err != nil {
+			slog.Error("failed to disconnect from MongoDB", "error", err)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	migrator := migrations.New(database.OpenDatabase(client))
+	migrator.Out = os.Stdout
+	migrator.DryRun = *dryRun
+	if *dataDir != "" {
+		migrator.Data = os.DirFS(*dataDir)
+	}
+
+	if err := run(ctx, migrator, command, *to, *steps); err != nil {
+		slog.Error("migration failed", "command", command, "error", err)
+		client.Disconnect(context.Background())
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, migrator *migrations.Migrator, command string, to, steps int) error {
+	switch command {
+	case "up":
+		count, err := migrator.Up(ctx, to)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d migration(s) %s\n", count, pastTense(migrator.DryRun, "applied"))
+	case "down":
+		count, err := migrator.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d migration(s) %s\n", count, pastTense(migrator.DryRun, "rolled back"))
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			state := "pending"
+			if status.Applied {
+				state = "applied " + status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%04d  %-16s %s\n", status.Version, status.Name, state)
+		}
+	default:
+		flag.Usage()
+		return fmt.Errorf("unknown command %q", command)
+	}
+	return nil
+}
+
+func pastTense(dryRun bool, verb string) string {
+	if dryRun {
+		return "would be " + verb
+	}
+	return verb
+}
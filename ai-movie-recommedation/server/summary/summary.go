@@ -0,0 +1,137 @@
+// Package summary has the LLM write a synopsis, mood tags and content warnings
+// for a movie from its admin review and metadata.
+package summary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/llm"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/prompts"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const operation = "movie_summary"
+
+const (
+	maxMoodTags        = 5
+	maxContentWarnings = 10
+)
+
+var (
+	// ErrNothingToSummarize is returned for a movie with neither an admin
+	// review nor a metadata overview.
+	ErrNothingToSummarize = errors.New("movie has no review or overview to summarize")
+	// ErrInvalidAnswer is returned when the LLM does not answer with the
+	// requested JSON object.
+	ErrInvalidAnswer = errors.New("LLM answered with an invalid summary")
+)
+
+// Generate summarizes movie with the movie summary prompt version in use.
+func Generate(ctx context.Context, client *mongo.Client, movie *models.Movie) (*models.Summary, error) {
+	prompt, err := prompts.Latest(ctx, client, prompts.MovieSummary)
+	if err != nil {
+		return nil, err
+	}
+	gateway, err := llm.NewGateway(client)
+	if err != nil {
+		return nil, err
+	}
+	return Summarize(ctx, gateway, prompt, movie)
+}
+
+// Summarize renders prompt for movie and parses the LLM's answer.
+func Summarize(ctx context.Context, gateway *llm.Gateway, prompt *models.PromptTemplate, movie *models.Movie) (*models.Summary, error) {
+	data := promptData(movie)
+	if data.Review == "" && data.Overview == "" {
+		return nil, ErrNothingToSummarize
+	}
+
+	rendered, err := prompts.Render(prompt, data)
+	if err != nil {
+		return nil, err
+	}
+
+	completion, err := gateway.Complete(ctx, operation, rendered)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := parse(completion.Text)
+	if err != nil {
+		// Do not let a bad answer stick: a retry should ask the model again.
+		if err := gateway.Forget(ctx, operation, rendered); err != nil {
+			return nil, err
+		}
+		return nil, err
+	}
+	summary.Prompt = prompts.Ref(prompt)
+	summary.GeneratedAt = time.Now().UTC()
+	return summary, nil
+}
+
+// promptData is what the movie summary prompt is rendered with.
+func promptData(movie *models.Movie) prompts.MovieSummaryData {
+	data := prompts.MovieSummaryData{Title: movie.Title, Review: strings.TrimSpace(movie.AdminReview)}
+	for _, genre := range movie.Genre {
+		data.Genres = append(data.Genres, genre.GenreName)
+	}
+	if metadata := movie.Metadata; metadata != nil {
+		data.Year = metadata.ReleaseYear
+		data.Director = metadata.Director
+		data.Cast = metadata.Cast
+		data.Overview = metadata.Overview
+	}
+	return data
+}
+
+// parse reads the JSON object out of answer, tolerating a Markdown code fence
+// around it, and tidies the tags.
+func parse(answer string) (*models.Summary, error) {
+	text := strings.TrimSpace(answer)
+	if start, end := strings.Index(text, "{"), strings.LastIndex(text, "}"); start >= 0 && end > start {
+		text = text[start : end+1]
+	}
+
+	var parsed struct {
+		Synopsis        string   `json:"synopsis"`
+		MoodTags        []string `json:"mood_tags"`
+		ContentWarnings []string `json:"content_warnings"`
+	}
+	if err := json.Unmarshal([]byte(text), &parsed); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAnswer, err)
+	}
+	if strings.TrimSpace(parsed.Synopsis) == "" {
+		return nil, fmt.Errorf("%w: the synopsis is empty", ErrInvalidAnswer)
+	}
+
+	return &models.Summary{
+		Synopsis:        strings.TrimSpace(parsed.Synopsis),
+		MoodTags:        tags(parsed.MoodTags, maxMoodTags),
+		ContentWarnings: tags(parsed.ContentWarnings, maxContentWarnings),
+	}, nil
+}
+
+// tags lowercases and de-duplicates values, dropping empty ones and keeping at
+// most limit.
+func tags(values []string, limit int) []string {
+	seen := map[string]bool{}
+	cleaned := []string{}
+	for _, value := range values {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		cleaned = append(cleaned, value)
+		if len(cleaned) == limit {
+			break
+		}
+	}
+	return cleaned
+}
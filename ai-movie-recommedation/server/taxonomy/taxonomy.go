@@ -0,0 +1,237 @@
+// Package taxonomy manages the genres collection: a tree of genres and
+// sub-genres that movies, users and profiles refer to by genre_id.
+package taxonomy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/princepal9120/ai-movie-recommedation/server/apperrors"
+	"github.com/princepal9120/ai-movie-recommedation/server/database"
+	"github.com/princepal9120/ai-movie-recommedation/server/models"
+	"github.com/princepal9120/ai-movie-recommedation/server/respcache"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+var (
+	ErrNotFound      = errors.New("genre not found")
+	ErrUnknownParent = errors.New("parent genre does not exist")
+	ErrCycle         = errors.New("a genre cannot be its own ancestor")
+)
+
+// InUseError is returned when deleting a genre that is still referenced.
+type InUseError struct {
+	Movies    int64
+	Users     int64
+	Profiles  int64
+	SubGenres int64
+}
+
+func (e *InUseError) Error() string {
+	return fmt.Sprintf("genre is referenced by %d movies, %d users, %d profiles and %d sub-genres", e.Movies, e.Users, e.Profiles, e.SubGenres)
+}
+
+func collection(client *mongo.Client) *mongo.Collection {
+	return database.OpenCollection("genres", client)
+}
+
+// Index is the genres collection keyed by genre_id.
+type Index map[int]models.Genre
+
+// Load reads every genre.
+func Load(ctx context.Context, client *mongo.Client) (Index, error) {
+	cursor, err := collection(client).Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	var genres []models.Genre
+	if err := cursor.All(ctx, &genres); err != nil {
+		return nil, err
+	}
+
+	index := make(Index, len(genres))
+	for _, genre := range genres {
+		index[genre.GenreID] = genre
+	}
+	return index, nil
+}
+
+// Resolve returns the copies of refs to embed in a movie or user, with names
+// taken from the index, and a field error for every genre_id the index does
+// not have. field is where refs sit in the request, such as "genre".
+func (index Index) Resolve(refs []models.Genre, field string) ([]models.Genre, []apperrors.FieldError) {
+	resolved := make([]models.Genre, 0, len(refs))
+	var fieldErrs []apperrors.FieldError
+	for i, ref := range refs {
+		genre, ok := index[ref.GenreID]
+		if !ok {
+			fieldErrs = append(fieldErrs, apperrors.FieldError{
+				Field:   fmt.Sprintf("%s[%d].genre_id", field, i),
+				Rule:    "exists",
+				Message: fmt.Sprintf("genre %d does not exist", ref.GenreID),
+			})
+			continue
+		}
+		resolved = append(resolved, models.Genre{GenreID: genre.GenreID, GenreName: genre.GenreName})
+	}
+	return resolved, fieldErrs
+}
+
+// Subtree returns names followed by the names of all their sub-genres, at any
+// depth.
+func (index Index) Subtree(names []string) []string {
+	wanted := map[string]bool{}
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	expanded := append([]string{}, names...)
+	for _, genre := range index {
+		if wanted[genre.GenreName] {
+			continue
+		}
+		for _, ancestor := range index.ancestors(genre) {
+			if wanted[ancestor.GenreName] {
+				expanded = append(expanded, genre.GenreName)
+				break
+			}
+		}
+	}
+	return expanded
+}
+
+// ancestors walks up from genre's parent. It stops at a missing parent or a
+// loop, so a corrupt tree cannot hang it.
+func (index Index) ancestors(genre models.Genre) []models.Genre {
+	var chain []models.Genre
+	seen := map[int]bool{genre.GenreID: true}
+	for genre.ParentID != nil {
+		parent, ok := index[*genre.ParentID]
+		if !ok || seen[parent.GenreID] {
+			break
+		}
+		seen[parent.GenreID] = true
+		chain = append(chain, parent)
+		genre = parent
+	}
+	return chain
+}
+
+// checkParent reports whether genreID may become a sub-genre of parentID.
+func (index Index) checkParent(genreID int, parentID *int) error {
+	if parentID == nil {
+		return nil
+	}
+	parent, ok := index[*parentID]
+	if !ok {
+		return ErrUnknownParent
+	}
+	if parent.GenreID == genreID {
+		return ErrCycle
+	}
+	for _, ancestor := range index.ancestors(parent) {
+		if ancestor.GenreID == genreID {
+			return ErrCycle
+		}
+	}
+	return nil
+}
+
+// Create adds a genre. Without a GenreID it takes the one after the highest
+// in use; two concurrent creates then collide on the unique index and the
+// loser gets a duplicate key error.
+func Create(ctx context.Context, client *mongo.Client, genre models.Genre) (*models.Genre, error) {
+	index, err := Load(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if genre.GenreID == 0 {
+		for id := range index {
+			genre.GenreID = max(genre.GenreID, id)
+		}
+		genre.GenreID++
+	}
+	if err := index.checkParent(genre.GenreID, genre.ParentID); err != nil {
+		return nil, err
+	}
+
+	if _, err := collection(client).InsertOne(ctx, genre); err != nil {
+		return nil, err
+	}
+	respcache.Invalidate(respcache.ScopeGenres)
+	return &genre, nil
+}
+
+// Update replaces a genre's name and parent and reports whether the name
+// changed, in which case the copies embedded in movies, users and profiles
+// are stale.
+func Update(ctx context.Context, client *mongo.Client, genreID int, name string, parentID *int) (*models.Genre, bool, error) {
+	index, err := Load(ctx, client)
+	if err != nil {
+		return nil, false, err
+	}
+	existing, ok := index[genreID]
+	if !ok {
+		return nil, false, ErrNotFound
+	}
+	if err := index.checkParent(genreID, parentID); err != nil {
+		return nil, false, err
+	}
+
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "genre_name", Value: name}}}}
+	if parentID != nil {
+		update[0].Value = append(update[0].Value.(bson.D), bson.E{Key: "parent_id", Value: *parentID})
+	} else {
+		update = append(update, bson.E{Key: "$unset", Value: bson.D{{Key: "parent_id", Value: ""}}})
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var updated models.Genre
+	err = collection(client).FindOneAndUpdate(ctx, bson.D{{Key: "genre_id", Value: genreID}}, update, opts).Decode(&updated)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, false, ErrNotFound
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	respcache.Invalidate(respcache.ScopeGenres)
+	return &updated, existing.GenreName != name, nil
+}
+
+// Delete removes a genre that no movie, user or sub-genre refers to.
+func Delete(ctx context.Context, client *mongo.Client, genreID int) error {
+	count := func(collectionName, field string) (int64, error) {
+		return database.OpenCollection(collectionName, client).CountDocuments(ctx, bson.D{{Key: field, Value: genreID}})
+	}
+
+	var inUse InUseError
+	var err error
+	if inUse.Movies, err = count("movies", "genre.genre_id"); err != nil {
+		return err
+	}
+	if inUse.Users, err = count("users", "favourite_genres.genre_id"); err != nil {
+		return err
+	}
+	if inUse.Profiles, err = count("profiles", "favourite_genres.genre_id"); err != nil {
+		return err
+	}
+	if inUse.SubGenres, err = count("genres", "parent_id"); err != nil {
+		return err
+	}
+	if inUse.Movies+inUse.Users+inUse.Profiles+inUse.SubGenres > 0 {
+		return &inUse
+	}
+
+	result, err := collection(client).DeleteOne(ctx, bson.D{{Key: "genre_id", Value: genreID}})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+	respcache.Invalidate(respcache.ScopeGenres)
+	return nil
+}
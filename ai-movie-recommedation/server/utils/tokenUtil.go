@@ -19,19 +19,23 @@ type SignedDetails struct {
 	LastName  string
 	Role      string
 	UserId    string
+	// ProfileId is the household profile the tokens act for, empty for the
+	// account itself.
+	ProfileId string
 	jwt.RegisteredClaims
 }
 
 var SECRET_KEY string = os.Getenv("SECRET_KEY")
 var SECRET_REFRESH_KEY string = os.Getenv("SECRET_REFRESH_KEY")
 
-func GenerateAllTokens(email, firstName, lastName, role, userId string) (string, string, error) {
+func GenerateAllTokens(email, firstName, lastName, role, userId, profileId string) (string, string, error) {
 	claims := &SignedDetails{
 		Email:     email,
 		FirstName: firstName,
 		LastName:  lastName,
 		Role:      role,
 		UserId:    userId,
+		ProfileId: profileId,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    "MagicStream",
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -51,6 +55,7 @@ func GenerateAllTokens(email, firstName, lastName, role, userId string) (string,
 		LastName:  lastName,
 		Role:      role,
 		UserId:    userId,
+		ProfileId: profileId,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    "MagicStream",
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -151,6 +156,14 @@ func GetUserIdFromContext(c *gin.Context) (string, error) {
 
 }
 
+// GetProfileIdFromContext returns the profile selected for the request, or
+// an empty string when the account acts for itself.
+func GetProfileIdFromContext(c *gin.Context) string {
+	profileId, _ := c.Get("profileId")
+	id, _ := profileId.(string)
+	return id
+}
+
 func GetRoleFromContext(c *gin.Context) (string, error) {
 	role, exists := c.Get("role")
 